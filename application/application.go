@@ -82,6 +82,17 @@ func (a *Application) UnmarshalTx(txb []byte) (interfaces.Transaction, error) {
 	return tx, nil
 }
 
+// PreValidatePendingTx performs the checks on a transaction that do not
+// depend on local state. A tx that fails them could not have been accepted
+// by the peer that relayed it.
+func (a *Application) PreValidatePendingTx(chainID uint32, txIf interfaces.Transaction) error {
+	tx, ok := txIf.(*objs.Tx)
+	if !ok {
+		return errorz.ErrInvalid{}.New("not a tx")
+	}
+	return tx.PreValidatePending(chainID)
+}
+
 func (a *Application) convertTxToIface(txs []*objs.Tx) []interfaces.Transaction {
 	out := make([]interfaces.Transaction, len(txs))
 	for i := 0; i < len(txs); i++ {
@@ -120,10 +120,18 @@ func (dp *Handler) Add(txn *badger.Txn, chainID uint32, utxoID []byte, biValue *
 
 // Remove will delete all references to a deposit from the Handler
 func (dp *Handler) Remove(txn *badger.Txn, utxoID []byte) error {
+	utxoID = utils.CopySlice(utxoID)
+	utxoID = utils.ForceSliceToLength(utxoID, constants.HashLen)
 	err := dp.valueIndex.Drop(txn, utxoID)
 	if err != nil {
 		utils.DebugTrace(dp.logger, err)
 	}
+	key := dp.makeKey(utxoID)
+	err = utils.DeleteValue(txn, key)
+	if err != nil {
+		utils.DebugTrace(dp.logger, err)
+		return err
+	}
 	return nil
 }
 
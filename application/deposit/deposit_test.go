@@ -534,6 +534,12 @@ func TestDepositRemove(t *testing.T) {
 			t.Fatal()
 		}
 
+		// A removed deposit may be added again
+		err = hndlr.Add(txn, testingChainID, utxoID, one, testingOwner())
+		if err != nil {
+			t.Fatal(err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -0,0 +1,501 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	geth "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sirupsen/logrus"
+)
+
+// Endpoint pool errors
+var (
+	ErrNoEndpoint        = errors.New("no Ethereum endpoint available")
+	ErrNoCurrentEndpoint = errors.New("no Ethereum endpoint has reached the finalized height")
+	ErrNoEndpointAtBlock = errors.New("no Ethereum endpoint has reached the requested block")
+)
+
+var _ GethClient = (*endpointPool)(nil)
+
+// endpoint is a single Ethereum endpoint along with what the last health
+// check learned about it
+type endpoint struct {
+	url       string
+	client    GethClient
+	peerCount func(context.Context) (uint64, error)
+	syncing   func(context.Context) (*geth.SyncProgress, error)
+	height    uint64
+	peers     uint64
+	failures  int
+}
+
+// endpointPool routes calls across several endpoints. Reads go to the
+// healthiest endpoint and fail over to the next one when the endpoint
+// itself fails. Transactions only go to endpoints that have reached the
+// finalized height.
+type endpointPool struct {
+	sync.RWMutex
+	logger        *logrus.Logger
+	endpoints     []*endpoint
+	finalityDelay uint64
+	timeout       time.Duration
+	closeChan     chan struct{}
+	closeOnce     sync.Once
+}
+
+func newEndpointPool(logger *logrus.Logger, endpoints []*endpoint, finalityDelay uint64, timeout time.Duration) *endpointPool {
+	return &endpointPool{
+		logger:        logger,
+		endpoints:     endpoints,
+		finalityDelay: finalityDelay,
+		timeout:       timeout,
+		closeChan:     make(chan struct{}),
+	}
+}
+
+// start runs the health checks until the pool is closed
+func (p *endpointPool) start(interval time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-p.closeChan:
+				return
+			case <-time.After(interval):
+				p.refresh()
+			}
+		}
+	}()
+}
+
+func (p *endpointPool) close() {
+	p.closeOnce.Do(func() {
+		close(p.closeChan)
+	})
+}
+
+// refresh updates the head height and peer count of every endpoint
+func (p *endpointPool) refresh() {
+	p.RLock()
+	endpoints := append([]*endpoint{}, p.endpoints...)
+	p.RUnlock()
+	for _, ep := range endpoints {
+		ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+		header, err := ep.client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			cancel()
+			p.fail(ep, err)
+			continue
+		}
+		peers, err := ep.peerCount(ctx)
+		cancel()
+		if err != nil {
+			// Not every provider exposes the net namespace
+			p.logger.Debugf("Could not get peer count of %v: %v", ep.url, err)
+			peers = 0
+		}
+		p.Lock()
+		ep.height = header.Number.Uint64()
+		ep.peers = peers
+		ep.failures = 0
+		p.Unlock()
+	}
+}
+
+func (p *endpointPool) fail(ep *endpoint, err error) {
+	p.Lock()
+	defer p.Unlock()
+	ep.failures++
+	p.logger.Warnf("Ethereum endpoint %v failed (%v in a row): %v", ep.url, ep.failures, err)
+}
+
+func (p *endpointPool) succeed(ep *endpoint) {
+	p.Lock()
+	defer p.Unlock()
+	ep.failures = 0
+}
+
+// finalizedHeight is the finalized height according to the highest head seen
+func (p *endpointPool) finalizedHeight() uint64 {
+	var height uint64
+	for _, ep := range p.endpoints {
+		if ep.height > height {
+			height = ep.height
+		}
+	}
+	if p.finalityDelay >= height {
+		return 0
+	}
+	return height - p.finalityDelay
+}
+
+// ranked returns the endpoints healthiest first. Endpoints that have not
+// failed come before those that have, then the highest head and the most
+// peers win.
+func (p *endpointPool) ranked() []*endpoint {
+	p.RLock()
+	defer p.RUnlock()
+	endpoints := append([]*endpoint{}, p.endpoints...)
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		a, b := endpoints[i], endpoints[j]
+		if a.failures != b.failures {
+			return a.failures < b.failures
+		}
+		if a.height != b.height {
+			return a.height > b.height
+		}
+		return a.peers > b.peers
+	})
+	return endpoints
+}
+
+// current returns the endpoints that have reached the finalized height,
+// healthiest first
+func (p *endpointPool) current() []*endpoint {
+	p.RLock()
+	finalized := p.finalizedHeight()
+	p.RUnlock()
+	return p.reached(finalized)
+}
+
+// reached returns the endpoints whose head was at or above height on the
+// last health check, healthiest first
+func (p *endpointPool) reached(height uint64) []*endpoint {
+	endpoints := p.ranked()
+	p.RLock()
+	defer p.RUnlock()
+	reached := []*endpoint{}
+	for _, ep := range endpoints {
+		if ep.height >= height {
+			reached = append(reached, ep)
+		}
+	}
+	return reached
+}
+
+// checkHead fails if the head of an endpoint is below height
+func (p *endpointPool) checkHead(ctx context.Context, ep *endpoint, height uint64) error {
+	header, err := ep.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	head := header.Number.Uint64()
+	p.Lock()
+	ep.height = head
+	p.Unlock()
+	if head < height {
+		return fmt.Errorf("head %v is below block %v", head, height)
+	}
+	return nil
+}
+
+// best returns the healthiest endpoint
+func (p *endpointPool) best() *endpoint {
+	endpoints := p.ranked()
+	if len(endpoints) == 0 {
+		return nil
+	}
+	return endpoints[0]
+}
+
+// isEndpointFailure tells if an error is the fault of the endpoint rather
+// than an answer from it
+func isEndpointFailure(err error) bool {
+	if err == geth.NotFound {
+		return false
+	}
+	if _, ok := err.(rpc.Error); ok {
+		return false
+	}
+	return true
+}
+
+// try calls fn on each endpoint in turn until one does not fail
+func (p *endpointPool) try(ctx context.Context, endpoints []*endpoint, fn func(*endpoint) error) error {
+	err := ErrNoEndpoint
+	for _, ep := range endpoints {
+		err = fn(ep)
+		if err == nil {
+			p.succeed(ep)
+			return nil
+		}
+		if !isEndpointFailure(err) || ctx.Err() != nil {
+			return err
+		}
+		p.fail(ep, err)
+	}
+	return err
+}
+
+func (p *endpointPool) read(ctx context.Context, fn func(*endpoint) error) error {
+	return p.try(ctx, p.ranked(), fn)
+}
+
+func (p *endpointPool) write(ctx context.Context, fn func(*endpoint) error) error {
+	endpoints := p.current()
+	if len(endpoints) == 0 {
+		return ErrNoCurrentEndpoint
+	}
+	return p.try(ctx, endpoints, fn)
+}
+
+func (p *endpointPool) PeerCount(ctx context.Context) (uint64, error) {
+	var peers uint64
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		peers, err = ep.peerCount(ctx)
+		return err
+	})
+	return peers, err
+}
+
+func (p *endpointPool) SyncProgress(ctx context.Context) (*geth.SyncProgress, error) {
+	var progress *geth.SyncProgress
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		progress, err = ep.syncing(ctx)
+		return err
+	})
+	return progress, err
+}
+
+func (p *endpointPool) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	var block *types.Block
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		block, err = ep.client.BlockByHash(ctx, hash)
+		return err
+	})
+	return block, err
+}
+
+func (p *endpointPool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	var block *types.Block
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		block, err = ep.client.BlockByNumber(ctx, number)
+		return err
+	})
+	return block, err
+}
+
+func (p *endpointPool) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	var header *types.Header
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		header, err = ep.client.HeaderByHash(ctx, hash)
+		return err
+	})
+	return header, err
+}
+
+func (p *endpointPool) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var header *types.Header
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		header, err = ep.client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (p *endpointPool) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	var count uint
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		count, err = ep.client.TransactionCount(ctx, blockHash)
+		return err
+	})
+	return count, err
+}
+
+func (p *endpointPool) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	var txn *types.Transaction
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		txn, err = ep.client.TransactionInBlock(ctx, blockHash, index)
+		return err
+	})
+	return txn, err
+}
+
+func (p *endpointPool) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (geth.Subscription, error) {
+	var sub geth.Subscription
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		sub, err = ep.client.SubscribeNewHead(ctx, ch)
+		return err
+	})
+	return sub, err
+}
+
+func (p *endpointPool) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	var txn *types.Transaction
+	var isPending bool
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		txn, isPending, err = ep.client.TransactionByHash(ctx, txHash)
+		return err
+	})
+	return txn, isPending, err
+}
+
+func (p *endpointPool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	var receipt *types.Receipt
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		receipt, err = ep.client.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return receipt, err
+}
+
+func (p *endpointPool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	var balance *big.Int
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		balance, err = ep.client.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+	return balance, err
+}
+
+func (p *endpointPool) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	var data []byte
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		data, err = ep.client.StorageAt(ctx, account, key, blockNumber)
+		return err
+	})
+	return data, err
+}
+
+func (p *endpointPool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	var code []byte
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		code, err = ep.client.CodeAt(ctx, account, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+func (p *endpointPool) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	var nonce uint64
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		nonce, err = ep.client.NonceAt(ctx, account, blockNumber)
+		return err
+	})
+	return nonce, err
+}
+
+func (p *endpointPool) CallContract(ctx context.Context, call geth.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var data []byte
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		data, err = ep.client.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return data, err
+}
+
+// PendingCodeAt is part of building a transaction, so it is answered by an
+// endpoint that the transaction could be sent to
+func (p *endpointPool) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	var code []byte
+	err := p.write(ctx, func(ep *endpoint) error {
+		var err error
+		code, err = ep.client.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+// PendingNonceAt is part of building a transaction, so it is answered by an
+// endpoint that the transaction could be sent to
+func (p *endpointPool) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	var nonce uint64
+	err := p.write(ctx, func(ep *endpoint) error {
+		var err error
+		nonce, err = ep.client.PendingNonceAt(ctx, account)
+		return err
+	})
+	return nonce, err
+}
+
+func (p *endpointPool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	var price *big.Int
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		price, err = ep.client.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (p *endpointPool) EstimateGas(ctx context.Context, call geth.CallMsg) (uint64, error) {
+	var gas uint64
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		gas, err = ep.client.EstimateGas(ctx, call)
+		return err
+	})
+	return gas, err
+}
+
+// SendTransaction sends the transaction to the healthiest endpoint that
+// has reached the finalized height
+func (p *endpointPool) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return p.write(ctx, func(ep *endpoint) error {
+		return ep.client.SendTransaction(ctx, tx)
+	})
+}
+
+// FilterLogs only asks endpoints that have reached ToBlock. An endpoint
+// behind it would answer with no logs rather than an error, so the head is
+// checked again before an empty answer is accepted.
+func (p *endpointPool) FilterLogs(ctx context.Context, query geth.FilterQuery) ([]types.Log, error) {
+	if query.ToBlock == nil {
+		var logs []types.Log
+		err := p.read(ctx, func(ep *endpoint) error {
+			var err error
+			logs, err = ep.client.FilterLogs(ctx, query)
+			return err
+		})
+		return logs, err
+	}
+
+	to := query.ToBlock.Uint64()
+	endpoints := p.reached(to)
+	if len(endpoints) == 0 {
+		return nil, ErrNoEndpointAtBlock
+	}
+	var logs []types.Log
+	err := p.try(ctx, endpoints, func(ep *endpoint) error {
+		var err error
+		logs, err = ep.client.FilterLogs(ctx, query)
+		if err != nil || len(logs) > 0 {
+			return err
+		}
+		return p.checkHead(ctx, ep, to)
+	})
+	return logs, err
+}
+
+func (p *endpointPool) SubscribeFilterLogs(ctx context.Context, query geth.FilterQuery, ch chan<- types.Log) (geth.Subscription, error) {
+	var sub geth.Subscription
+	err := p.read(ctx, func(ep *endpoint) error {
+		var err error
+		sub, err = ep.client.SubscribeFilterLogs(ctx, query, ch)
+		return err
+	})
+	return sub, err
+}
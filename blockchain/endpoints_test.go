@@ -0,0 +1,192 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/logging"
+	geth "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTestEndpointDown = errors.New("connection refused")
+
+type testClient struct {
+	GethClient
+	height  uint64
+	down    bool
+	reads   int
+	sent    int
+	balance *big.Int
+}
+
+func (c *testClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if c.down {
+		return nil, errTestEndpointDown
+	}
+	return &types.Header{Number: new(big.Int).SetUint64(c.height)}, nil
+}
+
+func (c *testClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	c.reads++
+	if c.down {
+		return nil, errTestEndpointDown
+	}
+	return c.balance, nil
+}
+
+func (c *testClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	c.reads++
+	if c.down {
+		return nil, errTestEndpointDown
+	}
+	return nil, geth.NotFound
+}
+
+func (c *testClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if c.down {
+		return errTestEndpointDown
+	}
+	c.sent++
+	return nil
+}
+
+func newTestPool(clients ...*testClient) *endpointPool {
+	endpoints := []*endpoint{}
+	for idx, c := range clients {
+		peers := uint64(idx)
+		endpoints = append(endpoints, &endpoint{
+			url:    string(rune('a' + idx)),
+			client: c,
+			peerCount: func(context.Context) (uint64, error) {
+				return peers, nil
+			},
+		})
+	}
+	pool := newEndpointPool(logging.GetLogger("ethereum"), endpoints, 6, time.Second)
+	pool.refresh()
+	return pool
+}
+
+func TestEndpointPoolPrefersHighestHead(t *testing.T) {
+	behind := &testClient{height: 90, balance: big.NewInt(1)}
+	ahead := &testClient{height: 100, balance: big.NewInt(2)}
+	pool := newTestPool(behind, ahead)
+
+	balance, err := pool.BalanceAt(context.Background(), common.Address{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(2), balance)
+	assert.Equal(t, "b", pool.best().url)
+	assert.Equal(t, 0, behind.reads)
+}
+
+func TestEndpointPoolFailover(t *testing.T) {
+	first := &testClient{height: 100, balance: big.NewInt(1)}
+	second := &testClient{height: 99, balance: big.NewInt(2)}
+	pool := newTestPool(first, second)
+
+	first.down = true
+	balance, err := pool.BalanceAt(context.Background(), common.Address{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(2), balance)
+
+	// The failed endpoint is now ranked last
+	balance, err = pool.BalanceAt(context.Background(), common.Address{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(2), balance)
+	assert.Equal(t, 1, first.reads)
+
+	// A health check brings it back
+	first.down = false
+	pool.refresh()
+	assert.Equal(t, "a", pool.best().url)
+
+	// Every endpoint down
+	first.down = true
+	second.down = true
+	_, err = pool.BalanceAt(context.Background(), common.Address{}, nil)
+	assert.Equal(t, errTestEndpointDown, err)
+}
+
+func TestEndpointPoolAnswerIsNotFailure(t *testing.T) {
+	first := &testClient{height: 100}
+	second := &testClient{height: 99}
+	pool := newTestPool(first, second)
+
+	_, err := pool.TransactionReceipt(context.Background(), common.Hash{})
+	assert.Equal(t, geth.NotFound, err)
+	assert.Equal(t, 1, first.reads)
+	assert.Equal(t, 0, second.reads)
+	assert.Equal(t, "a", pool.best().url)
+}
+
+func TestEndpointPoolSendsOnlyToCurrent(t *testing.T) {
+	stale := &testClient{height: 50}
+	current := &testClient{height: 100}
+	pool := newTestPool(stale, current)
+
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+	err := pool.SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, current.sent)
+
+	// The stale endpoint is never used, even when it is all that is left
+	current.down = true
+	err = pool.SendTransaction(context.Background(), tx)
+	assert.Equal(t, errTestEndpointDown, err)
+	assert.Equal(t, 0, stale.sent)
+
+	// Within the finality delay an endpoint is still current
+	stale.height = 94
+	current.down = false
+	pool.refresh()
+	current.down = true
+	err = pool.SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, stale.sent)
+}
+
+func (c *testClient) FilterLogs(ctx context.Context, query geth.FilterQuery) ([]types.Log, error) {
+	c.reads++
+	if c.down {
+		return nil, errTestEndpointDown
+	}
+	if c.height < query.ToBlock.Uint64() {
+		return nil, nil
+	}
+	return []types.Log{{BlockNumber: query.ToBlock.Uint64()}}, nil
+}
+
+func TestEndpointPoolFilterLogsReachedBlock(t *testing.T) {
+	stale := &testClient{height: 90}
+	behind := &testClient{height: 96}
+	ahead := &testClient{height: 100}
+	pool := newTestPool(stale, behind, ahead)
+
+	// Only the endpoints that reached the block are asked
+	query := geth.FilterQuery{FromBlock: big.NewInt(95), ToBlock: big.NewInt(95)}
+	logs, err := pool.FilterLogs(context.Background(), query)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, 1, ahead.reads)
+	assert.Equal(t, 0, stale.reads)
+
+	// An empty answer from an endpoint that fell behind since the last
+	// health check is not trusted
+	ahead.height = 90
+	logs, err = pool.FilterLogs(context.Background(), query)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, 1, behind.reads)
+	assert.Equal(t, 0, stale.reads)
+
+	// No endpoint has reached the block
+	query = geth.FilterQuery{FromBlock: big.NewInt(200), ToBlock: big.NewInt(200)}
+	_, err = pool.FilterLogs(context.Background(), query)
+	assert.Equal(t, ErrNoEndpointAtBlock, err)
+}
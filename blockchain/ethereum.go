@@ -50,6 +50,7 @@ type Ethereum interface {
 
 	GetAccount(common.Address) (accounts.Account, error)
 	GetAccountKeys(addr common.Address) (*keystore.Key, error)
+	GetSigner() Signer
 	GetBalance(common.Address) (*big.Int, error)
 	GetGethClient() GethClient
 	GetCoinbaseAddress() common.Address
@@ -65,6 +66,7 @@ type Ethereum interface {
 	GetValidators() ([]common.Address, error)
 
 	WaitForReceipt(context.Context, *types.Transaction) (*types.Receipt, error)
+	WaitForReceiptBefore(context.Context, *types.Transaction, uint64) (*types.Receipt, error)
 
 	RetryCount() int
 	RetryDelay() time.Duration
@@ -72,6 +74,10 @@ type Ethereum interface {
 	Timeout() time.Duration
 
 	Contracts() *Contracts
+
+	// Clone returns a view of the same connection that defaults to another
+	// account, so several validators can share a simulated chain
+	Clone(accounts.Account) Ethereum
 }
 
 // Ethereum specific errors
@@ -124,6 +130,7 @@ type ethereum struct {
 	logger         *logrus.Logger
 	endpoint       string
 	keystore       *keystore.KeyStore
+	signer         Signer
 	finalityDelay  uint64
 	accounts       map[common.Address]accounts.Account
 	coinbase       common.Address
@@ -135,6 +142,8 @@ type ethereum struct {
 	retryDelay     time.Duration
 	contracts      *Contracts
 	client         GethClient
+	endpoints      *endpointPool
+	txns           *txnTracker
 	close          func() error
 	commit         func()
 	chainID        *big.Int
@@ -194,7 +203,10 @@ func NewEthereumSimulator(
 		passcodes:     make(map[common.Address]string),
 		retryCount:    retryCount,
 		retryDelay:    retryDelay,
-		finalityDelay: uint64(finalityDelay)}
+		finalityDelay: uint64(finalityDelay),
+		// Blocks are only mined when the caller commits, so give blocking
+		// actions as long as the retries would take
+		timeout: time.Duration(retryCount) * retryDelay}
 	eth.contracts = &Contracts{eth: eth}
 
 	eth.LoadAccounts(pathKeystore)
@@ -212,8 +224,9 @@ func NewEthereumSimulator(
 
 	gasLimit := uint64(10000000000000000)
 	sim := backends.NewSimulatedBackend(genAlloc, gasLimit)
-	eth.client = sim
 	eth.chainID = big.NewInt(1337)
+	eth.txns = newTxnTracker(logger, sim, eth.chainID, nil, eth.signTxn)
+	eth.client = eth.txns
 	eth.peerCount = func(context.Context) (uint64, error) {
 		return 0, nil
 	}
@@ -232,21 +245,27 @@ func NewEthereumSimulator(
 	return eth, nil
 }
 
-// NewEthereumEndpoint creates a new Ethereum abstraction
+// NewEthereumEndpoint creates a new Ethereum abstraction. Calls are spread
+// over the given endpoints, which must all serve the same chain.
 func NewEthereumEndpoint(
-	endpoint string,
+	endpoints []string,
 	pathKeystore string,
 	pathPasscodes string,
 	defaultAccount string,
 	timeout time.Duration,
 	retryCount int,
 	retryDelay time.Duration,
-	finalityDelay int) (Ethereum, error) {
+	finalityDelay int,
+	maxGasPrice int,
+	signerURL string) (Ethereum, error) {
 
 	logger := logging.GetLogger("ethereum")
 
+	if len(endpoints) < 1 {
+		return nil, errors.New("at least 1 endpoint required")
+	}
+
 	eth := &ethereum{
-		endpoint:      endpoint,
 		logger:        logger,
 		accounts:      make(map[common.Address]accounts.Account),
 		keys:          make(map[common.Address]*keystore.Key),
@@ -258,12 +277,21 @@ func NewEthereumEndpoint(
 
 	eth.contracts = &Contracts{eth: eth}
 
-	// Load accounts + passcodes
-	eth.LoadAccounts(pathKeystore)
-	err := eth.LoadPasscodes(pathPasscodes)
-	if err != nil {
-		logger.Errorf("Error in NewEthereumEndpoint at eth.LoadPasscodes: %v", err)
-		return nil, err
+	// Load accounts + passcodes, unless a remote signer holds the keys
+	var err error
+	if signerURL == "" {
+		eth.LoadAccounts(pathKeystore)
+		err = eth.LoadPasscodes(pathPasscodes)
+		if err != nil {
+			logger.Errorf("Error in NewEthereumEndpoint at eth.LoadPasscodes: %v", err)
+			return nil, err
+		}
+	} else {
+		err = eth.LoadSignerAccounts(signerURL)
+		if err != nil {
+			logger.Errorf("Error in NewEthereumEndpoint at eth.LoadSignerAccounts: %v", err)
+			return nil, err
+		}
 	}
 
 	// Designate accounts
@@ -275,36 +303,84 @@ func NewEthereumEndpoint(
 	}
 	eth.SetDefaultAccount(acct)
 
-	// Low level rpc client
+	// Low level rpc clients
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
-	rpcClient, rpcErr := rpc.DialContext(ctx, endpoint)
-	if rpcErr != nil {
-		logger.Errorf("Error in NewEthereumEndpoint at rpc.DialContext: %v", err)
-		return nil, rpcErr
-	}
-	ethClient := ethclient.NewClient(rpcClient)
-	eth.client = ethClient
-	eth.chainID, err = ethClient.ChainID(ctx)
-	if err != nil {
-		logger.Errorf("Error in NewEthereumEndpoint at ethClient.ChainID: %v", err)
-		return nil, err
+	pool := []*endpoint{}
+	rpcClients := []*rpc.Client{}
+	for _, url := range endpoints {
+		rpcClient, rpcErr := rpc.DialContext(ctx, url)
+		if rpcErr != nil {
+			logger.Errorf("Error in NewEthereumEndpoint at rpc.DialContext(%v): %v", url, rpcErr)
+			continue
+		}
+		ethClient := ethclient.NewClient(rpcClient)
+		chainID, err := ethClient.ChainID(ctx)
+		if err != nil {
+			logger.Errorf("Error in NewEthereumEndpoint at ethClient.ChainID(%v): %v", url, err)
+			rpcClient.Close()
+			continue
+		}
+		if eth.chainID == nil {
+			eth.chainID = chainID
+			eth.endpoint = url
+
+			// Find coinbase
+			if e := rpcClient.CallContext(ctx, &eth.coinbase, "eth_coinbase"); e != nil {
+				logger.Warnf("Failed to determine coinbase: %v", e)
+			} else {
+				logger.Infof("Coinbase: %v", eth.coinbase.Hex())
+			}
+		} else if eth.chainID.Cmp(chainID) != 0 {
+			for _, c := range rpcClients {
+				c.Close()
+			}
+			rpcClient.Close()
+			return nil, fmt.Errorf("endpoint %v serves chain %v instead of %v", url, chainID, eth.chainID)
+		}
+		rpcClients = append(rpcClients, rpcClient)
+		pool = append(pool, &endpoint{
+			url:    url,
+			client: ethClient,
+			peerCount: func(ctx context.Context) (uint64, error) {
+				return eth.getPeerCount(ctx, rpcClient)
+			},
+			syncing: ethClient.SyncProgress,
+		})
 	}
-
-	eth.peerCount = func(ctx context.Context) (uint64, error) {
-		return eth.getPeerCount(ctx, rpcClient)
+	if len(pool) == 0 {
+		return nil, ErrNoEndpoint
 	}
-	eth.syncing = ethClient.SyncProgress
 
-	// Find coinbase
-	if e := rpcClient.CallContext(ctx, &eth.coinbase, "eth_coinbase"); e != nil {
-		logger.Warnf("Failed to determine coinbase: %v", e)
-	} else {
-		logger.Infof("Coinbase: %v", eth.coinbase.Hex())
+	endpointPool := newEndpointPool(logger, pool, eth.finalityDelay, timeout)
+	endpointPool.refresh()
+	endpointPool.start(constants.EthereumHealthCheckInterval)
+
+	// Gas price ceiling is configured in GWei
+	var ceiling *big.Int
+	if maxGasPrice > 0 {
+		ceiling = new(big.Int).Mul(big.NewInt(int64(maxGasPrice)), big.NewInt(1000000000))
 	}
+	eth.txns = newTxnTracker(logger, endpointPool, eth.chainID, ceiling, eth.signTxn)
+	eth.txns.start(constants.TxnCheckInterval, timeout)
+
+	eth.endpoints = endpointPool
+	eth.client = eth.txns
+	eth.peerCount = endpointPool.PeerCount
+	eth.syncing = endpointPool.SyncProgress
 
 	logger.Debug("Completed initialization")
-	eth.close = func() error { return nil }
+	eth.close = func() error {
+		eth.txns.close()
+		endpointPool.close()
+		for _, c := range rpcClients {
+			c.Close()
+		}
+		if rs, ok := eth.signer.(*remoteSigner); ok {
+			rs.Close()
+		}
+		return nil
+	}
 	eth.commit = func() {}
 
 	return eth, nil
@@ -373,6 +449,34 @@ func (eth *ethereum) LoadAccounts(directoryPath string) {
 
 	eth.accounts = accts
 	eth.keystore = ks
+	eth.signer = &keystoreSigner{keystore: ks}
+}
+
+// LoadSignerAccounts connects to a remote signer and loads the accounts it holds
+func (eth *ethereum) LoadSignerAccounts(url string) error {
+	logger := eth.logger
+
+	logger.Infof("LoadSignerAccounts(\"%v\")...", url)
+	signer, err := NewRemoteSigner(url, eth.timeout)
+	if err != nil {
+		return err
+	}
+
+	addresses, err := signer.Accounts()
+	if err != nil {
+		signer.(*remoteSigner).Close()
+		return err
+	}
+
+	accts := make(map[common.Address]accounts.Account, len(addresses))
+	for _, addr := range addresses {
+		logger.Infof("... found account %v", addr.Hex())
+		accts[addr] = accounts.Account{Address: addr, URL: accounts.URL{Scheme: "signer", Path: url}}
+	}
+
+	eth.accounts = accts
+	eth.signer = signer
+	return nil
 }
 
 // LoadPasscodes loads the specified passcode file
@@ -412,6 +516,11 @@ func (eth *ethereum) LoadPasscodes(filePath string) error {
 // UnlockAccount unlocks the previously loaded account using the previously loaded passcode
 func (eth *ethereum) UnlockAccount(acct accounts.Account) error {
 
+	// Accounts of a remote signer are unlocked there
+	if eth.keystore == nil {
+		return nil
+	}
+
 	passcode, passcodeFound := eth.passcodes[acct.Address]
 	if !passcodeFound {
 		return ErrPasscodeNotFound
@@ -461,6 +570,16 @@ func (eth *ethereum) GetAccountKeys(addr common.Address) (*keystore.Key, error)
 	return nil, ErrKeysNotFound
 }
 
+// GetSigner returns what signs for the accounts
+func (eth *ethereum) GetSigner() Signer {
+	return eth.signer
+}
+
+// signTxn signs a transaction for the account
+func (eth *ethereum) signTxn(from common.Address, txn *types.Transaction) (*types.Transaction, error) {
+	return eth.signer.SignTxn(from, txn, eth.chainID)
+}
+
 // SetDefaultAccount designates the account to be used by default
 func (eth *ethereum) SetDefaultAccount(acct accounts.Account) {
 	eth.defaultAccount = acct
@@ -487,7 +606,13 @@ func (eth *ethereum) GetBalance(addr common.Address) (*big.Int, error) {
 	return balance, nil
 }
 
+// GetEndpoint returns the endpoint currently preferred for reads
 func (eth *ethereum) GetEndpoint() string {
+	if eth.endpoints != nil {
+		if ep := eth.endpoints.best(); ep != nil {
+			return ep.url
+		}
+	}
 	return eth.endpoint
 }
 
@@ -544,23 +669,40 @@ func (eth *ethereum) RetryCount() int {
 	return eth.retryCount
 }
 
-// WaitForReceipt
+// WaitForReceipt waits for the transaction, or a replacement of it, to be mined
 func (eth *ethereum) WaitForReceipt(ctx context.Context, txn *types.Transaction) (*types.Receipt, error) {
+	return eth.WaitForReceiptBefore(ctx, txn, 0)
+}
+
+// WaitForReceiptBefore waits for the transaction, or a replacement of it, to
+// be mined. Replacements are sent sooner as the deadline approaches, a
+// deadline of 0 means there is none.
+func (eth *ethereum) WaitForReceiptBefore(ctx context.Context, txn *types.Transaction, deadline uint64) (*types.Receipt, error) {
+	eth.txns.setDeadline(txn, deadline)
 
 	count := 1
-	receipt, err := eth.client.TransactionReceipt(ctx, txn.Hash())
+	for {
+		for _, hash := range eth.txns.versions(txn) {
+			receipt, err := eth.client.TransactionReceipt(ctx, hash)
+
+			// Ugly condition, because
+			// -- Real endpoint returns err==geth.NotFound if receipt is nil
+			// -- Simulated endpoint returns err==nil and receipt==nil until commit() is called
+			if err == nil && receipt != nil {
+				eth.txns.mined(txn)
+				return receipt, nil
+			}
+			if err != nil && err != geth.NotFound {
+				return receipt, err
+			}
+		}
 
-	// Ugly condition, because
-	// -- Real endpoint returns err==geth.NotFound if receipt is nil
-	// -- Simulated endpoint returns err==nil and receipt==nil until commit() is called
-	for err == geth.NotFound || (err == nil && receipt == nil) {
 		eth.logger.Debugf("Retry #%d getting receipt for %v ...", count, txn.Hash().Hex())
 		count++
-		SleepWithContext(ctx, eth.retryDelay)
-		receipt, err = eth.client.TransactionReceipt(ctx, txn.Hash())
+		if err := SleepWithContext(ctx, eth.retryDelay); err != nil {
+			return nil, err
+		}
 	}
-
-	return receipt, err
 }
 
 func (eth *ethereum) RetryDelay() time.Duration {
@@ -572,18 +714,27 @@ func (eth *ethereum) Timeout() time.Duration {
 }
 
 func (eth *ethereum) GetTransactionOpts(ctx context.Context, account accounts.Account) (*bind.TransactOpts, error) {
-	opts, err := bind.NewKeyStoreTransactor(eth.keystore, account)
-	if err != nil {
+	if _, err := eth.GetAccount(account.Address); err != nil {
 		eth.logger.Errorf("could not create transactor for %v: %v", account.Address.Hex(), err)
-	} else {
-		opts.Context = ctx
-		opts.Nonce = nil
-		opts.Value = big.NewInt(0)
-		opts.GasLimit = uint64(0)
-		opts.GasPrice = nil
+		return nil, err
+	}
+
+	opts := &bind.TransactOpts{
+		From: account.Address,
+		Signer: func(_ types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != account.Address {
+				return nil, errors.New("not authorized to sign this account")
+			}
+			return eth.signTxn(address, tx)
+		},
+		Context:  ctx,
+		Nonce:    nil,
+		Value:    big.NewInt(0),
+		GasLimit: uint64(0),
+		GasPrice: nil,
 	}
 
-	return opts, err
+	return opts, nil
 }
 
 func (eth *ethereum) GetCallOpts(ctx context.Context, account accounts.Account) *bind.CallOpts { // TODO provide and use context
@@ -614,11 +765,10 @@ func (eth *ethereum) TransferEther(from common.Address, to common.Address, wei *
 	eth.logger.Debugf("TransferEther => chainID:%v from:%v nonce:%v, to:%v, wei:%v, gasLimit:%v, gasPrice:%v",
 		eth.chainID, from.Hex(), nonce, to.Hex(), wei, gasLimit, gasPrice)
 
-	signer := types.NewEIP155Signer(eth.chainID)
-
-	signedTx, err := types.SignTx(tx, signer, eth.keys[from].PrivateKey)
+	signedTx, err := eth.signTxn(from, tx)
 	if err != nil {
 		eth.logger.Error(err)
+		return err
 	}
 	ctx, cancel := eth.GetTimeoutContext()
 	defer cancel()
@@ -1240,7 +1390,7 @@ func (c *Contracts) DeployContracts(ctx context.Context, account accounts.Accoun
 
 // StringToBytes32 is useful for convert a Go string into a bytes32 useful calling Solidity
 func StringToBytes32(str string) (b [32]byte) {
-	copy(b[:], []byte(str)[0:32])
+	copy(b[:], str)
 	return
 }
 
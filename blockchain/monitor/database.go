@@ -12,6 +12,7 @@ import (
 )
 
 var stateKey = []byte("monitorStateKey")
+var ethdkgKey = []byte("monitorEthDKGKey")
 
 // Database describes required functionality for monitor persistence
 type Database interface {
@@ -72,7 +73,17 @@ func (mon *monitorDB) FindState() (*State, error) {
 		if err != nil {
 			return err
 		}
-		return nil
+
+		// ETHDKG is stored separately since its secrets and tasks can't be gob encoded
+		state.ethdkg = NewEthDKGState()
+		data, err = utils.GetValue(txn, ethdkgKey)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		return state.ethdkg.UnmarshalBinary(data)
 	}
 
 	err := mon.database.View(fn)
@@ -93,7 +104,21 @@ func (mon *monitorDB) UpdateState(state *State) error {
 		return err
 	}
 
+	var ethdkg []byte
+	if state.ethdkg != nil {
+		ethdkg, err = state.ethdkg.MarshalBinary()
+		if err != nil {
+			return err
+		}
+	}
+
 	fn := func(txn *badger.Txn) error {
+		if ethdkg != nil {
+			err := utils.SetValue(txn, ethdkgKey, ethdkg)
+			if err != nil {
+				return err
+			}
+		}
 		return utils.SetValue(txn, stateKey, buf.Bytes())
 	}
 
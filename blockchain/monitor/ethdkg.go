@@ -0,0 +1,319 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/MadBase/MadNet/blockchain/dkg"
+	"github.com/MadBase/MadNet/blockchain/tasks"
+	"github.com/MadBase/MadNet/blockchain/tasks/dkgtasks"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ethdkgSecretsName is the name of the encrypted store entry holding the
+// local secrets of the current ETHDKG round
+var ethdkgSecretsName = []byte("monitorEthDKGSecrets")
+
+// ethdkgPhases lists the phases in the order they run
+var ethdkgPhases = []EthDKGPhase{
+	Registration,
+	ShareDistribution,
+	Dispute,
+	KeyShareSubmission,
+	MPKSubmission,
+	GPKJSubmission,
+	GPKJGroupAccusation,
+	Complete}
+
+// ethDKGRecord is the persisted form of an EthDKGState. Secrets are kept out
+// of it and task handlers are reduced to their status.
+type ethDKGRecord struct {
+	Phase                       EthDKGPhase
+	Address                     common.Address
+	Index                       int
+	GroupPublicKey              [4]*big.Int
+	GroupSignature              [2]*big.Int
+	MasterPublicKey             [4]*big.Int
+	NumberOfValidators          int
+	Schedule                    *EthDKGSchedule
+	ValidatorThreshold          int
+	TransportPublicKey          [2]*big.Int
+	Commitments                 map[common.Address][][2]*big.Int
+	EncryptedShares             map[common.Address][]*big.Int
+	KeyShareG1s                 map[common.Address][2]*big.Int
+	KeyShareG1CorrectnessProofs map[common.Address][2]*big.Int
+	KeyShareG2s                 map[common.Address][4]*big.Int
+	Participants                dkg.ParticipantList
+	Tasks                       map[EthDKGPhase]EthDKGTaskStatus
+}
+
+// ethDKGSecrets are the local secrets of an ETHDKG round. They are written
+// to the admin handler's encrypted store.
+type ethDKGSecrets struct {
+	TransportPrivateKey *big.Int
+	PrivateCoefficients []*big.Int
+	GroupPrivateKey     *big.Int
+}
+
+// recoveredTaskHandler stands in for the handler of a task started before
+// a restart until the task is started again
+type recoveredTaskHandler struct {
+	status EthDKGTaskStatus
+}
+
+func (th *recoveredTaskHandler) Cancel() {}
+
+func (th *recoveredTaskHandler) Start() {}
+
+func (th *recoveredTaskHandler) Complete() bool {
+	return th.status != TaskPending
+}
+
+func (th *recoveredTaskHandler) Successful() bool {
+	return th.status == TaskSucceeded
+}
+
+// TaskHandler returns the field holding the task handler of a phase
+func (ethdkg *EthDKGState) TaskHandler(phase EthDKGPhase) *tasks.TaskHandler {
+	switch phase {
+	case Registration:
+		return &ethdkg.RegistrationTH
+	case ShareDistribution:
+		return &ethdkg.ShareDistributionTH
+	case Dispute:
+		return &ethdkg.DisputeTH
+	case KeyShareSubmission:
+		return &ethdkg.KeyShareSubmissionTH
+	case MPKSubmission:
+		return &ethdkg.MPKSubmissionTH
+	case GPKJSubmission:
+		return &ethdkg.GPKJSubmissionTH
+	case GPKJGroupAccusation:
+		return &ethdkg.GPKJGroupAccusationTH
+	case Complete:
+		return &ethdkg.CompleteTH
+	}
+	return nil
+}
+
+// TaskStatus returns how far the task of a phase got
+func (ethdkg *EthDKGState) TaskStatus(phase EthDKGPhase) EthDKGTaskStatus {
+	th := ethdkg.TaskHandler(phase)
+	if th == nil || *th == nil {
+		return TaskNotStarted
+	}
+	if (*th).Successful() {
+		return TaskSucceeded
+	}
+	if (*th).Complete() {
+		return TaskFailed
+	}
+	return TaskPending
+}
+
+// MarshalBinary returns the persisted form of the state without its secrets
+func (ethdkg *EthDKGState) MarshalBinary() ([]byte, error) {
+	rec := &ethDKGRecord{
+		Phase:                       ethdkg.Phase,
+		Address:                     ethdkg.Address,
+		Index:                       ethdkg.Index,
+		GroupPublicKey:              ethdkg.GroupPublicKey,
+		GroupSignature:              ethdkg.GroupSignature,
+		MasterPublicKey:             ethdkg.MasterPublicKey,
+		NumberOfValidators:          ethdkg.NumberOfValidators,
+		Schedule:                    ethdkg.Schedule,
+		ValidatorThreshold:          ethdkg.ValidatorThreshold,
+		TransportPublicKey:          ethdkg.TransportPublicKey,
+		Commitments:                 ethdkg.Commitments,
+		EncryptedShares:             ethdkg.EncryptedShares,
+		KeyShareG1s:                 ethdkg.KeyShareG1s,
+		KeyShareG1CorrectnessProofs: ethdkg.KeyShareG1CorrectnessProofs,
+		KeyShareG2s:                 ethdkg.KeyShareG2s,
+		Participants:                ethdkg.Participants,
+		Tasks:                       make(map[EthDKGPhase]EthDKGTaskStatus),
+	}
+	for _, phase := range ethdkgPhases {
+		if status := ethdkg.TaskStatus(phase); status != TaskNotStarted {
+			rec.Tasks[phase] = status
+		}
+	}
+	return json.Marshal(rec)
+}
+
+// UnmarshalBinary restores a state written by MarshalBinary. Tasks come back
+// as placeholders reporting their old status; secrets must be restored
+// separately.
+func (ethdkg *EthDKGState) UnmarshalBinary(data []byte) error {
+	rec := &ethDKGRecord{}
+	err := json.Unmarshal(data, rec)
+	if err != nil {
+		return err
+	}
+
+	*ethdkg = *NewEthDKGState()
+	ethdkg.Phase = rec.Phase
+	ethdkg.Address = rec.Address
+	ethdkg.Index = rec.Index
+	ethdkg.GroupPublicKey = rec.GroupPublicKey
+	ethdkg.GroupSignature = rec.GroupSignature
+	ethdkg.MasterPublicKey = rec.MasterPublicKey
+	ethdkg.NumberOfValidators = rec.NumberOfValidators
+	ethdkg.ValidatorThreshold = rec.ValidatorThreshold
+	ethdkg.TransportPublicKey = rec.TransportPublicKey
+	ethdkg.Participants = rec.Participants
+	if rec.Schedule != nil {
+		ethdkg.Schedule = rec.Schedule
+	}
+	if rec.Commitments != nil {
+		ethdkg.Commitments = rec.Commitments
+	}
+	if rec.EncryptedShares != nil {
+		ethdkg.EncryptedShares = rec.EncryptedShares
+	}
+	if rec.KeyShareG1s != nil {
+		ethdkg.KeyShareG1s = rec.KeyShareG1s
+	}
+	if rec.KeyShareG1CorrectnessProofs != nil {
+		ethdkg.KeyShareG1CorrectnessProofs = rec.KeyShareG1CorrectnessProofs
+	}
+	if rec.KeyShareG2s != nil {
+		ethdkg.KeyShareG2s = rec.KeyShareG2s
+	}
+	for phase, status := range rec.Tasks {
+		th := ethdkg.TaskHandler(phase)
+		if th == nil {
+			return fmt.Errorf("unknown ETHDKG phase %v", phase)
+		}
+		*th = &recoveredTaskHandler{status: status}
+	}
+	return nil
+}
+
+// blockProcessors returns the block handlers driving a round of ETHDKG
+func (svcs *Services) blockProcessors(schedule *EthDKGSchedule) map[uint64]func(*State, uint64) error {
+	// TODO associate names with these also to help with debugging/logging
+	ib := make(map[uint64]func(*State, uint64) error)
+	ib[schedule.ShareDistributionStart] = svcs.DoDistributeShares      // Do ShareDistribution
+	ib[schedule.DisputeStart] = svcs.DoSubmitDispute                   // Do Disputes
+	ib[schedule.KeyShareSubmissionStart] = svcs.DoSubmitKeyShare       // Do KeyShareSubmission
+	ib[schedule.MPKSubmissionStart] = svcs.DoSubmitMasterPublicKey     // Do MPKSubmission
+	ib[schedule.GPKJSubmissionStart] = svcs.DoSubmitGPKj               // Do GPKJSubmission
+	ib[schedule.GPKJGroupAccusationStart] = svcs.DoGroupAccusationGPKj // Do GPKJDisputes
+	ib[schedule.CompleteStart] = svcs.DoSuccessfulCompletion           // Do SuccessfulCompletion
+	return ib
+}
+
+// startTask builds the task for a phase from what is recorded in the
+// state and starts it
+func (svcs *Services) startTask(ethdkg *EthDKGState, phase EthDKGPhase) error {
+	eth := svcs.eth
+	acct := eth.GetDefaultAccount()
+	addr := ethdkg.Address
+	schedule := ethdkg.Schedule
+
+	var task tasks.Task
+	switch phase {
+	case Registration:
+		task = dkgtasks.NewRegisterTask(logging.GetLogger("rt"), eth, acct,
+			ethdkg.TransportPublicKey,
+			schedule.RegistrationEnd)
+	case ShareDistribution:
+		task = dkgtasks.NewShareDistributionTask(logging.GetLogger("sdt"), eth, acct,
+			ethdkg.TransportPublicKey, ethdkg.EncryptedShares[addr], ethdkg.Commitments[addr],
+			schedule.RegistrationEnd, schedule.ShareDistributionEnd)
+	case Dispute:
+		task = dkgtasks.NewDisputeTask(logging.GetLogger("dispute"), eth, acct,
+			ethdkg.TransportPublicKey,
+			schedule.RegistrationEnd, schedule.DisputeEnd)
+	case KeyShareSubmission:
+		task = dkgtasks.NewKeyshareSubmissionTask(logging.GetLogger("kst"), eth, acct,
+			ethdkg.TransportPublicKey, ethdkg.KeyShareG1s[addr], ethdkg.KeyShareG1CorrectnessProofs[addr], ethdkg.KeyShareG2s[addr],
+			schedule.RegistrationEnd, schedule.KeyShareSubmissionEnd)
+	case MPKSubmission:
+		task = dkgtasks.NewMPKSubmissionTask(svcs.logger, eth, acct,
+			ethdkg.TransportPublicKey, ethdkg.MasterPublicKey,
+			schedule.RegistrationEnd, schedule.MPKSubmissionEnd)
+	case GPKJSubmission:
+		task = dkgtasks.NewGPKSubmissionTask(svcs.logger, eth, acct,
+			ethdkg.TransportPublicKey, ethdkg.GroupPublicKey, ethdkg.GroupSignature,
+			schedule.RegistrationEnd, schedule.GPKJSubmissionEnd)
+	case Complete:
+		task = dkgtasks.NewCompletionTask(svcs.logger, eth, acct,
+			ethdkg.TransportPublicKey,
+			schedule.RegistrationEnd, schedule.CompleteEnd)
+	default:
+		return fmt.Errorf("no task for ETHDKG phase %v", phase)
+	}
+
+	th := svcs.taskMan.NewTaskHandler(eth.Timeout(), eth.RetryDelay(), task)
+	*ethdkg.TaskHandler(phase) = th
+	ethdkg.Phase = phase
+	th.Start()
+
+	return nil
+}
+
+// persistSecrets writes the local secrets of the current round to the
+// encrypted store
+func (svcs *Services) persistSecrets(ethdkg *EthDKGState) error {
+	data, err := json.Marshal(&ethDKGSecrets{
+		TransportPrivateKey: ethdkg.TransportPrivateKey,
+		PrivateCoefficients: ethdkg.PrivateCoefficients,
+		GroupPrivateKey:     ethdkg.GroupPrivateKey,
+	})
+	if err != nil {
+		return err
+	}
+	return svcs.ah.SetPrivK(ethdkgSecretsName, data)
+}
+
+// RecoverETHDKG picks up a round of ETHDKG restored from the database. The
+// secrets are read back from the encrypted store, the block handlers are
+// rebuilt from the schedule and tasks still pending are started again.
+func (svcs *Services) RecoverETHDKG(state *State) error {
+	state.interestingBlocks = make(map[uint64]func(*State, uint64) error)
+	if state.ethdkg == nil {
+		state.ethdkg = NewEthDKGState()
+		return nil
+	}
+
+	ethdkg := state.ethdkg
+	if !ETHDKGInProgress(ethdkg, state.HighestBlockProcessed+1) {
+		return nil
+	}
+
+	data, err := svcs.ah.GetPrivK(ethdkgSecretsName)
+	if err != nil {
+		state.ethdkg = NewEthDKGState()
+		return fmt.Errorf("could not load ETHDKG secrets: %v", err)
+	}
+	secrets := &ethDKGSecrets{}
+	err = json.Unmarshal(data, secrets)
+	if err != nil {
+		state.ethdkg = NewEthDKGState()
+		return fmt.Errorf("could not decode ETHDKG secrets: %v", err)
+	}
+	ethdkg.TransportPrivateKey = secrets.TransportPrivateKey
+	ethdkg.PrivateCoefficients = secrets.PrivateCoefficients
+	if len(secrets.PrivateCoefficients) > 0 {
+		ethdkg.SecretValue = secrets.PrivateCoefficients[0]
+	}
+	ethdkg.GroupPrivateKey = secrets.GroupPrivateKey
+
+	svcs.logger.Infof("Recovering ETHDKG in phase %v with schedule %+v", ethdkg.Phase, *ethdkg.Schedule)
+	state.interestingBlocks = svcs.blockProcessors(ethdkg.Schedule)
+
+	for _, phase := range ethdkgPhases {
+		if ethdkg.TaskStatus(phase) == TaskPending {
+			svcs.logger.Infof("Restarting ETHDKG task for phase %v", phase)
+			err := svcs.startTask(ethdkg, phase)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
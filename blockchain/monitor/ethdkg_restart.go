@@ -0,0 +1,136 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrETHDKGStalled is the reason given for a round of ETHDKG that passed
+// its deadline without producing a validator set
+var ErrETHDKGStalled = errors.New("ETHDKG passed its deadline without completing")
+
+// ethdkgRestartBackoff is how many blocks to wait before restarting ETHDKG
+// after the given number of consecutive failures
+func ethdkgRestartBackoff(failures uint32) uint64 {
+	backoff := uint64(constants.ETHDKGRestartBlocks)
+	for i := uint32(1); i < failures && backoff < constants.ETHDKGRestartMaxBlocks; i++ {
+		backoff *= 2
+	}
+	if backoff > constants.ETHDKGRestartMaxBlocks {
+		backoff = constants.ETHDKGRestartMaxBlocks
+	}
+	return backoff
+}
+
+// ethdkgStalled indicates the round of ETHDKG being followed is past its
+// deadline and no validator set came out of it
+func ethdkgStalled(state *State, block uint64) bool {
+	ethdkg := state.ethdkg
+	if ethdkg == nil || ethdkg.Schedule == nil || ethdkg.Schedule.CompleteEnd == 0 {
+		return false
+	}
+	if block <= ethdkg.Schedule.CompleteEnd {
+		return false
+	}
+	if ethdkg.TaskStatus(Complete) == TaskSucceeded {
+		return false
+	}
+	return state.EthDKGCompletedBlock < ethdkg.Schedule.RegistrationStart
+}
+
+// scheduleETHDKGRestart counts a failure and picks the block a new round of
+// ETHDKG is asked for at. Validators failing together shouldn't all ask at
+// once, so up to half the backoff again is added at random.
+func scheduleETHDKGRestart(state *State, after uint64) {
+	state.EthDKGFailures++
+	backoff := ethdkgRestartBackoff(state.EthDKGFailures)
+	state.EthDKGRestartBlock = after + backoff + uint64(rand.Int63n(int64(backoff/2+1)))
+}
+
+// failETHDKG gives up on the current round of ETHDKG. Everything started
+// for it is cancelled and a new round is scheduled.
+func (svcs *Services) failETHDKG(state *State, reason error) {
+	after := state.HighestBlockProcessed
+	if ethdkg := state.ethdkg; ethdkg != nil {
+		if ethdkg.Schedule != nil && ethdkg.Schedule.CompleteEnd > after {
+			after = ethdkg.Schedule.CompleteEnd
+		}
+		AbortETHDKG(ethdkg)
+	}
+	svcs.taskMan.CancelTasks()
+
+	state.ethdkg = NewEthDKGState()
+	state.interestingBlocks = make(map[uint64]func(*State, uint64) error)
+
+	scheduleETHDKGRestart(state, after)
+
+	svcs.logger.WithFields(logrus.Fields{
+		"Reason":       reason,
+		"Failures":     state.EthDKGFailures,
+		"RestartBlock": state.EthDKGRestartBlock,
+	}).Warn("ETHDKG failed")
+}
+
+// completeETHDKG records that a round of ETHDKG produced a validator set
+func (svcs *Services) completeETHDKG(state *State, block uint64) {
+	if state.EthDKGFailures > 0 || state.EthDKGRestartBlock > 0 {
+		svcs.logger.WithFields(logrus.Fields{
+			"Failures": state.EthDKGFailures,
+			"Block":    block,
+		}).Info("ETHDKG recovered")
+	}
+	state.EthDKGCompletedBlock = block
+	state.EthDKGFailures = 0
+	state.EthDKGRestartBlock = 0
+}
+
+// restartETHDKG asks the ETHDKG contract for a new round once the restart
+// block is reached. The contract decides if this node is permitted to, so
+// a refusal only postpones the next attempt.
+func (svcs *Services) restartETHDKG(ctx context.Context, state *State) {
+	if state.EthDKGRestartBlock == 0 || state.HighestBlockProcessed < state.EthDKGRestartBlock {
+		return
+	}
+
+	eth := svcs.eth
+	c := eth.Contracts()
+	acct := eth.GetDefaultAccount()
+	logger := svcs.logger.WithFields(logrus.Fields{
+		"Failures":     state.EthDKGFailures,
+		"RestartBlock": state.EthDKGRestartBlock,
+	})
+
+	// Somebody else might have started a round already
+	currentHeight, err := eth.GetCurrentHeight(ctx)
+	if err != nil {
+		logger.Warnf("Could not get current height to restart ETHDKG: %v", err)
+		return
+	}
+	completes, err := c.Ethdkg.TDKGCOMPLETE(eth.GetCallOpts(ctx, acct))
+	if err != nil {
+		logger.Warnf("Could not check for a round of ETHDKG in progress: %v", err)
+		return
+	}
+	if completes.IsUint64() && completes.Uint64() >= currentHeight {
+		logger.Infof("ETHDKG round in progress until block %v; not restarting", completes)
+		state.EthDKGRestartBlock = completes.Uint64() + 1
+		return
+	}
+
+	txnOpts, err := eth.GetTransactionOpts(ctx, acct)
+	if err != nil {
+		logger.Warnf("Could not build transaction options to restart ETHDKG: %v", err)
+	} else if txn, err := c.Ethdkg.InitializeState(txnOpts); err != nil {
+		logger.Warnf("Could not restart ETHDKG: %v", err)
+	} else {
+		logger.Infof("Asked for a new round of ETHDKG in txn %v", txn.Hash().Hex())
+	}
+
+	// Try again if the round doesn't open
+	scheduleETHDKGRestart(state, state.HighestBlockProcessed)
+	logger.WithField("NextRestartBlock", state.EthDKGRestartBlock).Info("ETHDKG restart attempted")
+}
@@ -0,0 +1,184 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EthDKGStatus is a view of the ETHDKG round for operators
+type EthDKGStatus struct {
+	Phase          EthDKGPhase
+	Address        common.Address
+	CurrentBlock   uint64 // Latest Ethereum block
+	ProcessedBlock uint64 // Latest block processed by the monitor
+	Schedule       EthDKGSchedule
+	Tasks          map[EthDKGPhase]EthDKGTaskStatus
+	Participants   []EthDKGParticipantStatus
+	Failures       uint32 // Consecutive rounds that failed
+	RestartBlock   uint64 // Block a new round will be asked for at
+}
+
+// EthDKGParticipantStatus is what the ETHDKG contract recorded for a participant
+type EthDKGParticipantStatus struct {
+	Address           common.Address
+	Index             int
+	SharesDistributed bool
+	KeyShareSubmitted bool
+	GPKjSubmitted     bool
+	Malicious         bool // Accused and found malicious
+}
+
+func (phase EthDKGPhase) String() string {
+	switch phase {
+	case Registration:
+		return "Registration"
+	case ShareDistribution:
+		return "ShareDistribution"
+	case Dispute:
+		return "Dispute"
+	case KeyShareSubmission:
+		return "KeyShareSubmission"
+	case MPKSubmission:
+		return "MPKSubmission"
+	case GPKJSubmission:
+		return "GPKJSubmission"
+	case GPKJGroupAccusation:
+		return "GPKJGroupAccusation"
+	case Complete:
+		return "Complete"
+	}
+	return "Unknown"
+}
+
+func (status EthDKGTaskStatus) String() string {
+	switch status {
+	case TaskNotStarted:
+		return "NotStarted"
+	case TaskPending:
+		return "Pending"
+	case TaskSucceeded:
+		return "Succeeded"
+	case TaskFailed:
+		return "Failed"
+	}
+	return "Unknown"
+}
+
+// EthDKGPhases lists the phases in the order they run
+func EthDKGPhases() []EthDKGPhase {
+	return append([]EthDKGPhase{}, ethdkgPhases...)
+}
+
+// Window returns the blocks the phase starts and ends at
+func (schedule *EthDKGSchedule) Window(phase EthDKGPhase) (uint64, uint64) {
+	switch phase {
+	case Registration:
+		return schedule.RegistrationStart, schedule.RegistrationEnd
+	case ShareDistribution:
+		return schedule.ShareDistributionStart, schedule.ShareDistributionEnd
+	case Dispute:
+		return schedule.DisputeStart, schedule.DisputeEnd
+	case KeyShareSubmission:
+		return schedule.KeyShareSubmissionStart, schedule.KeyShareSubmissionEnd
+	case MPKSubmission:
+		return schedule.MPKSubmissionStart, schedule.MPKSubmissionEnd
+	case GPKJSubmission:
+		return schedule.GPKJSubmissionStart, schedule.GPKJSubmissionEnd
+	case GPKJGroupAccusation:
+		return schedule.GPKJGroupAccusationStart, schedule.GPKJGroupAccusationEnd
+	case Complete:
+		return schedule.CompleteStart, schedule.CompleteEnd
+	}
+	return 0, 0
+}
+
+// publishEthDKG keeps a copy of the ETHDKG state for EthDKGStatus, since
+// the state itself belongs to the monitor's event loop
+func (svcs *Services) publishEthDKG(state *State) {
+	status := &EthDKGStatus{
+		ProcessedBlock: state.HighestBlockProcessed,
+		Failures:       state.EthDKGFailures,
+		RestartBlock:   state.EthDKGRestartBlock,
+		Tasks:          make(map[EthDKGPhase]EthDKGTaskStatus),
+	}
+	if ethdkg := state.ethdkg; ethdkg != nil {
+		status.Phase = ethdkg.Phase
+		status.Address = ethdkg.Address
+		if ethdkg.Schedule != nil {
+			status.Schedule = *ethdkg.Schedule
+		}
+		for _, phase := range ethdkgPhases {
+			status.Tasks[phase] = ethdkg.TaskStatus(phase)
+		}
+	}
+
+	svcs.statusLock.Lock()
+	defer svcs.statusLock.Unlock()
+	svcs.ethdkgStatus = status
+}
+
+// EthDKGStatus reports the ETHDKG phase and schedule as the monitor last saw
+// them along with what the ETHDKG contract recorded for each participant
+func (svcs *Services) EthDKGStatus(ctx context.Context) (*EthDKGStatus, error) {
+	status := &EthDKGStatus{Tasks: make(map[EthDKGPhase]EthDKGTaskStatus)}
+	svcs.statusLock.Lock()
+	if svcs.ethdkgStatus != nil {
+		*status = *svcs.ethdkgStatus
+		status.Tasks = make(map[EthDKGPhase]EthDKGTaskStatus)
+		for phase, taskStatus := range svcs.ethdkgStatus.Tasks {
+			status.Tasks[phase] = taskStatus
+		}
+	}
+	svcs.statusLock.Unlock()
+
+	eth := svcs.eth
+	c := eth.Contracts()
+	if status.Address == (common.Address{}) {
+		status.Address = eth.GetDefaultAccount().Address
+	}
+
+	var err error
+	status.CurrentBlock, err = eth.GetCurrentHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	callOpts := eth.GetCallOpts(ctx, eth.GetDefaultAccount())
+	participants, _, err := RetrieveParticipants(eth, callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, participant := range participants {
+		ps := EthDKGParticipantStatus{Address: participant.Address, Index: participant.Index}
+
+		hash, err := c.Ethdkg.ShareDistributionHashes(callOpts, participant.Address)
+		if err != nil {
+			return nil, err
+		}
+		ps.SharesDistributed = hash != [32]byte{}
+
+		keyShare, err := c.Ethdkg.KeyShares(callOpts, participant.Address, common.Big0)
+		if err != nil {
+			return nil, err
+		}
+		ps.KeyShareSubmitted = keyShare.Sign() != 0
+
+		gpkj, err := c.Ethdkg.GpkjSubmissions(callOpts, participant.Address, big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		ps.GPKjSubmitted = gpkj.Sign() != 0
+
+		ps.Malicious, err = c.Ethdkg.IsMalicious(callOpts, participant.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		status.Participants = append(status.Participants, ps)
+	}
+
+	return status, nil
+}
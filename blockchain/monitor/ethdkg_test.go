@@ -0,0 +1,286 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/blockchain/dkg"
+	"github.com/MadBase/MadNet/blockchain/tasks"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type testAdminHandler struct {
+	secrets map[string][]byte
+	removed []uint32
+}
+
+func (ah *testAdminHandler) AddValidatorSet(*objs.ValidatorSet) error               { return nil }
+func (ah *testAdminHandler) AddSnapshot(*objs.BlockHeader, bool) error              { return nil }
+func (ah *testAdminHandler) AddPrivateKey([]byte, constants.CurveSpec) error        { return nil }
+func (ah *testAdminHandler) RegisterSnapshotCallback(func(*objs.BlockHeader) error) {}
+func (ah *testAdminHandler) SetSynchronized(bool)                                   {}
+
+func (ah *testAdminHandler) RemoveValidatorSet(notBefore uint32) error {
+	ah.removed = append(ah.removed, notBefore)
+	return nil
+}
+
+func (ah *testAdminHandler) SetPrivK(name []byte, privk []byte) error {
+	ah.secrets[string(name)] = privk
+	return nil
+}
+
+func (ah *testAdminHandler) GetPrivK(name []byte) ([]byte, error) {
+	privk, ok := ah.secrets[string(name)]
+	if !ok {
+		return nil, badger.ErrKeyNotFound
+	}
+	return privk, nil
+}
+
+func testEthDKGState() *EthDKGState {
+	addr := common.HexToAddress("0x546F99F244b7B58B855330AE0E2BC1b30b41302F")
+	ethdkg := NewEthDKGState()
+	ethdkg.Phase = ShareDistribution
+	ethdkg.Address = addr
+	ethdkg.Index = 1
+	ethdkg.NumberOfValidators = 4
+	ethdkg.ValidatorThreshold = 2
+	ethdkg.TransportPrivateKey = big.NewInt(17)
+	ethdkg.TransportPublicKey = [2]*big.Int{big.NewInt(1), big.NewInt(2)}
+	ethdkg.PrivateCoefficients = []*big.Int{big.NewInt(3), big.NewInt(5), big.NewInt(7)}
+	ethdkg.SecretValue = ethdkg.PrivateCoefficients[0]
+	ethdkg.Participants = dkg.ParticipantList{{Address: addr, Index: 1, PublicKey: ethdkg.TransportPublicKey}}
+	ethdkg.Commitments[addr] = [][2]*big.Int{{big.NewInt(11), big.NewInt(13)}}
+	ethdkg.EncryptedShares[addr] = []*big.Int{big.NewInt(19), big.NewInt(23)}
+	ethdkg.Schedule = &EthDKGSchedule{
+		RegistrationStart:        100,
+		RegistrationEnd:          110,
+		ShareDistributionStart:   111,
+		ShareDistributionEnd:     120,
+		DisputeStart:             121,
+		DisputeEnd:               130,
+		KeyShareSubmissionStart:  131,
+		KeyShareSubmissionEnd:    140,
+		MPKSubmissionStart:       141,
+		MPKSubmissionEnd:         150,
+		GPKJSubmissionStart:      151,
+		GPKJSubmissionEnd:        160,
+		GPKJGroupAccusationStart: 161,
+		GPKJGroupAccusationEnd:   170,
+		CompleteStart:            171,
+		CompleteEnd:              180,
+	}
+	ethdkg.RegistrationTH = &recoveredTaskHandler{status: TaskSucceeded}
+	ethdkg.ShareDistributionTH = &recoveredTaskHandler{status: TaskFailed}
+	return ethdkg
+}
+
+func TestEthDKGStateMarshal(t *testing.T) {
+	ethdkg := testEthDKGState()
+
+	data, err := ethdkg.MarshalBinary()
+	assert.Nil(t, err)
+
+	ethdkg2 := &EthDKGState{}
+	err = ethdkg2.UnmarshalBinary(data)
+	assert.Nil(t, err)
+
+	assert.Equal(t, ShareDistribution, ethdkg2.Phase)
+	assert.Equal(t, ethdkg.Address, ethdkg2.Address)
+	assert.Equal(t, 1, ethdkg2.Index)
+	assert.Equal(t, *ethdkg.Schedule, *ethdkg2.Schedule)
+	assert.Equal(t, ethdkg.TransportPublicKey, ethdkg2.TransportPublicKey)
+	assert.Equal(t, ethdkg.Commitments, ethdkg2.Commitments)
+	assert.Equal(t, ethdkg.EncryptedShares, ethdkg2.EncryptedShares)
+	assert.Equal(t, ethdkg.Participants, ethdkg2.Participants)
+	assert.Nil(t, ethdkg2.GroupPublicKey[0])
+
+	// Secrets are never written in the clear
+	assert.Nil(t, ethdkg2.TransportPrivateKey)
+	assert.Nil(t, ethdkg2.PrivateCoefficients)
+	assert.Nil(t, ethdkg2.SecretValue)
+
+	assert.Equal(t, TaskSucceeded, ethdkg2.TaskStatus(Registration))
+	assert.Equal(t, TaskFailed, ethdkg2.TaskStatus(ShareDistribution))
+	assert.Equal(t, TaskNotStarted, ethdkg2.TaskStatus(Dispute))
+}
+
+func TestDBETHDKG(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	database := NewDatabase(ctx, "", true)
+
+	state := &State{HighestBlockProcessed: 115, ethdkg: testEthDKGState()}
+	err := database.UpdateState(state)
+	assert.Nil(t, err)
+
+	state2, err := database.FindState()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(115), state2.HighestBlockProcessed)
+	assert.NotNil(t, state2.ethdkg)
+	assert.Equal(t, ShareDistribution, state2.ethdkg.Phase)
+	assert.Nil(t, state2.interestingBlocks)
+}
+
+func TestRecoverETHDKG(t *testing.T) {
+	ah := &testAdminHandler{secrets: make(map[string][]byte)}
+	svcs := &Services{ah: ah, logger: logging.GetLogger("services")}
+
+	ethdkg := testEthDKGState()
+	err := svcs.persistSecrets(ethdkg)
+	assert.Nil(t, err)
+
+	data, err := ethdkg.MarshalBinary()
+	assert.Nil(t, err)
+	state := &State{HighestBlockProcessed: 115, ethdkg: &EthDKGState{}}
+	err = state.ethdkg.UnmarshalBinary(data)
+	assert.Nil(t, err)
+
+	err = svcs.RecoverETHDKG(state)
+	assert.Nil(t, err)
+	assert.Equal(t, ethdkg.TransportPrivateKey, state.ethdkg.TransportPrivateKey)
+	assert.Equal(t, ethdkg.PrivateCoefficients, state.ethdkg.PrivateCoefficients)
+	assert.Equal(t, ethdkg.SecretValue, state.ethdkg.SecretValue)
+	assert.Len(t, state.interestingBlocks, 7)
+	assert.Contains(t, state.interestingBlocks, ethdkg.Schedule.DisputeStart)
+	assert.True(t, state.ethdkg.RegistrationTH.Successful())
+	assert.False(t, state.ethdkg.ShareDistributionTH.Successful())
+}
+
+func TestRecoverETHDKGMissingSecrets(t *testing.T) {
+	ah := &testAdminHandler{secrets: make(map[string][]byte)}
+	svcs := &Services{ah: ah, logger: logging.GetLogger("services")}
+
+	state := &State{HighestBlockProcessed: 115, ethdkg: testEthDKGState()}
+	err := svcs.RecoverETHDKG(state)
+	assert.NotNil(t, err)
+	assert.False(t, ETHDKGInProgress(state.ethdkg, 116))
+	assert.NotNil(t, state.interestingBlocks)
+	assert.Len(t, state.interestingBlocks, 0)
+}
+
+func TestRecoverETHDKGFinished(t *testing.T) {
+	ah := &testAdminHandler{secrets: make(map[string][]byte)}
+	svcs := &Services{ah: ah, logger: logging.GetLogger("services")}
+
+	state := &State{HighestBlockProcessed: 500, ethdkg: testEthDKGState()}
+	err := svcs.RecoverETHDKG(state)
+	assert.Nil(t, err)
+	assert.Len(t, state.interestingBlocks, 0)
+}
+
+func TestPublishEthDKG(t *testing.T) {
+	svcs := &Services{}
+	state := &State{HighestBlockProcessed: 115, ethdkg: testEthDKGState()}
+
+	svcs.publishEthDKG(state)
+	status := svcs.ethdkgStatus
+	assert.Equal(t, ShareDistribution, status.Phase)
+	assert.Equal(t, state.ethdkg.Address, status.Address)
+	assert.Equal(t, uint64(115), status.ProcessedBlock)
+	assert.Equal(t, TaskSucceeded, status.Tasks[Registration])
+	assert.Equal(t, TaskFailed, status.Tasks[ShareDistribution])
+	assert.Equal(t, TaskNotStarted, status.Tasks[Dispute])
+
+	// Later changes to the state don't show until published again
+	state.ethdkg.Schedule.DisputeEnd = 135
+	start, end := status.Schedule.Window(Dispute)
+	assert.Equal(t, uint64(121), start)
+	assert.Equal(t, uint64(130), end)
+
+	assert.Equal(t, "ShareDistribution", status.Phase.String())
+	assert.Equal(t, "Failed", status.Tasks[ShareDistribution].String())
+	assert.Len(t, EthDKGPhases(), 8)
+}
+
+type blockingTask struct{}
+
+func (t *blockingTask) DoDone()                          {}
+func (t *blockingTask) DoRetry(ctx context.Context) bool { return t.DoWork(ctx) }
+func (t *blockingTask) ShouldRetry(context.Context) bool { return false }
+
+func (t *blockingTask) DoWork(ctx context.Context) bool {
+	<-ctx.Done()
+	return false
+}
+
+func TestEthDKGRestartBackoff(t *testing.T) {
+	assert.Equal(t, uint64(constants.ETHDKGRestartBlocks), ethdkgRestartBackoff(1))
+	assert.Equal(t, uint64(2*constants.ETHDKGRestartBlocks), ethdkgRestartBackoff(2))
+	assert.Equal(t, uint64(4*constants.ETHDKGRestartBlocks), ethdkgRestartBackoff(3))
+	assert.Equal(t, uint64(constants.ETHDKGRestartMaxBlocks), ethdkgRestartBackoff(64))
+}
+
+func TestEthDKGStalled(t *testing.T) {
+	state := &State{ethdkg: testEthDKGState()}
+	assert.False(t, ethdkgStalled(state, 180))
+	assert.True(t, ethdkgStalled(state, 181))
+
+	// A validator set came out of the round
+	state.EthDKGCompletedBlock = 175
+	assert.False(t, ethdkgStalled(state, 181))
+
+	state.EthDKGCompletedBlock = 0
+	state.ethdkg.CompleteTH = &recoveredTaskHandler{status: TaskSucceeded}
+	assert.False(t, ethdkgStalled(state, 181))
+
+	// Nothing to stall without a round
+	state.ethdkg = NewEthDKGState()
+	assert.False(t, ethdkgStalled(state, 181))
+}
+
+func TestFailETHDKG(t *testing.T) {
+	logger := logging.GetLogger("services")
+	svcs := &Services{logger: logger, taskMan: tasks.NewManager(logger)}
+
+	state := &State{HighestBlockProcessed: 115, ethdkg: testEthDKGState()}
+	state.interestingBlocks = svcs.blockProcessors(state.ethdkg.Schedule)
+
+	th := svcs.taskMan.NewTaskHandler(time.Minute, time.Second, &blockingTask{})
+	th.Start()
+
+	svcs.failETHDKG(state, ErrCanNotContinue)
+
+	done := make(chan struct{})
+	go func() {
+		svcs.taskMan.WaitForTasks()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not cancelled")
+	}
+	assert.False(t, th.Successful())
+
+	assert.Len(t, state.interestingBlocks, 0)
+	assert.False(t, ETHDKGInProgress(state.ethdkg, 116))
+	assert.Equal(t, uint32(1), state.EthDKGFailures)
+
+	// The restart waits for the old round's deadline and the backoff
+	backoff := ethdkgRestartBackoff(1)
+	assert.GreaterOrEqual(t, state.EthDKGRestartBlock, 180+backoff)
+	assert.LessOrEqual(t, state.EthDKGRestartBlock, 180+backoff+backoff/2)
+
+	// Consecutive failures wait longer
+	state.HighestBlockProcessed = 500
+	svcs.failETHDKG(state, ErrETHDKGStalled)
+	assert.Equal(t, uint32(2), state.EthDKGFailures)
+	assert.GreaterOrEqual(t, state.EthDKGRestartBlock, 500+ethdkgRestartBackoff(2))
+
+	before := state.Clone()
+	svcs.completeETHDKG(state, 700)
+	assert.Contains(t, before.Diff(state), "EthDKGFailures: 2 -> 0")
+	assert.Equal(t, uint32(0), state.EthDKGFailures)
+	assert.Equal(t, uint64(0), state.EthDKGRestartBlock)
+	assert.Equal(t, uint64(700), state.EthDKGCompletedBlock)
+}
@@ -0,0 +1,27 @@
+package monitor
+
+import (
+	"github.com/MadBase/MadNet/dynamics"
+)
+
+// DynamicsStorage holds the parameters governance may change, each taking
+// effect from an epoch on. The bridge contracts don't emit parameter
+// updates yet, so the monitor only moves the storage along with the epochs.
+type DynamicsStorage interface {
+	SetCurrentEpoch(epoch uint32) error
+	LoadStorage(epoch uint32) error
+}
+
+var _ DynamicsStorage = (*dynamics.Storage)(nil)
+
+// advanceStorage moves the dynamic parameters on to the epoch following a snapshot
+func (svcs *Services) advanceStorage(epoch uint32) error {
+	if svcs.storage == nil {
+		return nil
+	}
+	err := svcs.storage.SetCurrentEpoch(epoch)
+	if err != nil {
+		return err
+	}
+	return svcs.storage.LoadStorage(epoch)
+}
@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/MadBase/MadNet/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+type testStorage struct {
+	currentEpoch uint32
+	loadedEpoch  uint32
+}
+
+func (s *testStorage) SetCurrentEpoch(epoch uint32) error {
+	s.currentEpoch = epoch
+	return nil
+}
+
+func (s *testStorage) LoadStorage(epoch uint32) error {
+	s.loadedEpoch = epoch
+	return nil
+}
+
+func TestAdvanceStorage(t *testing.T) {
+	svcs := &Services{logger: logging.GetLogger("services")}
+	assert.Nil(t, svcs.advanceStorage(11))
+
+	storage := &testStorage{}
+	svcs.storage = storage
+	err := svcs.advanceStorage(11)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(11), storage.currentEpoch)
+	assert.Equal(t, uint32(11), storage.loadedEpoch)
+}
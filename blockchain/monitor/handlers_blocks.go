@@ -10,9 +10,9 @@ import (
 
 	"github.com/MadBase/MadNet/blockchain/dkg"
 	"github.com/MadBase/MadNet/blockchain/tasks/dkgtasks"
+	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto/bn256"
 	"github.com/MadBase/MadNet/crypto/bn256/cloudflare"
-	"github.com/MadBase/MadNet/logging"
 	"github.com/MadBase/bridge/bindings"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -40,7 +40,7 @@ func (svcs *Services) DoDistributeShares(state *State, block uint64) error {
 	// If registration wasn't successful then quit now
 	if state.ethdkg.RegistrationTH == nil || !state.ethdkg.RegistrationTH.Successful() {
 		AbortETHDKG(state.ethdkg)
-		return fmt.Errorf("Registration didn't complete succesful, exiting ETHDKG")
+		return fmt.Errorf("Registration didn't complete succesful, exiting ETHDKG: %w", ErrCanNotContinue)
 	}
 
 	// Basic setup
@@ -59,6 +59,11 @@ func (svcs *Services) DoDistributeShares(state *State, block uint64) error {
 		return ErrCanNotContinue
 	}
 
+	if len(participants) < constants.ETHDKGMinParticipants {
+		logger.Errorf("Only %v participants registered, %v are required", len(participants), constants.ETHDKGMinParticipants)
+		return ErrCanNotContinue
+	}
+
 	// Save state
 	ethdkg := state.ethdkg
 
@@ -79,19 +84,17 @@ func (svcs *Services) DoDistributeShares(state *State, block uint64) error {
 	// Store everything we'll need later
 	ethdkg.PrivateCoefficients = privateCoefficients
 	ethdkg.SecretValue = privateCoefficients[0]
+	ethdkg.Commitments[ethdkg.Address] = commitments
+	ethdkg.EncryptedShares[ethdkg.Address] = encryptedShares
 
-	// Do the mechanics of calling
-
-	taskLogger := logging.GetLogger("sdt")
-
-	task := dkgtasks.NewShareDistributionTask(taskLogger, eth, acct,
-		ethdkg.TransportPublicKey, encryptedShares, commitments,
-		ethdkg.Schedule.RegistrationEnd, ethdkg.Schedule.ShareDistributionEnd)
-
-	ethdkg.ShareDistributionTH = svcs.taskMan.NewTaskHandler(eth.Timeout(), eth.RetryDelay(), task)
-	ethdkg.ShareDistributionTH.Start()
+	err = svcs.persistSecrets(ethdkg)
+	if err != nil {
+		logger.Errorf("Can't persist ETHDKG secrets: %v", err)
+		return ErrCanNotContinue
+	}
 
-	return nil
+	// Do the mechanics of calling
+	return svcs.startTask(ethdkg, ShareDistribution)
 }
 
 // DoSubmitDispute submits a dispute if any of the shares we've seen are bad
@@ -103,25 +106,13 @@ func (svcs *Services) DoSubmitDispute(state *State, block uint64) error {
 	// First confirm we distributed shares
 	if state.ethdkg.ShareDistributionTH == nil || !state.ethdkg.ShareDistributionTH.Successful() {
 		AbortETHDKG(state.ethdkg)
-		return fmt.Errorf("Share distribution didn't complete succesful, exiting ETHDKG")
+		return fmt.Errorf("Share distribution didn't complete succesful, exiting ETHDKG: %w", ErrCanNotContinue)
 	}
 
 	//
 
 	// Setup and start task
-	taskLogger := logging.GetLogger("dispute")
-	eth := svcs.eth
-	acct := eth.GetDefaultAccount()
-	ethdkg := state.ethdkg
-
-	task := dkgtasks.NewDisputeTask(taskLogger, eth, acct,
-		ethdkg.TransportPublicKey,
-		ethdkg.Schedule.RegistrationEnd, ethdkg.Schedule.DisputeEnd)
-
-	ethdkg.DisputeTH = svcs.taskMan.NewTaskHandler(eth.Timeout(), eth.RetryDelay(), task)
-	ethdkg.DisputeTH.Start()
-
-	return nil
+	return svcs.startTask(state.ethdkg, Dispute)
 }
 
 // DoSubmitKeyShare does something
@@ -136,7 +127,7 @@ func (svcs *Services) DoSubmitKeyShare(state *State, block uint64) error {
 	// If dispute wasn't successful then quit now
 	if state.ethdkg.DisputeTH == nil || !state.ethdkg.DisputeTH.Successful() {
 		AbortETHDKG(state.ethdkg)
-		return fmt.Errorf("Share dispute didn't complete succesful, exiting ETHDKG")
+		return fmt.Errorf("Share dispute didn't complete succesful, exiting ETHDKG: %w", ErrCanNotContinue)
 	}
 
 	// Generate the key shares
@@ -145,20 +136,13 @@ func (svcs *Services) DoSubmitKeyShare(state *State, block uint64) error {
 		return fmt.Errorf("Can't GenerateKeyShare: %v", err)
 	}
 
-	eth := svcs.eth
-	acct := eth.GetDefaultAccount()
 	ethdkg := state.ethdkg
 
-	taskLogger := logging.GetLogger("kst")
-
-	task := dkgtasks.NewKeyshareSubmissionTask(taskLogger, eth, acct,
-		ethdkg.TransportPublicKey, g1KeyShare, g1Proof, g2KeyShare,
-		ethdkg.Schedule.RegistrationEnd, ethdkg.Schedule.KeyShareSubmissionEnd)
-
-	ethdkg.KeyShareSubmissionTH = svcs.taskMan.NewTaskHandler(eth.Timeout(), eth.RetryDelay(), task)
-	ethdkg.KeyShareSubmissionTH.Start()
+	ethdkg.KeyShareG1s[ethdkg.Address] = g1KeyShare
+	ethdkg.KeyShareG1CorrectnessProofs[ethdkg.Address] = g1Proof
+	ethdkg.KeyShareG2s[ethdkg.Address] = g2KeyShare
 
-	return nil
+	return svcs.startTask(ethdkg, KeyShareSubmission)
 }
 
 // DoSubmitMasterPublicKey does something
@@ -173,7 +157,7 @@ func (svcs *Services) DoSubmitMasterPublicKey(state *State, block uint64) error
 	// First confirm we submitted key shares
 	if state.ethdkg.KeyShareSubmissionTH == nil || !state.ethdkg.KeyShareSubmissionTH.Successful() {
 		AbortETHDKG(state.ethdkg)
-		return fmt.Errorf("Key share submission didn't complete succesful, exiting ETHDKG")
+		return fmt.Errorf("Key share submission didn't complete succesful, exiting ETHDKG: %w", ErrCanNotContinue)
 	}
 	ethdkg := state.ethdkg
 
@@ -204,17 +188,7 @@ func (svcs *Services) DoSubmitMasterPublicKey(state *State, block uint64) error
 	logger.Infof("MasterPublicKey: %v", dkgtasks.FormatBigIntSlice(mpk[:]))
 
 	// Task setup
-	eth := svcs.eth
-	acct := eth.GetDefaultAccount()
-
-	task := dkgtasks.NewMPKSubmissionTask(logger, eth, acct,
-		ethdkg.TransportPublicKey, ethdkg.MasterPublicKey,
-		ethdkg.Schedule.RegistrationEnd, ethdkg.Schedule.MPKSubmissionEnd)
-
-	state.ethdkg.MPKSubmissionTH = svcs.taskMan.NewTaskHandler(eth.Timeout(), eth.RetryDelay(), task)
-	state.ethdkg.MPKSubmissionTH.Start()
-
-	return nil
+	return svcs.startTask(ethdkg, MPKSubmission)
 }
 
 // DoSubmitGPKj does something
@@ -229,7 +203,7 @@ func (svcs *Services) DoSubmitGPKj(state *State, block uint64) error {
 	// If dispute wasn't successful then quit now
 	if state.ethdkg.MPKSubmissionTH == nil || !state.ethdkg.MPKSubmissionTH.Successful() {
 		AbortETHDKG(state.ethdkg)
-		return fmt.Errorf("Share dispute didn't complete succesful, exiting ETHDKG")
+		return fmt.Errorf("Share dispute didn't complete succesful, exiting ETHDKG: %w", ErrCanNotContinue)
 	}
 
 	// setup
@@ -261,6 +235,13 @@ func (svcs *Services) DoSubmitGPKj(state *State, block uint64) error {
 
 	ethdkg.GroupPrivateKey = groupPrivateKey
 	ethdkg.GroupPublicKey = groupPublicKey
+	ethdkg.GroupSignature = groupSignature
+
+	err = svcs.persistSecrets(ethdkg)
+	if err != nil {
+		logger.Errorf("Can't persist ETHDKG secrets: %v", err)
+		return ErrCanNotContinue
+	}
 
 	//
 	err = svcs.SetBN256PrivateKey(groupPrivateKey.Bytes())
@@ -270,13 +251,7 @@ func (svcs *Services) DoSubmitGPKj(state *State, block uint64) error {
 		return ErrCanNotContinue
 	}
 
-	task := dkgtasks.NewGPKSubmissionTask(logger, eth, acct, ethdkg.TransportPublicKey, groupPublicKey, groupSignature,
-		ethdkg.Schedule.RegistrationEnd, ethdkg.Schedule.GPKJSubmissionEnd)
-
-	state.ethdkg.GPKJSubmissionTH = svcs.taskMan.NewTaskHandler(eth.Timeout(), eth.RetryDelay(), task)
-	state.ethdkg.GPKJSubmissionTH.Start()
-
-	return nil
+	return svcs.startTask(ethdkg, GPKJSubmission)
 }
 
 // DoGroupAccusationGPKj does something
@@ -287,6 +262,8 @@ func (svcs *Services) DoGroupAccusationGPKj(state *State, block uint64) error {
 	logger.Infof("DoGroupAccusationGPKj()")
 	logger.Infof(strings.Repeat("-", 60))
 
+	state.ethdkg.Phase = GPKJGroupAccusation
+
 	return nil
 }
 
@@ -298,17 +275,7 @@ func (svcs *Services) DoSuccessfulCompletion(state *State, block uint64) error {
 	logger.Infof("DoSuccessfulCompletion()")
 	logger.Infof(strings.Repeat("-", 60))
 
-	eth := svcs.eth
-	ethdkg := state.ethdkg
-	acct := eth.GetDefaultAccount()
-
-	task := dkgtasks.NewCompletionTask(logger, eth, acct, ethdkg.TransportPublicKey,
-		ethdkg.Schedule.RegistrationEnd, ethdkg.Schedule.CompleteEnd)
-
-	state.ethdkg.CompleteTH = svcs.taskMan.NewTaskHandler(eth.Timeout(), eth.RetryDelay(), task)
-	state.ethdkg.CompleteTH.Start()
-
-	return nil
+	return svcs.startTask(state.ethdkg, Complete)
 }
 
 func thresholdFromUsers(n int) (int, int) {
@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"context"
+	"math"
 	"math/big"
 	"strings"
 
@@ -11,7 +12,6 @@ import (
 	"github.com/MadBase/MadNet/consensus/objs"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto/bn256"
-	"github.com/MadBase/MadNet/logging"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/sirupsen/logrus"
@@ -34,6 +34,9 @@ func (svcs *Services) ProcessRegistrationOpen(state *State, log types.Log) error
 		AbortETHDKG(state.ethdkg)
 	}
 
+	// A round opened, whoever asked for it
+	state.EthDKGRestartBlock = 0
+
 	if event.RegistrationEnds.Uint64() > state.HighestBlockFinalized {
 
 		private, public, err := dkg.GenerateKeys()
@@ -73,15 +76,7 @@ func (svcs *Services) ProcessRegistrationOpen(state *State, log types.Log) error
 		schedule.CompleteStart = schedule.GPKJGroupAccusationEnd + 1
 		schedule.CompleteEnd = event.DkgComplete.Uint64()
 
-		// TODO associate names with these also to help with debugging/logging
-		ib := make(map[uint64]func(*State, uint64) error)
-		ib[schedule.ShareDistributionStart] = svcs.DoDistributeShares      // Do ShareDistribution
-		ib[schedule.DisputeStart] = svcs.DoSubmitDispute                   // Do Disputes
-		ib[schedule.KeyShareSubmissionStart] = svcs.DoSubmitKeyShare       // Do KeyShareSubmission
-		ib[schedule.MPKSubmissionStart] = svcs.DoSubmitMasterPublicKey     // Do MPKSubmission
-		ib[schedule.GPKJSubmissionStart] = svcs.DoSubmitGPKj               // Do GPKJSubmission
-		ib[schedule.GPKJGroupAccusationStart] = svcs.DoGroupAccusationGPKj // Do GPKJDisputes
-		ib[schedule.CompleteStart] = svcs.DoSuccessfulCompletion           // Do SuccessfulCompletion
+		ib := svcs.blockProcessors(schedule)
 
 		logger.Infof("Adding block processors for %v", ib)
 		state.interestingBlocks = ib
@@ -94,15 +89,16 @@ func (svcs *Services) ProcessRegistrationOpen(state *State, log types.Log) error
 		state.ethdkg.TransportPrivateKey = private
 		state.ethdkg.TransportPublicKey = public
 
-		taskLogger := logging.GetLogger("rt")
-
-		task := dkgtasks.NewRegisterTask(taskLogger, eth, acct,
-			state.ethdkg.TransportPublicKey,
-			state.ethdkg.Schedule.RegistrationEnd)
-
-		state.ethdkg.RegistrationTH = svcs.taskMan.NewTaskHandler(eth.Timeout(), eth.RetryDelay(), task)
+		// The transport key must survive a restart or we can't decrypt our shares
+		err = svcs.persistSecrets(state.ethdkg)
+		if err != nil {
+			return err
+		}
 
-		state.ethdkg.RegistrationTH.Start()
+		err = svcs.startTask(state.ethdkg, Registration)
+		if err != nil {
+			return err
+		}
 
 	} else {
 		logger.Infof("Not participating in DKG... registration ends at height %v but height %v is finalized.",
@@ -191,6 +187,10 @@ func (svcs *Services) ProcessValidatorSet(state *State, log types.Log) error {
 
 	epoch := uint32(event.Epoch.Int64())
 
+	if pb, present := state.ProcessedBlocks[log.BlockNumber]; present {
+		pb.saveEpoch(state, epoch)
+	}
+
 	vs := state.ValidatorSets[epoch]
 	vs.NotBeforeMadNetHeight = event.MadHeight
 	vs.ValidatorCount = event.ValidatorCount
@@ -201,7 +201,10 @@ func (svcs *Services) ProcessValidatorSet(state *State, log types.Log) error {
 
 	updatedState.ValidatorSets[epoch] = vs
 
-	err = svcs.checkValidatorSet(updatedState, epoch)
+	// A new validator set is what a successful round of ETHDKG ends with
+	svcs.completeETHDKG(state, log.BlockNumber)
+
+	err = svcs.checkValidatorSet(updatedState, epoch, log.BlockNumber)
 	if err != nil {
 		return err
 	}
@@ -224,6 +227,10 @@ func (svcs *Services) ProcessValidatorMember(state *State, log types.Log) error
 
 	index := uint8(event.Index.Uint64()) - 1
 
+	if pb, present := state.ProcessedBlocks[log.BlockNumber]; present {
+		pb.saveEpoch(state, epoch)
+	}
+
 	v := Validator{
 		Account:   event.Account,
 		Index:     index,
@@ -244,7 +251,7 @@ func (svcs *Services) ProcessValidatorMember(state *State, log types.Log) error
 		return err
 	}
 	svcs.logger.Debugf("Validator member %v %x", v.Index, groupShare)
-	err = svcs.checkValidatorSet(state, epoch)
+	err = svcs.checkValidatorSet(state, epoch, log.BlockNumber)
 	if err != nil {
 		return err
 	}
@@ -252,7 +259,9 @@ func (svcs *Services) ProcessValidatorMember(state *State, log types.Log) error
 	return nil
 }
 
-func (svcs *Services) checkValidatorSet(state *State, epoch uint32) error {
+// checkValidatorSet hands the validator set of epoch to consensus once every
+// member has been received in block
+func (svcs *Services) checkValidatorSet(state *State, epoch uint32, block uint64) error {
 	logger := svcs.logger
 
 	// Make sure we've received a validator set event
@@ -310,6 +319,8 @@ func (svcs *Services) checkValidatorSet(state *State, epoch uint32) error {
 		err = svcs.ah.AddValidatorSet(vs)
 		if err != nil {
 			logger.Errorf("Unable to add validator set: %v", err) // TODO handle -- MUST retry or consensus shuts down
+		} else if pb, present := state.ProcessedBlocks[block]; present {
+			pb.addValidatorSet(vs.NotBefore)
 		}
 	}
 	return nil
@@ -330,8 +341,8 @@ func (svcs *Services) ProcessDepositReceived(state *State, log types.Log) error
 	logger.Infof("deposit depositID:%x ethereum:0x%x amount:%d",
 		event.DepositID, event.Depositor, event.Amount)
 
-	return svcs.consensusDb.Update(func(txn *badger.Txn) error {
-		depositNonce := event.DepositID.Bytes()
+	depositNonce := event.DepositID.Bytes()
+	err = svcs.consensusDb.Update(func(txn *badger.Txn) error {
 		account := event.Depositor.Bytes()
 		owner := &aobjs.Owner{}
 		err := owner.New(account, constants.CurveSecp256k1)
@@ -341,6 +352,15 @@ func (svcs *Services) ProcessDepositReceived(state *State, log types.Log) error
 		}
 		return svcs.dph.Add(txn, svcs.chainID, depositNonce, event.Amount, owner)
 	})
+	if err != nil {
+		return err
+	}
+
+	if pb, present := state.ProcessedBlocks[log.BlockNumber]; present {
+		pb.addDeposit(depositNonce)
+	}
+
+	return nil
 }
 
 // ProcessSnapshotTaken handles receiving snapshots
@@ -381,11 +401,27 @@ func (svcs *Services) ProcessSnapshotTaken(state *State, log types.Log) error {
 	header.BClaims = bclaims
 	header.SigGroup = rawSignature
 
+	// Our own chain has to agree with what the validators put on Ethereum
+	if epoch.IsUint64() && epoch.Uint64() < math.MaxUint32 {
+		err = svcs.verifySnapshot(state, uint32(epoch.Uint64()), event.Validator, header)
+		if err != nil {
+			logger.Errorf("Could not verify snapshot of epoch %v: %v", epoch, err)
+		}
+	}
+
 	// send the reconstituted header to a handler
 	err = svcs.ah.AddSnapshot(header, ethDkgStarted) // TODO must happen or things stuff
 	if err != nil {
 		return err
 	}
 
+	if epoch.IsUint64() && epoch.Uint64() < math.MaxUint32 && uint32(epoch.Uint64()) > state.HighestEpochSeen {
+		state.HighestEpochSeen = uint32(epoch.Uint64())
+		err = svcs.advanceStorage(state.HighestEpochSeen + 1)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
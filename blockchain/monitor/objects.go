@@ -27,10 +27,24 @@ type State struct {
 	PeerCount              uint32
 	ValidatorSets          map[uint32]ValidatorSet
 	Validators             map[uint32][]Validator
+	ProcessedBlocks        map[uint64]*ProcessedBlock
+	EthDKGFailures         uint32 // Consecutive rounds of ETHDKG that failed
+	EthDKGRestartBlock     uint64 // Block to ask for a new round of ETHDKG at; zero if none is needed
+	EthDKGCompletedBlock   uint64 // Block the last successful round of ETHDKG completed at
+	SnapshotMismatches     uint32 // Snapshots on Ethereum that differ from the committed block header
+	UnverifiedSnapshots    []UnverifiedSnapshot
 	ethdkg                 *EthDKGState
 	interestingBlocks      map[uint64]func(*State, uint64) error
 }
 
+// UnverifiedSnapshot is a snapshot taken on Ethereum at a height the node
+// has not committed yet. It is verified once the height is committed.
+type UnverifiedSnapshot struct {
+	Epoch     uint32
+	Validator common.Address
+	Header    []byte // marshalled block header
+}
+
 // EthDKGPhase is used to indicate what phase we are currently in
 type EthDKGPhase int
 
@@ -43,6 +57,18 @@ const (
 	MPKSubmission
 	GPKJSubmission
 	GPKJGroupAccusation
+	Complete
+)
+
+// EthDKGTaskStatus records how far the task started for a phase got
+type EthDKGTaskStatus int
+
+// These are the valid task statuses
+const (
+	TaskNotStarted EthDKGTaskStatus = iota
+	TaskPending
+	TaskSucceeded
+	TaskFailed
 )
 
 // EthDKGSchedule RegistrationOpen event publishes phase schedule, so we record that here
@@ -69,10 +95,12 @@ type EthDKGSchedule struct {
 type EthDKGState struct {
 
 	// Local validator info
+	Phase               EthDKGPhase
 	Address             common.Address
 	Index               int
 	GroupPrivateKey     *big.Int
 	GroupPublicKey      [4]*big.Int
+	GroupSignature      [2]*big.Int
 	MasterPublicKey     [4]*big.Int
 	NumberOfValidators  int
 	PrivateCoefficients []*big.Int
@@ -169,6 +197,11 @@ func (s *State) Clone() *State {
 	ns.HighestEpochSeen = s.HighestEpochSeen
 	ns.InSync = s.InSync
 	ns.EthereumInSync = s.EthereumInSync
+	ns.EthDKGFailures = s.EthDKGFailures
+	ns.EthDKGRestartBlock = s.EthDKGRestartBlock
+	ns.EthDKGCompletedBlock = s.EthDKGCompletedBlock
+	ns.SnapshotMismatches = s.SnapshotMismatches
+	ns.UnverifiedSnapshots = append([]UnverifiedSnapshot{}, s.UnverifiedSnapshots...)
 
 	return ns
 }
@@ -213,5 +246,25 @@ func (s *State) Diff(o *State) string {
 		d = append(d, fmt.Sprintf("CommunicationFailures: %v -> %v", s.CommunicationFailures, o.CommunicationFailures))
 	}
 
+	if s.EthDKGFailures != o.EthDKGFailures {
+		d = append(d, fmt.Sprintf("EthDKGFailures: %v -> %v", s.EthDKGFailures, o.EthDKGFailures))
+	}
+
+	if s.EthDKGRestartBlock != o.EthDKGRestartBlock {
+		d = append(d, fmt.Sprintf("EthDKGRestartBlock: %v -> %v", s.EthDKGRestartBlock, o.EthDKGRestartBlock))
+	}
+
+	if s.EthDKGCompletedBlock != o.EthDKGCompletedBlock {
+		d = append(d, fmt.Sprintf("EthDKGCompletedBlock: %v -> %v", s.EthDKGCompletedBlock, o.EthDKGCompletedBlock))
+	}
+
+	if s.SnapshotMismatches != o.SnapshotMismatches {
+		d = append(d, fmt.Sprintf("SnapshotMismatches: %v -> %v", s.SnapshotMismatches, o.SnapshotMismatches))
+	}
+
+	if len(s.UnverifiedSnapshots) != len(o.UnverifiedSnapshots) {
+		d = append(d, fmt.Sprintf("UnverifiedSnapshots: %v -> %v", len(s.UnverifiedSnapshots), len(o.UnverifiedSnapshots)))
+	}
+
 	return strings.Join(d, ", ")
 }
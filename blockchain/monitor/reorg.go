@@ -0,0 +1,235 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ProcessedBlock records the hash of a processed block and what processing
+// it changed, so the changes can be undone if the block is reorged out
+type ProcessedBlock struct {
+	Hash          common.Hash
+	Deposits      [][]byte                // IDs of deposits added
+	Epochs        []uint32                // Epochs whose validators changed
+	ValidatorSets map[uint32]ValidatorSet // Validator sets before the block
+	Validators    map[uint32][]Validator  // Validators before the block
+	EthDKG        []byte                  // ETHDKG state before the block
+	AddedSets     []uint32                // NotBefore of validator sets given to consensus
+}
+
+// addDeposit records a deposit added by the block
+func (pb *ProcessedBlock) addDeposit(depositID []byte) {
+	pb.Deposits = append(pb.Deposits, depositID)
+}
+
+// addValidatorSet records a validator set the block gave to consensus
+func (pb *ProcessedBlock) addValidatorSet(notBefore uint32) {
+	pb.AddedSets = append(pb.AddedSets, notBefore)
+}
+
+// saveEpoch records the validators of an epoch before the block changes them
+func (pb *ProcessedBlock) saveEpoch(state *State, epoch uint32) {
+	for _, e := range pb.Epochs {
+		if e == epoch {
+			return
+		}
+	}
+	pb.Epochs = append(pb.Epochs, epoch)
+
+	if pb.ValidatorSets == nil {
+		pb.ValidatorSets = make(map[uint32]ValidatorSet)
+	}
+	if vs, present := state.ValidatorSets[epoch]; present {
+		pb.ValidatorSets[epoch] = vs
+	}
+
+	if pb.Validators == nil {
+		pb.Validators = make(map[uint32][]Validator)
+	}
+	if validators, present := state.Validators[epoch]; present {
+		pb.Validators[epoch] = append([]Validator{}, validators...)
+	}
+}
+
+// trackBlock records a block about to be processed. Blocks too far below
+// the finalized height to be reorged out are not tracked. The hash of a block
+// is taken from its logs. Only the last block of a batch is looked up when it
+// has none: blocks are chained, so its hash covers the blocks below it, and
+// blocks without a hash are rolled back with the first reorged block above
+// them.
+func (svcs *Services) trackBlock(ctx context.Context, state *State, block uint64, lastBlock uint64, finalizedHeight uint64, logs []types.Log) error {
+	if block+constants.MonitorReorgDepth <= finalizedHeight {
+		return nil
+	}
+
+	pb := &ProcessedBlock{}
+	if len(logs) > 0 {
+		pb.Hash = logs[0].BlockHash
+	} else if block == lastBlock {
+		header, err := svcs.eth.GetGethClient().HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+		if err != nil {
+			return err
+		}
+		pb.Hash = header.Hash()
+	}
+
+	// Only events and block processors change ETHDKG
+	_, hasProcessor := state.interestingBlocks[block]
+	if state.ethdkg != nil && (len(logs) > 0 || hasProcessor) {
+		data, err := state.ethdkg.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		pb.EthDKG = data
+	}
+
+	if state.ProcessedBlocks == nil {
+		state.ProcessedBlocks = make(map[uint64]*ProcessedBlock)
+	}
+	state.ProcessedBlocks[block] = pb
+
+	return nil
+}
+
+// pruneBlocks forgets blocks that can no longer be reorged out
+func (svcs *Services) pruneBlocks(state *State, finalizedHeight uint64) {
+	for block := range state.ProcessedBlocks {
+		if block+constants.MonitorReorgDepth <= finalizedHeight {
+			delete(state.ProcessedBlocks, block)
+		}
+	}
+}
+
+// CheckReorg compares the hashes of processed blocks with the canonical
+// chain. If they differ the processed blocks past the common ancestor are
+// rolled back so they get processed again.
+func (svcs *Services) CheckReorg(ctx context.Context, state *State) error {
+	if len(state.ProcessedBlocks) == 0 {
+		return nil
+	}
+
+	blocks := []uint64{}
+	for block := range state.ProcessedBlocks {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i] > blocks[j] })
+
+	// Blocks are chained, so if the highest matches so do the rest
+	var ancestor uint64
+	found := false
+	for _, block := range blocks {
+		if state.ProcessedBlocks[block].Hash == (common.Hash{}) {
+			continue
+		}
+		header, err := svcs.eth.GetGethClient().HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+		if err != nil {
+			return err
+		}
+		if header.Hash() == state.ProcessedBlocks[block].Hash {
+			ancestor = block
+			found = true
+			break
+		}
+	}
+
+	if found && ancestor == blocks[0] {
+		return nil
+	}
+
+	if found {
+		svcs.logger.Warnf("Chain reorganized. Rolling back from block %v to block %v.", state.HighestBlockProcessed, ancestor)
+	} else {
+		lowest := blocks[len(blocks)-1]
+		if lowest > 0 {
+			ancestor = lowest - 1
+		}
+		svcs.logger.Errorf("Chain reorganized below every known hash of the %v blocks tracked. Rolling back to block %v.", len(blocks), ancestor)
+	}
+
+	return svcs.rollback(state, blocks, ancestor)
+}
+
+// rollback undoes the processing of blocks past the ancestor, newest first
+func (svcs *Services) rollback(state *State, blocks []uint64, ancestor uint64) error {
+	var ethdkg []byte
+	for _, block := range blocks {
+		if block <= ancestor {
+			break
+		}
+		pb := state.ProcessedBlocks[block]
+
+		for _, notBefore := range pb.AddedSets {
+			svcs.logger.Infof("Removing validator set starting at height %v from block %v", notBefore, block)
+			err := svcs.ah.RemoveValidatorSet(notBefore)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(pb.Deposits) > 0 {
+			err := svcs.consensusDb.Update(func(txn *badger.Txn) error {
+				for _, depositID := range pb.Deposits {
+					svcs.logger.Infof("Removing deposit depositID:%x from block %v", depositID, block)
+					err := svcs.dph.Remove(txn, depositID)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(pb.Epochs) > 0 && state.ValidatorSets == nil {
+			state.ValidatorSets = make(map[uint32]ValidatorSet)
+		}
+		if len(pb.Epochs) > 0 && state.Validators == nil {
+			state.Validators = make(map[uint32][]Validator)
+		}
+		for _, epoch := range pb.Epochs {
+			if vs, present := pb.ValidatorSets[epoch]; present {
+				state.ValidatorSets[epoch] = vs
+			} else {
+				delete(state.ValidatorSets, epoch)
+			}
+			if validators, present := pb.Validators[epoch]; present {
+				state.Validators[epoch] = validators
+			} else {
+				delete(state.Validators, epoch)
+			}
+		}
+
+		// The oldest snapshot is the one to go back to
+		if pb.EthDKG != nil {
+			ethdkg = pb.EthDKG
+		}
+
+		delete(state.ProcessedBlocks, block)
+	}
+
+	state.HighestBlockProcessed = ancestor
+
+	if ethdkg != nil {
+		if state.ethdkg != nil {
+			AbortETHDKG(state.ethdkg)
+		}
+		state.ethdkg = &EthDKGState{}
+		err := state.ethdkg.UnmarshalBinary(ethdkg)
+		if err != nil {
+			state.ethdkg = NewEthDKGState()
+			state.interestingBlocks = make(map[uint64]func(*State, uint64) error)
+			return err
+		}
+		return svcs.RecoverETHDKG(state)
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/application/deposit"
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/blockchain"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupReorgServices(t *testing.T, blocks int) (*Services, *State) {
+	eth, err := blockchain.NewEthereumSimulator(
+		"../../assets/test/keys",
+		"../../assets/test/passcodes.txt",
+		3,
+		time.Second,
+		0,
+		big.NewInt(9223372036854775807),
+		"0x26D3D8Ab74D62C26f1ACc220dA1646411c9880Ac")
+	assert.Nil(t, err)
+	t.Cleanup(func() { eth.Close() })
+
+	for i := 0; i < blocks; i++ {
+		eth.Commit()
+	}
+
+	rawDB, err := badger.Open(badger.DefaultOptions("").WithInMemory(true))
+	assert.Nil(t, err)
+	t.Cleanup(func() { rawDB.Close() })
+	consensusDb := &db.Database{}
+	err = consensusDb.Init(rawDB)
+	assert.Nil(t, err)
+
+	dph := &deposit.Handler{}
+	err = dph.Init()
+	assert.Nil(t, err)
+	dph.IsSpent = func(*badger.Txn, []byte) (bool, error) { return false, nil }
+
+	svcs := &Services{
+		eth:         eth,
+		consensusDb: consensusDb,
+		dph:         dph,
+		ah:          &testAdminHandler{secrets: make(map[string][]byte)},
+		logger:      logging.GetLogger("services"),
+	}
+
+	state := &State{
+		ValidatorSets:     make(map[uint32]ValidatorSet),
+		Validators:        make(map[uint32][]Validator),
+		ethdkg:            NewEthDKGState(),
+		interestingBlocks: make(map[uint64]func(*State, uint64) error),
+	}
+
+	return svcs, state
+}
+
+func addTestDeposit(t *testing.T, svcs *Services, depositID []byte) error {
+	owner := &aobjs.Owner{}
+	err := owner.New(common.HexToAddress("0x26D3D8Ab74D62C26f1ACc220dA1646411c9880Ac").Bytes(), constants.CurveSecp256k1)
+	assert.Nil(t, err)
+	return svcs.consensusDb.Update(func(txn *badger.Txn) error {
+		return svcs.dph.Add(txn, 1, depositID, big.NewInt(10), owner)
+	})
+}
+
+func TestCheckReorg(t *testing.T) {
+	ctx := context.Background()
+	svcs, state := setupReorgServices(t, 5)
+
+	// Process blocks 1 to 5. Block 4 adds a deposit, changes epoch 1 and moves ETHDKG along.
+	depositID := []byte{4}
+	state.interestingBlocks[4] = func(*State, uint64) error { return nil }
+	for block := uint64(1); block <= 5; block++ {
+		err := svcs.trackBlock(ctx, state, block, block, 5, nil)
+		assert.Nil(t, err)
+		if block == 4 {
+			pb := state.ProcessedBlocks[block]
+			assert.Nil(t, addTestDeposit(t, svcs, depositID))
+			pb.addDeposit(depositID)
+			pb.saveEpoch(state, 1)
+			pb.addValidatorSet(10)
+			state.ValidatorSets[1] = ValidatorSet{ValidatorCount: 4}
+			state.Validators[1] = []Validator{{Index: 0}}
+			state.ethdkg.Phase = ShareDistribution
+		}
+		state.HighestBlockProcessed = block
+	}
+	assert.Len(t, state.ProcessedBlocks, 5)
+	assert.NotNil(t, state.ProcessedBlocks[4].EthDKG)
+	assert.Nil(t, state.ProcessedBlocks[3].EthDKG)
+
+	// Nothing changed
+	err := svcs.CheckReorg(ctx, state)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), state.HighestBlockProcessed)
+	assert.Len(t, state.ProcessedBlocks, 5)
+
+	// Blocks 4 and 5 are no longer canonical
+	state.ProcessedBlocks[4].Hash = common.HexToHash("0x04")
+	state.ProcessedBlocks[5].Hash = common.HexToHash("0x05")
+	err = svcs.CheckReorg(ctx, state)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), state.HighestBlockProcessed)
+	assert.Len(t, state.ProcessedBlocks, 3)
+	assert.NotContains(t, state.ValidatorSets, uint32(1))
+	assert.NotContains(t, state.Validators, uint32(1))
+	assert.Equal(t, Registration, state.ethdkg.Phase)
+	assert.Equal(t, []uint32{10}, svcs.ah.(*testAdminHandler).removed)
+
+	err = svcs.consensusDb.View(func(txn *badger.Txn) error {
+		found, missing, _, err := svcs.dph.Get(txn, [][]byte{depositID})
+		assert.Len(t, found, 0)
+		assert.Len(t, missing, 1)
+		return err
+	})
+	assert.Nil(t, err)
+
+	// The deposit can be added again when the block is reprocessed
+	assert.Nil(t, addTestDeposit(t, svcs, depositID))
+}
+
+func TestCheckReorgRestoresValidators(t *testing.T) {
+	ctx := context.Background()
+	svcs, state := setupReorgServices(t, 2)
+
+	state.ValidatorSets[1] = ValidatorSet{ValidatorCount: 2}
+	state.Validators[1] = []Validator{{Index: 0}, {Index: 1}}
+
+	err := svcs.trackBlock(ctx, state, 1, 1, 2, nil)
+	assert.Nil(t, err)
+	err = svcs.trackBlock(ctx, state, 2, 2, 2, nil)
+	assert.Nil(t, err)
+
+	pb := state.ProcessedBlocks[2]
+	pb.saveEpoch(state, 1)
+	pb.saveEpoch(state, 1)
+	assert.Len(t, pb.Epochs, 1)
+	state.ValidatorSets[1] = ValidatorSet{ValidatorCount: 3}
+	state.Validators[1][1] = Validator{Index: 7}
+	state.HighestBlockProcessed = 2
+
+	state.ProcessedBlocks[2].Hash = common.Hash{}
+	err = svcs.CheckReorg(ctx, state)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), state.HighestBlockProcessed)
+	assert.Equal(t, uint8(2), state.ValidatorSets[1].ValidatorCount)
+	assert.Equal(t, uint8(1), state.Validators[1][1].Index)
+}
+
+func TestCheckReorgTooDeep(t *testing.T) {
+	ctx := context.Background()
+	svcs, state := setupReorgServices(t, 5)
+
+	for block := uint64(3); block <= 5; block++ {
+		err := svcs.trackBlock(ctx, state, block, block, 5, nil)
+		assert.Nil(t, err)
+		state.ProcessedBlocks[block].Hash = common.Hash{}
+	}
+	state.HighestBlockProcessed = 5
+
+	err := svcs.CheckReorg(ctx, state)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), state.HighestBlockProcessed)
+	assert.Len(t, state.ProcessedBlocks, 0)
+}
+
+func TestTrackBlockDepth(t *testing.T) {
+	ctx := context.Background()
+	svcs, state := setupReorgServices(t, 3)
+
+	finalized := uint64(2 + constants.MonitorReorgDepth)
+	err := svcs.trackBlock(ctx, state, 2, 2, finalized, nil)
+	assert.Nil(t, err)
+	assert.Len(t, state.ProcessedBlocks, 0)
+
+	err = svcs.trackBlock(ctx, state, 3, 3, finalized, nil)
+	assert.Nil(t, err)
+	assert.Len(t, state.ProcessedBlocks, 1)
+
+	svcs.pruneBlocks(state, finalized+1)
+	assert.Len(t, state.ProcessedBlocks, 0)
+}
+
+func TestTrackBlockHashes(t *testing.T) {
+	ctx := context.Background()
+	svcs, state := setupReorgServices(t, 4)
+
+	// Only the last block of the batch is looked up
+	for block := uint64(1); block <= 3; block++ {
+		err := svcs.trackBlock(ctx, state, block, 3, 4, nil)
+		assert.Nil(t, err)
+		state.HighestBlockProcessed = block
+	}
+	assert.Equal(t, common.Hash{}, state.ProcessedBlocks[1].Hash)
+	assert.Equal(t, common.Hash{}, state.ProcessedBlocks[2].Hash)
+	assert.NotEqual(t, common.Hash{}, state.ProcessedBlocks[3].Hash)
+
+	// Blocks without a hash are checked through the blocks above them
+	err := svcs.trackBlock(ctx, state, 4, 4, 4, nil)
+	assert.Nil(t, err)
+	state.HighestBlockProcessed = 4
+	state.ProcessedBlocks[4].Hash = common.HexToHash("0x04")
+	err = svcs.CheckReorg(ctx, state)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), state.HighestBlockProcessed)
+	assert.Len(t, state.ProcessedBlocks, 3)
+}
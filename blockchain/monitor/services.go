@@ -2,8 +2,10 @@ package monitor
 
 import (
 	"context"
+	"errors"
 	"math"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/MadBase/MadNet/application/deposit"
@@ -14,6 +16,7 @@ import (
 	"github.com/MadBase/MadNet/consensus/admin"
 	"github.com/MadBase/MadNet/consensus/db"
 	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/logging"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -27,22 +30,44 @@ type eventProcessor struct {
 	processor func(*State, types.Log) error
 }
 
+// AdminHandler is the consensus side of the monitor. Validator sets,
+// snapshots and keys learned from Ethereum are handed to it. It also keeps
+// the ETHDKG secrets in its encrypted store.
+type AdminHandler interface {
+	AddValidatorSet(*objs.ValidatorSet) error
+	RemoveValidatorSet(uint32) error
+	AddSnapshot(*objs.BlockHeader, bool) error
+	AddPrivateKey([]byte, constants.CurveSpec) error
+	SetPrivK([]byte, []byte) error
+	GetPrivK([]byte) ([]byte, error)
+	RegisterSnapshotCallback(func(*objs.BlockHeader) error)
+	SetSynchronized(bool)
+}
+
+var _ AdminHandler = (*admin.Handlers)(nil)
+
 // Services just a bundle of requirements common for monitoring functionality
 type Services struct {
 	logger            *logrus.Logger
 	eth               blockchain.Ethereum
 	consensusDb       *db.Database
 	dph               *deposit.Handler
-	ah                *admin.Handlers
+	ah                AdminHandler
+	storage           DynamicsStorage
 	contractAddresses []common.Address
 	batchSize         int
 	events            map[string]*eventProcessor
 	chainID           uint32
+	haltOnMismatch    bool
 	taskMan           tasks.Manager
+	statusLock        sync.Mutex
+	ethdkgStatus      *EthDKGStatus
 }
 
-// NewServices creates a new Services struct
-func NewServices(eth blockchain.Ethereum, db *db.Database, dph *deposit.Handler, ah *admin.Handlers, batchSize int, chainID uint32) *Services {
+// NewServices creates a new Services struct. The dynamic parameters are
+// only moved along with the epochs when storage is not nil. With haltOnSnapshotMismatch the node
+// stops validating once a snapshot on Ethereum disagrees with its own chain.
+func NewServices(eth blockchain.Ethereum, db *db.Database, dph *deposit.Handler, ah AdminHandler, storage DynamicsStorage, batchSize int, chainID uint32, haltOnSnapshotMismatch bool) *Services {
 
 	c := eth.Contracts()
 
@@ -55,6 +80,7 @@ func NewServices(eth blockchain.Ethereum, db *db.Database, dph *deposit.Handler,
 
 	svcs := &Services{
 		ah:                ah,
+		storage:           storage,
 		batchSize:         batchSize,
 		consensusDb:       db,
 		contractAddresses: contractAddresses,
@@ -62,6 +88,7 @@ func NewServices(eth blockchain.Ethereum, db *db.Database, dph *deposit.Handler,
 		eth:               eth,
 		events:            make(map[string]*eventProcessor),
 		chainID:           chainID,
+		haltOnMismatch:    haltOnSnapshotMismatch,
 		logger:            serviceLogger,
 		taskMan:           tasks.NewManager(taskLogger)}
 
@@ -127,6 +154,8 @@ func (svcs *Services) WatchEthereum(state *State) error {
 	logger := svcs.logger
 	eth := svcs.eth
 
+	defer svcs.publishEthDKG(state)
+
 	ctx, cancelFunc := eth.GetTimeoutContext()
 	defer cancelFunc()
 
@@ -158,6 +187,20 @@ func (svcs *Services) WatchEthereum(state *State) error {
 		return err
 	}
 
+	// After a restart the ETHDKG schedule has to be rebuilt before any block is processed
+	if state.interestingBlocks == nil {
+		err = svcs.RecoverETHDKG(state)
+		if err != nil {
+			logger.Warnf("Could not recover ETHDKG: %v", err)
+		}
+	}
+
+	// Undo what was processed from blocks that are no longer canonical
+	err = svcs.CheckReorg(ctx, state)
+	if err != nil {
+		return err
+	}
+
 	// Decide what events to look for
 	firstBlock := state.HighestBlockProcessed + 1
 	lastBlock := state.HighestBlockProcessed + uint64(svcs.batchSize) // Be optimistic
@@ -203,6 +246,12 @@ func (svcs *Services) WatchEthereum(state *State) error {
 		// Interesting blocks can change based on an event, so we need to look at all blocks in range in order
 		for block := firstBlock; block <= lastBlock; block++ {
 
+			// Remember the block so what it changes can be undone if it is reorged out
+			err := svcs.trackBlock(ctx, state, block, lastBlock, finalizedHeight, logsByBlock[block])
+			if err != nil {
+				return err
+			}
+
 			// If current block has any events, we process all of them
 			if logs, present := logsByBlock[block]; present {
 				for _, log := range logs {
@@ -230,15 +279,27 @@ func (svcs *Services) WatchEthereum(state *State) error {
 					err := processor(state, block)
 					if err != nil {
 						logger.Warnf("Block handler for %v failed: %v", block, err)
-						if err == ErrCanNotContinue {
-							state.ethdkg = NewEthDKGState()
-							state.interestingBlocks = make(map[uint64]func(*State, uint64) error)
+						if errors.Is(err, ErrCanNotContinue) {
+							svcs.failETHDKG(state, err)
 						}
 					}
 				}
 			}
 
-			state.HighestBlockProcessed = lastBlock
+			// A round that missed its deadline won't finish on its own
+			if ethdkgStalled(state, block) {
+				svcs.failETHDKG(state, ErrETHDKGStalled)
+			}
+
+			state.HighestBlockProcessed = block
+		}
+
+		svcs.pruneBlocks(state, finalizedHeight)
+
+		// Snapshots taken ahead of our chain can be checked once we caught up
+		err = svcs.verifyQueuedSnapshots(state)
+		if err != nil {
+			logger.Errorf("Could not verify queued snapshots: %v", err)
 		}
 
 		if lastBlock < finalizedHeight {
@@ -246,11 +307,16 @@ func (svcs *Services) WatchEthereum(state *State) error {
 			svcs.ah.SetSynchronized(false)
 		} else {
 			state.InSync = true
-			svcs.ah.SetSynchronized(true)
+			svcs.ah.SetSynchronized(!svcs.halted())
 		}
 
 	}
 
+	// Only ask for a new round of ETHDKG once we know the chain is caught up
+	if state.InSync {
+		svcs.restartETHDKG(ctx, state)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,164 @@
+package monitor
+
+import (
+	"bytes"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+)
+
+// compareSnapshot returns an alert describing how a snapshot differs from
+// the block header committed at its height, or nil if they agree
+func compareSnapshot(local *objs.BlockHeader, snapshot *objs.BlockHeader) (*objs.SnapshotAlert, error) {
+	localBClaims, err := local.BClaims.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	snapshotBClaims, err := snapshot.BClaims.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	alert := &objs.SnapshotAlert{
+		Height:          snapshot.BClaims.Height,
+		BClaimsMismatch: !bytes.Equal(localBClaims, snapshotBClaims),
+		SigMismatch:     !bytes.Equal(local.SigGroup, snapshot.SigGroup),
+	}
+	if !alert.BClaimsMismatch && !alert.SigMismatch {
+		return nil, nil
+	}
+
+	alert.LocalBlockHash, err = local.BClaims.BlockHash()
+	if err != nil {
+		return nil, err
+	}
+	alert.RemoteBlockHash, err = snapshot.BClaims.BlockHash()
+	if err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// verifySnapshot compares a snapshot taken on Ethereum with the block header
+// committed locally at the same height and stores an alert if they differ.
+// Snapshots of heights not committed yet are queued and verified by
+// verifyQueuedSnapshots once the height is committed.
+func (svcs *Services) verifySnapshot(state *State, epoch uint32, validator common.Address, snapshot *objs.BlockHeader) error {
+	if svcs.consensusDb == nil {
+		return nil
+	}
+
+	verified, err := svcs.compareCommitted(state, epoch, validator, snapshot)
+	if err != nil || verified {
+		return err
+	}
+
+	svcs.logger.Debugf("Block header %v not committed yet, snapshot queued for verification", snapshot.BClaims.Height)
+	header, err := snapshot.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	state.UnverifiedSnapshots = append(state.UnverifiedSnapshots, UnverifiedSnapshot{
+		Epoch:     epoch,
+		Validator: validator,
+		Header:    header,
+	})
+	return nil
+}
+
+// verifyQueuedSnapshots verifies the queued snapshots whose height has been
+// committed since they were taken
+func (svcs *Services) verifyQueuedSnapshots(state *State) error {
+	if svcs.consensusDb == nil || len(state.UnverifiedSnapshots) == 0 {
+		return nil
+	}
+
+	pending := []UnverifiedSnapshot{}
+	for i, us := range state.UnverifiedSnapshots {
+		snapshot := &objs.BlockHeader{}
+		err := snapshot.UnmarshalBinary(us.Header)
+		if err != nil {
+			svcs.logger.Errorf("Dropping queued snapshot of epoch %v: %v", us.Epoch, err)
+			continue
+		}
+		verified, err := svcs.compareCommitted(state, us.Epoch, us.Validator, snapshot)
+		if err != nil {
+			state.UnverifiedSnapshots = append(pending, state.UnverifiedSnapshots[i:]...)
+			return err
+		}
+		if !verified {
+			pending = append(pending, us)
+		}
+	}
+	state.UnverifiedSnapshots = pending
+	return nil
+}
+
+// compareCommitted compares a snapshot with the block header committed at
+// its height. It returns false if the height is not committed yet.
+func (svcs *Services) compareCommitted(state *State, epoch uint32, validator common.Address, snapshot *objs.BlockHeader) (bool, error) {
+	var alert *objs.SnapshotAlert
+	committed := false
+	err := svcs.consensusDb.Update(func(txn *badger.Txn) error {
+		local, err := svcs.consensusDb.GetCommittedBlockHeader(txn, snapshot.BClaims.Height)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		committed = true
+		metrics.GetOrRegisterCounter("snapshot/verified").Inc(1)
+
+		alert, err = compareSnapshot(local, snapshot)
+		if err != nil || alert == nil {
+			return err
+		}
+		alert.Epoch = epoch
+		alert.Validator = validator.Bytes()
+		return svcs.consensusDb.SetSnapshotAlert(txn, alert)
+	})
+	if err != nil || alert == nil {
+		return committed, err
+	}
+
+	state.SnapshotMismatches++
+	metrics.GetOrRegisterCounter("snapshot/mismatches").Inc(1)
+	metrics.GetOrRegisterGauge("snapshot/last_mismatch_height").Update(int64(alert.Height))
+
+	svcs.logger.WithFields(logrus.Fields{
+		"Epoch":           alert.Epoch,
+		"Height":          alert.Height,
+		"Validator":       validator.Hex(),
+		"BClaimsMismatch": alert.BClaimsMismatch,
+		"SigMismatch":     alert.SigMismatch,
+		"Halting":         svcs.haltOnMismatch,
+	}).Error("Snapshot on Ethereum does not match the committed block header")
+
+	return true, nil
+}
+
+// halted indicates validation has to stop because a snapshot disagreed
+// with our chain. Only an operator can decide which side is wrong, so the
+// node stays halted until they clear every snapshot alert.
+func (svcs *Services) halted() bool {
+	if !svcs.haltOnMismatch || svcs.consensusDb == nil {
+		return false
+	}
+	halted := true
+	err := svcs.consensusDb.View(func(txn *badger.Txn) error {
+		alerts, err := svcs.consensusDb.GetSnapshotAlerts(txn)
+		if err != nil {
+			return err
+		}
+		halted = len(alerts) > 0
+		return nil
+	})
+	if err != nil {
+		svcs.logger.Errorf("Could not read snapshot alerts: %v", err)
+	}
+	return halted
+}
@@ -0,0 +1,130 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBlockHeader(height uint32, stateRoot []byte) *objs.BlockHeader {
+	return &objs.BlockHeader{
+		SigGroup: make([]byte, 192),
+		BClaims: &objs.BClaims{
+			ChainID:    42,
+			Height:     height,
+			PrevBlock:  crypto.Hasher([]byte("prev")),
+			HeaderRoot: crypto.Hasher([]byte("header")),
+			StateRoot:  stateRoot,
+			TxRoot:     crypto.Hasher([]byte{}),
+		},
+	}
+}
+
+func setupSnapshotServices(t *testing.T, haltOnMismatch bool) *Services {
+	rawDB, err := badger.Open(badger.DefaultOptions("").WithInMemory(true))
+	assert.Nil(t, err)
+	t.Cleanup(func() { rawDB.Close() })
+	consensusDb := &db.Database{}
+	err = consensusDb.Init(rawDB)
+	assert.Nil(t, err)
+
+	err = consensusDb.Update(func(txn *badger.Txn) error {
+		return consensusDb.SetCommittedBlockHeaderFastSync(txn, testBlockHeader(1024, crypto.Hasher([]byte("state"))))
+	})
+	assert.Nil(t, err)
+
+	return &Services{
+		consensusDb:    consensusDb,
+		haltOnMismatch: haltOnMismatch,
+		logger:         logging.GetLogger("services"),
+	}
+}
+
+func TestVerifySnapshot(t *testing.T) {
+	svcs := setupSnapshotServices(t, true)
+	state := &State{}
+	validator := common.HexToAddress("0x546F99F244b7B58B855330AE0E2BC1b30b41302F")
+
+	// Agrees with our chain
+	err := svcs.verifySnapshot(state, 1, validator, testBlockHeader(1024, crypto.Hasher([]byte("state"))))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), state.SnapshotMismatches)
+	assert.False(t, svcs.halted())
+
+	// Not committed yet
+	err = svcs.verifySnapshot(state, 2, validator, testBlockHeader(2048, crypto.Hasher([]byte("other"))))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), state.SnapshotMismatches)
+	assert.Len(t, state.UnverifiedSnapshots, 1)
+
+	// Disagrees with our chain
+	err = svcs.verifySnapshot(state, 1, validator, testBlockHeader(1024, crypto.Hasher([]byte("other"))))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), state.SnapshotMismatches)
+	assert.True(t, svcs.halted())
+
+	err = svcs.consensusDb.View(func(txn *badger.Txn) error {
+		alerts, err := svcs.consensusDb.GetSnapshotAlerts(txn)
+		assert.Nil(t, err)
+		assert.Len(t, alerts, 1)
+		assert.Equal(t, uint32(1024), alerts[0].Height)
+		assert.Equal(t, uint32(1), alerts[0].Epoch)
+		assert.Equal(t, validator.Bytes(), alerts[0].Validator)
+		assert.True(t, alerts[0].BClaimsMismatch)
+		assert.False(t, alerts[0].SigMismatch)
+		assert.NotEqual(t, alerts[0].LocalBlockHash, alerts[0].RemoteBlockHash)
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func TestVerifySnapshotNoHalt(t *testing.T) {
+	svcs := setupSnapshotServices(t, false)
+	state := &State{}
+
+	snapshot := testBlockHeader(1024, crypto.Hasher([]byte("state")))
+	snapshot.SigGroup[0] = 1
+	err := svcs.verifySnapshot(state, 1, common.Address{}, snapshot)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), state.SnapshotMismatches)
+	assert.False(t, svcs.halted())
+}
+
+func TestVerifyQueuedSnapshots(t *testing.T) {
+	svcs := setupSnapshotServices(t, true)
+	state := &State{}
+
+	err := svcs.verifySnapshot(state, 2, common.Address{}, testBlockHeader(2048, crypto.Hasher([]byte("other"))))
+	assert.Nil(t, err)
+	assert.Len(t, state.UnverifiedSnapshots, 1)
+
+	// Still not committed
+	err = svcs.verifyQueuedSnapshots(state)
+	assert.Nil(t, err)
+	assert.Len(t, state.UnverifiedSnapshots, 1)
+	assert.False(t, svcs.halted())
+
+	err = svcs.consensusDb.Update(func(txn *badger.Txn) error {
+		return svcs.consensusDb.SetCommittedBlockHeaderFastSync(txn, testBlockHeader(2048, crypto.Hasher([]byte("state"))))
+	})
+	assert.Nil(t, err)
+
+	err = svcs.verifyQueuedSnapshots(state)
+	assert.Nil(t, err)
+	assert.Len(t, state.UnverifiedSnapshots, 0)
+	assert.Equal(t, uint32(1), state.SnapshotMismatches)
+	assert.True(t, svcs.halted())
+
+	// Clearing the alert resumes the node
+	err = svcs.consensusDb.Update(func(txn *badger.Txn) error {
+		return svcs.consensusDb.DeleteSnapshotAlert(txn, 2048)
+	})
+	assert.Nil(t, err)
+	assert.False(t, svcs.halted())
+}
@@ -0,0 +1,359 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RemoteSignerDigestType is the content type used to have a remote signer
+// sign a bare digest. Only consensus messages are signed this way, since
+// their signatures are over the hash of the message. Clef refuses to sign
+// bare digests, so CheckDigestSigning is run at startup by nodes that sign
+// consensus messages.
+const RemoteSignerDigestType = "application/x-digest"
+
+// Signer errors
+var (
+	ErrSignerMismatch   = errors.New("signature is not what was asked of the signer")
+	ErrSignerNoAccount  = errors.New("signer does not hold the account")
+	ErrSignerBadContent = errors.New("content type not supported by signer")
+	ErrSignerNoDigests  = errors.New("signer does not sign digests of type " + RemoteSignerDigestType)
+)
+
+// Signer signs on behalf of Ethereum accounts without handing out their keys
+type Signer interface {
+	Accounts() ([]common.Address, error)
+	SignTxn(from common.Address, txn *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignText(from common.Address, text []byte) ([]byte, error)
+	SignHash(from common.Address, hash []byte) ([]byte, error)
+}
+
+// SignerPublicKey finds the public key of an account by having the signer
+// sign a text message for it
+func SignerPublicKey(signer Signer, from common.Address) ([]byte, error) {
+	text := []byte("public key of " + from.Hex())
+	sig, err := signer.SignText(from, text)
+	if err != nil {
+		return nil, err
+	}
+	return recoverSigner(from, accounts.TextHash(text), sig)
+}
+
+// CheckDigestSigning makes sure the signer signs bare digests for an account
+// before it is relied on to sign consensus messages
+func CheckDigestSigning(signer Signer, from common.Address) error {
+	hash := crypto.Keccak256([]byte("digest of " + from.Hex()))
+	sig, err := signer.SignHash(from, hash)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSignerNoDigests, err)
+	}
+	_, err = recoverSigner(from, hash, sig)
+	return err
+}
+
+// recoverSigner returns the public key of the signature of hash, which must
+// be that of from
+func recoverSigner(from common.Address, hash []byte, sig []byte) ([]byte, error) {
+	pubk, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, err
+	}
+	if crypto.PubkeyToAddress(*pubk) != from {
+		return nil, ErrSignerMismatch
+	}
+	return crypto.FromECDSAPub(pubk), nil
+}
+
+// keystoreSigner signs with keys from the keystore. Accounts have to be
+// unlocked before use.
+type keystoreSigner struct {
+	keystore *keystore.KeyStore
+}
+
+func (ks *keystoreSigner) Accounts() ([]common.Address, error) {
+	addresses := []common.Address{}
+	for _, acct := range ks.keystore.Accounts() {
+		addresses = append(addresses, acct.Address)
+	}
+	return addresses, nil
+}
+
+func (ks *keystoreSigner) SignTxn(from common.Address, txn *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return ks.keystore.SignTx(accounts.Account{Address: from}, txn, chainID)
+}
+
+func (ks *keystoreSigner) SignText(from common.Address, text []byte) ([]byte, error) {
+	return ks.keystore.SignHash(accounts.Account{Address: from}, accounts.TextHash(text))
+}
+
+func (ks *keystoreSigner) SignHash(from common.Address, hash []byte) ([]byte, error) {
+	return ks.keystore.SignHash(accounts.Account{Address: from}, hash)
+}
+
+// signTxnArgs is the transaction handed to a remote signer. It follows
+// Clef's account_signTransaction.
+type signTxnArgs struct {
+	From     common.MixedcaseAddress  `json:"from"`
+	To       *common.MixedcaseAddress `json:"to"`
+	Gas      hexutil.Uint64           `json:"gas"`
+	GasPrice hexutil.Big              `json:"gasPrice"`
+	Value    hexutil.Big              `json:"value"`
+	Nonce    hexutil.Uint64           `json:"nonce"`
+	Data     *hexutil.Bytes           `json:"data"`
+}
+
+// signTxnResult is what a remote signer returns for a transaction
+type signTxnResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+// remoteSigner has a Clef-style signer reachable over HTTP or a Unix socket
+// sign for the node, so keys never reside on the node host
+type remoteSigner struct {
+	client  *rpc.Client
+	timeout time.Duration
+}
+
+// NewRemoteSigner connects to the signer at url, which is either an HTTP
+// endpoint or the path of a Unix socket
+func NewRemoteSigner(url string, timeout time.Duration) (Signer, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSigner{client: client, timeout: timeout}, nil
+}
+
+func (rs *remoteSigner) Close() error {
+	rs.client.Close()
+	return nil
+}
+
+func (rs *remoteSigner) Accounts() ([]common.Address, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	addresses := []common.Address{}
+	err := rs.client.CallContext(ctx, &addresses, "account_list")
+	if err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+func (rs *remoteSigner) SignTxn(from common.Address, txn *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	data := hexutil.Bytes(txn.Data())
+	args := signTxnArgs{
+		From:     common.NewMixedcaseAddress(from),
+		Gas:      hexutil.Uint64(txn.Gas()),
+		GasPrice: hexutil.Big(*txn.GasPrice()),
+		Value:    hexutil.Big(*txn.Value()),
+		Nonce:    hexutil.Uint64(txn.Nonce()),
+		Data:     &data,
+	}
+	if txn.To() != nil {
+		to := common.NewMixedcaseAddress(*txn.To())
+		args.To = &to
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	result := &signTxnResult{}
+	err := rs.client.CallContext(ctx, result, "account_signTransaction", &args)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := new(types.Transaction)
+	err = rlp.DecodeBytes(result.Raw, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signer must have signed what it was given, for this chain and account
+	signer := types.NewEIP155Signer(chainID)
+	if signer.Hash(signed) != signer.Hash(txn) {
+		return nil, ErrSignerMismatch
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, err
+	}
+	if sender != from {
+		return nil, ErrSignerMismatch
+	}
+
+	return signed, nil
+}
+
+func (rs *remoteSigner) SignText(from common.Address, text []byte) ([]byte, error) {
+	return rs.signData(from, accounts.MimetypeTextPlain, text)
+}
+
+func (rs *remoteSigner) SignHash(from common.Address, hash []byte) ([]byte, error) {
+	return rs.signData(from, RemoteSignerDigestType, hash)
+}
+
+// signData has the signer sign data of the given content type
+func (rs *remoteSigner) signData(from common.Address, contentType string, data []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	var sig hexutil.Bytes
+	addr := common.NewMixedcaseAddress(from)
+	err := rs.client.CallContext(ctx, &sig, "account_signData", contentType, &addr, hexutil.Bytes(data))
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("signer returned a signature of %v bytes", len(sig))
+	}
+
+	// Clef returns V as 27 or 28
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	return sig, nil
+}
+
+// LocalSigner is a stand-in for a remote signer that holds its keys in
+// memory. It serves the same JSON-RPC API, which makes it useful in tests.
+// Like Clef it can be made to refuse digests.
+type LocalSigner struct {
+	chainID   *big.Int
+	keys      map[common.Address]*ecdsa.PrivateKey
+	noDigests bool
+}
+
+// NewLocalSigner returns a signer for the given keys on the given chain
+func NewLocalSigner(chainID *big.Int, keys ...*ecdsa.PrivateKey) *LocalSigner {
+	ls := &LocalSigner{
+		chainID: chainID,
+		keys:    make(map[common.Address]*ecdsa.PrivateKey),
+	}
+	for _, key := range keys {
+		ls.keys[crypto.PubkeyToAddress(key.PublicKey)] = key
+	}
+	return ls
+}
+
+// Accounts returns the accounts held, in ascending order
+func (ls *LocalSigner) Accounts() ([]common.Address, error) {
+	addresses := []common.Address{}
+	for addr := range ls.keys {
+		addresses = append(addresses, addr)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i].Hex() < addresses[j].Hex() })
+	return addresses, nil
+}
+
+// SignTxn signs a transaction for the chain
+func (ls *LocalSigner) SignTxn(from common.Address, txn *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, ok := ls.keys[from]
+	if !ok {
+		return nil, ErrSignerNoAccount
+	}
+	return types.SignTx(txn, types.NewEIP155Signer(chainID), key)
+}
+
+// RefuseDigests makes the signer refuse to sign bare digests like Clef does
+func (ls *LocalSigner) RefuseDigests() {
+	ls.noDigests = true
+}
+
+// SignText signs a text message
+func (ls *LocalSigner) SignText(from common.Address, text []byte) ([]byte, error) {
+	key, ok := ls.keys[from]
+	if !ok {
+		return nil, ErrSignerNoAccount
+	}
+	return crypto.Sign(accounts.TextHash(text), key)
+}
+
+// SignHash signs a digest
+func (ls *LocalSigner) SignHash(from common.Address, hash []byte) ([]byte, error) {
+	if ls.noDigests {
+		return nil, ErrSignerBadContent
+	}
+	key, ok := ls.keys[from]
+	if !ok {
+		return nil, ErrSignerNoAccount
+	}
+	return crypto.Sign(hash, key)
+}
+
+// Server returns a JSON-RPC server answering like a remote signer
+func (ls *LocalSigner) Server() (*rpc.Server, error) {
+	server := rpc.NewServer()
+	err := server.RegisterName("account", &localSignerAPI{ls})
+	if err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// localSignerAPI is the JSON-RPC API of a LocalSigner
+type localSignerAPI struct {
+	ls *LocalSigner
+}
+
+func (api *localSignerAPI) List() ([]common.Address, error) {
+	return api.ls.Accounts()
+}
+
+func (api *localSignerAPI) SignTransaction(args signTxnArgs, methodSelector *string) (*signTxnResult, error) {
+	var data []byte
+	if args.Data != nil {
+		data = *args.Data
+	}
+	var txn *types.Transaction
+	if args.To == nil {
+		txn = types.NewContractCreation(uint64(args.Nonce), args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), data)
+	} else {
+		txn = types.NewTransaction(uint64(args.Nonce), args.To.Address(), args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), data)
+	}
+
+	// Like Clef, the chain is the signer's own
+	signed, err := api.ls.SignTxn(args.From.Address(), txn, api.ls.chainID)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := rlp.EncodeToBytes(signed)
+	if err != nil {
+		return nil, err
+	}
+	return &signTxnResult{Raw: raw, Tx: signed}, nil
+}
+
+func (api *localSignerAPI) SignData(contentType string, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	var sig []byte
+	var err error
+	switch contentType {
+	case accounts.MimetypeTextPlain:
+		sig, err = api.ls.SignText(addr.Address(), data)
+	case RemoteSignerDigestType:
+		sig, err = api.ls.SignHash(addr.Address(), data)
+	default:
+		return nil, ErrSignerBadContent
+	}
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
@@ -0,0 +1,161 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRemoteSigner(t *testing.T, ls *LocalSigner) *remoteSigner {
+	server, err := ls.Server()
+	assert.Nil(t, err)
+	return &remoteSigner{client: rpc.DialInProc(server), timeout: time.Second}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(1337)
+
+	rs := newTestRemoteSigner(t, NewLocalSigner(chainID, key))
+	defer rs.Close()
+
+	addresses, err := rs.Accounts()
+	assert.Nil(t, err)
+	assert.Equal(t, []common.Address{addr}, addresses)
+
+	to := common.HexToAddress("0x546F99F244b7B58B855330AE0E2BC1b30b41302F")
+	txns := []*types.Transaction{
+		types.NewTransaction(3, to, big.NewInt(5), 21000, big.NewInt(7), []byte{1, 2}),
+		types.NewContractCreation(4, big.NewInt(0), 100000, big.NewInt(7), []byte{3, 4}),
+	}
+	for _, txn := range txns {
+		signed, err := rs.SignTxn(addr, txn, chainID)
+		assert.Nil(t, err)
+		sender, err := types.Sender(types.NewEIP155Signer(chainID), signed)
+		assert.Nil(t, err)
+		assert.Equal(t, addr, sender)
+		assert.Equal(t, txn.Nonce(), signed.Nonce())
+		assert.Equal(t, txn.To(), signed.To())
+		assert.Equal(t, txn.Data(), signed.Data())
+	}
+
+	hash := crypto.Keccak256([]byte("A message to sign"))
+	sig, err := rs.SignHash(addr, hash)
+	assert.Nil(t, err)
+	pubk, err := crypto.SigToPub(hash, sig)
+	assert.Nil(t, err)
+	assert.Equal(t, addr, crypto.PubkeyToAddress(*pubk))
+
+	publicKey, err := SignerPublicKey(rs, addr)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.FromECDSAPub(&key.PublicKey), publicKey)
+	assert.Nil(t, CheckDigestSigning(rs, addr))
+
+	// Accounts the signer doesn't hold
+	_, err = rs.SignHash(to, hash)
+	assert.NotNil(t, err)
+	_, err = SignerPublicKey(rs, to)
+	assert.NotNil(t, err)
+}
+
+func TestRemoteSignerNoDigests(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(1337)
+
+	ls := NewLocalSigner(chainID, key)
+	ls.RefuseDigests()
+	rs := newTestRemoteSigner(t, ls)
+	defer rs.Close()
+
+	// Transactions and public keys don't need digests
+	to := common.HexToAddress("0x546F99F244b7B58B855330AE0E2BC1b30b41302F")
+	signed, err := rs.SignTxn(addr, types.NewTransaction(3, to, big.NewInt(5), 21000, big.NewInt(7), nil), chainID)
+	assert.Nil(t, err)
+	sender, err := types.Sender(types.NewEIP155Signer(chainID), signed)
+	assert.Nil(t, err)
+	assert.Equal(t, addr, sender)
+	publicKey, err := SignerPublicKey(rs, addr)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.FromECDSAPub(&key.PublicKey), publicKey)
+
+	// Consensus signing is refused up front
+	err = CheckDigestSigning(rs, addr)
+	assert.True(t, errors.Is(err, ErrSignerNoDigests))
+}
+
+func TestRemoteSignerWrongChain(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	rs := newTestRemoteSigner(t, NewLocalSigner(big.NewInt(5), key))
+	defer rs.Close()
+
+	txn := types.NewTransaction(0, addr, big.NewInt(0), 21000, big.NewInt(1), nil)
+	_, err = rs.SignTxn(addr, txn, big.NewInt(1337))
+	assert.NotNil(t, err)
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	from := common.HexToAddress("0x546F99F244b7B58B855330AE0E2BC1b30b41302F")
+	to := common.HexToAddress("0x9AC1c9afBAec85278679fF75Ef109217f26b1417")
+
+	eth, err := NewEthereumSimulator(
+		"../assets/test/keys",
+		"../assets/test/passcodes.txt",
+		1,
+		time.Second,
+		0,
+		big.NewInt(1000000000000000000),
+		from.Hex(), to.Hex())
+	assert.Nil(t, err)
+	defer eth.Close()
+
+	acct, err := eth.GetAccount(from)
+	assert.Nil(t, err)
+
+	// Nothing is signed before the account is unlocked
+	_, err = SignerPublicKey(eth.GetSigner(), from)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, eth.UnlockAccount(acct))
+	keys, err := eth.GetAccountKeys(from)
+	assert.Nil(t, err)
+
+	publicKey, err := SignerPublicKey(eth.GetSigner(), from)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.FromECDSAPub(&keys.PrivateKey.PublicKey), publicKey)
+	assert.Nil(t, CheckDigestSigning(eth.GetSigner(), from))
+
+	before, err := eth.GetBalance(to)
+	assert.Nil(t, err)
+	err = eth.TransferEther(from, to, big.NewInt(1000))
+	assert.Nil(t, err)
+	eth.Commit()
+	after, err := eth.GetBalance(to)
+	assert.Nil(t, err)
+	assert.Equal(t, new(big.Int).Add(before, big.NewInt(1000)), after)
+
+	txnOpts, err := eth.GetTransactionOpts(context.Background(), acct)
+	assert.Nil(t, err)
+	txn := types.NewTransaction(1, to, big.NewInt(0), 21000, big.NewInt(1), nil)
+	_, err = txnOpts.Signer(types.HomesteadSigner{}, to, txn)
+	assert.NotNil(t, err)
+	signed, err := txnOpts.Signer(types.HomesteadSigner{}, from, txn)
+	assert.Nil(t, err)
+	sender, err := types.Sender(types.NewEIP155Signer(big.NewInt(1337)), signed)
+	assert.Nil(t, err)
+	assert.Equal(t, from, sender)
+}
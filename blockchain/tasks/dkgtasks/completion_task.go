@@ -64,7 +64,7 @@ func (t *CompletionTask) doTask(ctx context.Context) bool {
 	}
 
 	// Waiting for receipt
-	receipt, err := t.eth.WaitForReceipt(ctx, txn)
+	receipt, err := t.eth.WaitForReceiptBefore(ctx, txn, t.lastBlock)
 	if err != nil {
 		t.logger.Errorf("waiting for receipt failed: %v", err)
 		return false
@@ -47,14 +47,16 @@ func connectSimulatorEndpoint(t *testing.T) blockchain.Ethereum {
 
 func connectRemoteEndpoint(t *testing.T) blockchain.Ethereum {
 	eth, err := blockchain.NewEthereumEndpoint(
-		"http://192.168.86.29:8545",
+		[]string{"http://192.168.86.29:8545"},
 		"keystore_test",
 		"assets_test/passcodes.txt",
 		accountAddresses[0],
 		3*time.Second, // This is the timeout for blocking actions
 		30,            // Let's do lots of retries
 		1*time.Second, // This is the retry delay
-		2)             // For testing finality is 2 blocks
+		2,             // For testing finality is 2 blocks
+		0,             // No gas price ceiling
+		"")            // Keys come from the keystore
 	assert.Nil(t, err)
 
 	return eth
@@ -78,7 +78,7 @@ func (t *GPKJDisputeTask) doTask(ctx context.Context) {
 	}
 
 	// Waiting for receipt
-	receipt, err := t.eth.WaitForReceipt(ctx, txn)
+	receipt, err := t.eth.WaitForReceiptBefore(ctx, txn, t.lastBlock)
 	if err != nil {
 		t.logger.Errorf("waiting for receipt failed: %v", err)
 		return
@@ -71,7 +71,7 @@ func (t *GPKSubmissionTask) doTask(ctx context.Context) bool {
 	}
 
 	// Waiting for receipt
-	receipt, err := t.eth.WaitForReceipt(ctx, txn)
+	receipt, err := t.eth.WaitForReceiptBefore(ctx, txn, t.lastBlock)
 	if err != nil {
 		t.logger.Errorf("waiting for receipt failed: %v", err)
 		return false
@@ -16,13 +16,16 @@ import (
 // Manager describtes the basic functionality of a task Manager
 type Manager interface {
 	NewTaskHandler(timeout time.Duration, retryDelay time.Duration, t Task) TaskHandler
+	CancelTasks()
 	WaitForTasks()
 }
 
 // ManagerDetails contains information required for implmentation of task Manager
 type ManagerDetails struct {
-	wg     sync.WaitGroup
-	logger *logrus.Logger
+	sync.Mutex
+	wg       sync.WaitGroup
+	logger   *logrus.Logger
+	handlers []*TaskHandlerDetails
 }
 
 // ========================================================
@@ -39,7 +42,7 @@ func (md *ManagerDetails) NewTaskHandler(
 
 	md.logger.Infof("Creating task %v with timeout of %v and retryDelay of %v", taskID, timeout, retryDelay)
 
-	return &TaskHandlerDetails{
+	th := &TaskHandlerDetails{
 		ID:          taskID,
 		doWork:      task.DoWork,
 		doRetry:     task.DoRetry,
@@ -50,6 +53,31 @@ func (md *ManagerDetails) NewTaskHandler(
 		timeout:     timeout,
 		retryDelay:  retryDelay,
 	}
+
+	// Keep track of the handlers that might still be running
+	md.Lock()
+	defer md.Unlock()
+	handlers := []*TaskHandlerDetails{th}
+	for _, handler := range md.handlers {
+		if !handler.Complete() {
+			handlers = append(handlers, handler)
+		}
+	}
+	md.handlers = handlers
+
+	return th
+}
+
+// CancelTasks cancels every task of this Manager that has not completed
+func (md *ManagerDetails) CancelTasks() {
+	md.Lock()
+	defer md.Unlock()
+	for _, handler := range md.handlers {
+		if !handler.Complete() {
+			handler.Cancel()
+		}
+	}
+	md.handlers = nil
 }
 
 // WaitForTasks blocks until all tasks associated withis Manager have completed
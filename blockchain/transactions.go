@@ -0,0 +1,329 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrGasPriceCeiling is returned when a transaction can't be replaced
+// without going over the gas price ceiling
+var ErrGasPriceCeiling = errors.New("gas price ceiling reached")
+
+// trackedTxn is a transaction sent but not yet mined
+type trackedTxn struct {
+	txn      *types.Transaction // latest version sent
+	hashes   []common.Hash      // every version sent
+	sentAt   uint64             // height the latest version was first seen at
+	deadline uint64             // height the transaction must be mined by, 0 for none
+}
+
+// txnTracker sits between the contract bindings and the Ethereum client. It
+// prices transactions, hands out nonces that account for transactions still
+// in flight and replaces transactions that are not mined in time with ones
+// paying a higher gas price.
+//
+// Only legacy transactions are sent. The go-ethereum version in use predates
+// typed transactions, so EIP-1559 dynamic fee transactions can not be built
+// and the gas price ceiling plays the role of the fee cap. On a chain with a
+// base fee a legacy transaction pays its whole gas price and no tip is set.
+//
+// TODO Price and bump GasFeeCap and GasTipCap once go-ethereum is upgraded
+// past v1.10.5. The upgrade is blocked by the contract bindings of
+// github.com/MadBase/bridge, which are generated for the BoundContract.Call
+// signature of v1.9 and have to be regenerated first.
+type txnTracker struct {
+	GethClient
+	sync.Mutex
+	logger      *logrus.Logger
+	signer      types.Signer
+	sign        func(common.Address, *types.Transaction) (*types.Transaction, error)
+	maxGasPrice *big.Int
+	inflight    map[common.Address]map[uint64]*trackedTxn
+	closeChan   chan struct{}
+	closeOnce   sync.Once
+}
+
+func newTxnTracker(logger *logrus.Logger, client GethClient, chainID *big.Int, maxGasPrice *big.Int,
+	sign func(common.Address, *types.Transaction) (*types.Transaction, error)) *txnTracker {
+	return &txnTracker{
+		GethClient:  client,
+		logger:      logger,
+		signer:      types.NewEIP155Signer(chainID),
+		sign:        sign,
+		maxGasPrice: maxGasPrice,
+		inflight:    make(map[common.Address]map[uint64]*trackedTxn),
+		closeChan:   make(chan struct{}),
+	}
+}
+
+// start checks on the transactions in flight until the tracker is closed
+func (tt *txnTracker) start(interval time.Duration, timeout time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-tt.closeChan:
+				return
+			case <-time.After(interval):
+				ctx, cancel := context.WithTimeout(context.Background(), timeout)
+				err := tt.check(ctx)
+				cancel()
+				if err != nil {
+					tt.logger.Warnf("Could not check transactions in flight: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (tt *txnTracker) close() {
+	tt.closeOnce.Do(func() {
+		close(tt.closeChan)
+	})
+}
+
+// SuggestGasPrice returns the price suggested by the endpoint held to the
+// ceiling
+func (tt *txnTracker) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	price, err := tt.GethClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tt.maxGasPrice != nil && price.Cmp(tt.maxGasPrice) > 0 {
+		tt.logger.Warnf("Suggested gas price %v is over the ceiling of %v", price, tt.maxGasPrice)
+		return new(big.Int).Set(tt.maxGasPrice), nil
+	}
+	return price, nil
+}
+
+// PendingNonceAt returns the next nonce of the account. Transactions sent
+// but not yet seen by the endpoint, e.g. because they went to another
+// endpoint, are taken into account.
+func (tt *txnTracker) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	nonce, err := tt.GethClient.PendingNonceAt(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+	tt.Lock()
+	defer tt.Unlock()
+	for n := range tt.inflight[account] {
+		if n >= nonce {
+			nonce = n + 1
+		}
+	}
+	return nonce, nil
+}
+
+// SendTransaction sends the transaction and tracks it until it is mined. A
+// transaction reusing the nonce of one in flight is tracked as a new
+// version of it.
+func (tt *txnTracker) SendTransaction(ctx context.Context, txn *types.Transaction) error {
+	err := tt.GethClient.SendTransaction(ctx, txn)
+	if err != nil {
+		return err
+	}
+	from, err := types.Sender(tt.signer, txn)
+	if err != nil {
+		tt.logger.Warnf("Can't track transaction %v: %v", txn.Hash().Hex(), err)
+		return nil
+	}
+	tt.Lock()
+	defer tt.Unlock()
+	if _, ok := tt.inflight[from]; !ok {
+		tt.inflight[from] = make(map[uint64]*trackedTxn)
+	}
+	tracked, ok := tt.inflight[from][txn.Nonce()]
+	if !ok {
+		tracked = &trackedTxn{}
+		tt.inflight[from][txn.Nonce()] = tracked
+	}
+	tracked.txn = txn
+	tracked.hashes = append(tracked.hashes, txn.Hash())
+	tracked.sentAt = 0
+	return nil
+}
+
+func (tt *txnTracker) lookup(txn *types.Transaction) (*trackedTxn, bool) {
+	from, err := types.Sender(tt.signer, txn)
+	if err != nil {
+		return nil, false
+	}
+	tracked, ok := tt.inflight[from][txn.Nonce()]
+	return tracked, ok
+}
+
+// setDeadline records the height the transaction has to be mined by
+func (tt *txnTracker) setDeadline(txn *types.Transaction, deadline uint64) {
+	tt.Lock()
+	defer tt.Unlock()
+	if tracked, ok := tt.lookup(txn); ok {
+		tracked.deadline = deadline
+	}
+}
+
+// versions returns the hashes of every version of the transaction sent
+func (tt *txnTracker) versions(txn *types.Transaction) []common.Hash {
+	tt.Lock()
+	defer tt.Unlock()
+	if tracked, ok := tt.lookup(txn); ok {
+		return append([]common.Hash{}, tracked.hashes...)
+	}
+	return []common.Hash{txn.Hash()}
+}
+
+// mined forgets the transactions of the account up to the mined nonce
+func (tt *txnTracker) mined(txn *types.Transaction) {
+	from, err := types.Sender(tt.signer, txn)
+	if err != nil {
+		return
+	}
+	tt.Lock()
+	defer tt.Unlock()
+	tt.forget(from, txn.Nonce()+1)
+}
+
+// forget drops the transactions of the account below the nonce
+func (tt *txnTracker) forget(from common.Address, nonce uint64) {
+	for n := range tt.inflight[from] {
+		if n < nonce {
+			delete(tt.inflight[from], n)
+		}
+	}
+	if len(tt.inflight[from]) == 0 {
+		delete(tt.inflight, from)
+	}
+}
+
+// replacementInterval is the number of blocks a transaction may wait before
+// it is replaced. Close to the deadline it shrinks so there is still time
+// for a replacement to be mined.
+func replacementInterval(height uint64, deadline uint64) uint64 {
+	interval := uint64(constants.TxnReplacementBlocks)
+	if deadline > 0 {
+		remaining := uint64(0)
+		if deadline > height {
+			remaining = deadline - height
+		}
+		if remaining/2 < interval {
+			interval = remaining / 2
+		}
+		if interval < 1 {
+			interval = 1
+		}
+	}
+	return interval
+}
+
+// check forgets the transactions that have been mined and replaces those
+// that have waited too long
+func (tt *txnTracker) check(ctx context.Context) error {
+	header, err := tt.GethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	height := header.Number.Uint64()
+
+	tt.Lock()
+	accounts := []common.Address{}
+	for from := range tt.inflight {
+		accounts = append(accounts, from)
+	}
+	tt.Unlock()
+
+	for _, from := range accounts {
+		nonce, err := tt.GethClient.NonceAt(ctx, from, nil)
+		if err != nil {
+			return err
+		}
+
+		stuck := []*types.Transaction{}
+		tt.Lock()
+		tt.forget(from, nonce)
+		for _, tracked := range tt.inflight[from] {
+			if tracked.sentAt == 0 {
+				tracked.sentAt = height
+				continue
+			}
+			if height >= tracked.sentAt+replacementInterval(height, tracked.deadline) {
+				stuck = append(stuck, tracked.txn)
+			}
+		}
+		tt.Unlock()
+
+		for _, txn := range stuck {
+			_, err := tt.replace(ctx, txn)
+			if err != nil {
+				tt.logger.Warnf("Could not replace transaction %v: %v", txn.Hash().Hex(), err)
+			}
+			// Either way wait another interval before trying again
+			tt.Lock()
+			if tracked, ok := tt.lookup(txn); ok {
+				tracked.sentAt = height
+			}
+			tt.Unlock()
+		}
+	}
+	return nil
+}
+
+// bumpedGasPrice returns the gas price for a replacement. It is at least
+// TxnReplacementBump percent over the old price, which is more than nodes
+// require to accept a replacement, and no less than currently suggested.
+func (tt *txnTracker) bumpedGasPrice(ctx context.Context, old *big.Int) (*big.Int, error) {
+	price := new(big.Int).Mul(old, big.NewInt(100+constants.TxnReplacementBump))
+	price.Div(price, big.NewInt(100))
+	price.Add(price, big.NewInt(1))
+
+	suggested, err := tt.GethClient.SuggestGasPrice(ctx)
+	if err == nil && suggested.Cmp(price) > 0 {
+		price = suggested
+	}
+
+	if tt.maxGasPrice != nil && price.Cmp(tt.maxGasPrice) > 0 {
+		if old.Cmp(tt.maxGasPrice) >= 0 {
+			return nil, ErrGasPriceCeiling
+		}
+		price = new(big.Int).Set(tt.maxGasPrice)
+	}
+	return price, nil
+}
+
+// replace sends the same transaction again with a higher gas price
+func (tt *txnTracker) replace(ctx context.Context, txn *types.Transaction) (*types.Transaction, error) {
+	from, err := types.Sender(tt.signer, txn)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := tt.bumpedGasPrice(ctx, txn.GasPrice())
+	if err != nil {
+		return nil, err
+	}
+
+	var replacement *types.Transaction
+	if txn.To() == nil {
+		replacement = types.NewContractCreation(txn.Nonce(), txn.Value(), txn.Gas(), price, txn.Data())
+	} else {
+		replacement = types.NewTransaction(txn.Nonce(), *txn.To(), txn.Value(), txn.Gas(), price, txn.Data())
+	}
+	replacement, err = tt.sign(from, replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	tt.logger.Infof("Replacing transaction %v (nonce:%v gasPrice:%v) with %v (gasPrice:%v)",
+		txn.Hash().Hex(), txn.Nonce(), txn.GasPrice(), replacement.Hash().Hex(), price)
+
+	err = tt.SendTransaction(ctx, replacement)
+	if err != nil {
+		return nil, err
+	}
+	return replacement, nil
+}
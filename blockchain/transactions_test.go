@@ -0,0 +1,182 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/MadBase/MadNet/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+type txnClient struct {
+	GethClient
+	height       uint64
+	pendingNonce uint64
+	minedNonce   uint64
+	gasPrice     *big.Int
+	sent         []*types.Transaction
+}
+
+func (c *txnClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(c.height)}, nil
+}
+
+func (c *txnClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.gasPrice, nil
+}
+
+func (c *txnClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *txnClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return c.minedNonce, nil
+}
+
+func (c *txnClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	c.sent = append(c.sent, tx)
+	return nil
+}
+
+func newTestTracker(t *testing.T, client *txnClient, maxGasPrice *big.Int) (*txnTracker, *ecdsa.PrivateKey) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+
+	chainID := big.NewInt(1337)
+	sign := func(from common.Address, txn *types.Transaction) (*types.Transaction, error) {
+		return types.SignTx(txn, types.NewEIP155Signer(chainID), key)
+	}
+	return newTxnTracker(logging.GetLogger("ethereum"), client, chainID, maxGasPrice, sign), key
+}
+
+func sendTestTxn(t *testing.T, tt *txnTracker, key *ecdsa.PrivateKey, gasPrice int64) *types.Transaction {
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	nonce, err := tt.PendingNonceAt(context.Background(), from)
+	assert.Nil(t, err)
+
+	txn := types.NewTransaction(nonce, common.Address{}, big.NewInt(0), 21000, big.NewInt(gasPrice), nil)
+	txn, err = tt.sign(from, txn)
+	assert.Nil(t, err)
+
+	err = tt.SendTransaction(context.Background(), txn)
+	assert.Nil(t, err)
+	return txn
+}
+
+func TestTxnTrackerNonces(t *testing.T) {
+	client := &txnClient{pendingNonce: 3}
+	tt, key := newTestTracker(t, client, nil)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	// The endpoint has not seen the first transaction yet
+	first := sendTestTxn(t, tt, key, 10)
+	second := sendTestTxn(t, tt, key, 10)
+	assert.Equal(t, uint64(3), first.Nonce())
+	assert.Equal(t, uint64(4), second.Nonce())
+
+	// Nothing in flight is below what the endpoint reports
+	client.pendingNonce = 7
+	nonce, err := tt.PendingNonceAt(context.Background(), from)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), nonce)
+
+	client.pendingNonce = 3
+	tt.mined(second)
+	nonce, err = tt.PendingNonceAt(context.Background(), from)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), nonce)
+	assert.Len(t, tt.inflight, 0)
+}
+
+func TestTxnTrackerGasPriceCeiling(t *testing.T) {
+	client := &txnClient{gasPrice: big.NewInt(200)}
+	tt, _ := newTestTracker(t, client, big.NewInt(100))
+
+	price, err := tt.SuggestGasPrice(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), price)
+
+	client.gasPrice = big.NewInt(50)
+	price, err = tt.SuggestGasPrice(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), price)
+}
+
+func TestTxnTrackerBumpedGasPrice(t *testing.T) {
+	client := &txnClient{gasPrice: big.NewInt(10)}
+	tt, _ := newTestTracker(t, client, big.NewInt(150))
+
+	price, err := tt.bumpedGasPrice(context.Background(), big.NewInt(100))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(126), price)
+
+	// The suggested price wins when it is higher
+	client.gasPrice = big.NewInt(140)
+	price, err = tt.bumpedGasPrice(context.Background(), big.NewInt(100))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(140), price)
+
+	// Held to the ceiling
+	price, err = tt.bumpedGasPrice(context.Background(), big.NewInt(130))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(150), price)
+
+	_, err = tt.bumpedGasPrice(context.Background(), big.NewInt(150))
+	assert.Equal(t, ErrGasPriceCeiling, err)
+}
+
+func TestTxnTrackerReplacesStuckTxn(t *testing.T) {
+	client := &txnClient{height: 100, gasPrice: big.NewInt(10)}
+	tt, key := newTestTracker(t, client, nil)
+
+	txn := sendTestTxn(t, tt, key, 100)
+	tt.setDeadline(txn, 200)
+
+	// First seen
+	err := tt.check(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, client.sent, 1)
+
+	// Not stuck yet
+	client.height = 105
+	err = tt.check(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, client.sent, 1)
+
+	client.height = 106
+	err = tt.check(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, client.sent, 2)
+	replacement := client.sent[1]
+	assert.Equal(t, txn.Nonce(), replacement.Nonce())
+	assert.Equal(t, big.NewInt(126), replacement.GasPrice())
+	assert.Equal(t, []common.Hash{txn.Hash(), replacement.Hash()}, tt.versions(txn))
+
+	// Close to the deadline replacements come sooner
+	tt.setDeadline(txn, 110)
+	client.height = 108
+	err = tt.check(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, client.sent, 3)
+
+	// Once the nonce is mined the transaction is forgotten
+	client.minedNonce = txn.Nonce() + 1
+	client.height = 120
+	err = tt.check(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, client.sent, 3)
+	assert.Len(t, tt.inflight, 0)
+}
+
+func TestReplacementInterval(t *testing.T) {
+	assert.Equal(t, uint64(6), replacementInterval(100, 0))
+	assert.Equal(t, uint64(6), replacementInterval(100, 200))
+	assert.Equal(t, uint64(2), replacementInterval(100, 104))
+	assert.Equal(t, uint64(1), replacementInterval(100, 100))
+	assert.Equal(t, uint64(1), replacementInterval(100, 90))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/interfaces"
 	"github.com/MadBase/MadNet/logging"
 	"github.com/MadBase/MadNet/peering"
@@ -70,11 +71,26 @@ func bootNode(cmd *cobra.Command, args []string) {
 	handler := peering.NewBootNodeServerHandler(logger, xport.NodeAddr(), srvr)
 	defer handler.Close()
 
+	// Serve the DHT as an ordinary node seeded by the other bootnodes
+	dht, err := peering.NewDHT(logger, xport)
+	if err != nil {
+		panic(err)
+	}
+	defer dht.Close()
+	for _, bn := range config.Configuration.Transport.BootNodes() {
+		addr, err := transport.NewNodeAddr(bn)
+		if err != nil {
+			panic(err)
+		}
+		dht.Add(addr)
+	}
+	go refreshLoop(logger, dht)
+
 	localP2PAddr := xport.NodeAddr()
 	logger.Infof("Starting bootnode with address: %s", localP2PAddr.P2PAddr())
 
 	// Kick-off event loop
-	acceptLoop(logger, xport, handler)
+	acceptLoop(logger, xport, handler, dht)
 }
 
 // Server implements the bootnode protocol
@@ -150,13 +166,31 @@ func forceCleanup(conn interfaces.P2PConn) {
 	}
 }
 
-func acceptLoop(log *logrus.Logger, transport interfaces.P2PTransport, handler *peering.ServerHandler) {
+// refreshLoop keeps the DHT routing table fresh with lookups for random
+// targets
+func refreshLoop(log *logrus.Logger, dht *peering.DHT) {
+	for {
+		time.Sleep(constants.DHTLookupFreq)
+		ctx, cf := context.WithTimeout(context.Background(), constants.DHTLookupFreq)
+		_, err := dht.LookupRandom(ctx)
+		cf()
+		if err != nil {
+			log.Debug(err)
+		}
+	}
+}
+
+func acceptLoop(log *logrus.Logger, transport interfaces.P2PTransport, handler *peering.ServerHandler, dht *peering.DHT) {
 	for {
 		conn, err := transport.Accept()
 		if err != nil {
 			log.Error(err)
 			return
 		}
+		if conn.Protocol() == types.DHTProtocol {
+			go dht.HandleConnection(conn)
+			continue
+		}
 		// bind the connection to serve the request
 		go handler.HandleConnection(conn)
 		// force drop the connection after 10 seconds
@@ -29,14 +29,16 @@ func deployNode(cmd *cobra.Command, args []string) {
 	logger.Info("Deploying contracts...")
 
 	eth, err := blockchain.NewEthereumEndpoint(
-		config.Configuration.Ethereum.Endpoint,
+		config.Configuration.Ethereum.Endpoints(),
 		config.Configuration.Ethereum.Keystore,
 		config.Configuration.Ethereum.Passcodes,
 		config.Configuration.Ethereum.DefaultAccount,
 		config.Configuration.Ethereum.Timeout,
 		config.Configuration.Ethereum.RetryCount,
 		config.Configuration.Ethereum.RetryDelay,
-		config.Configuration.Ethereum.FinalityDelay)
+		config.Configuration.Ethereum.FinalityDelay,
+		config.Configuration.Ethereum.MaxGasPrice,
+		config.Configuration.Ethereum.Signer)
 	if err != nil {
 		logger.Errorf("Could not connect to Ethereum: %v", err)
 	}
@@ -0,0 +1,333 @@
+package lightnode
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/MadBase/MadNet/application/deposit"
+	"github.com/MadBase/MadNet/blockchain"
+	"github.com/MadBase/MadNet/blockchain/monitor"
+	"github.com/MadBase/MadNet/cmd/utils"
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/lightclient"
+	"github.com/MadBase/MadNet/consensus/request"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/localrpc"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/MadBase/MadNet/peering"
+	"github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/rbus"
+	mnutils "github.com/MadBase/MadNet/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var _ monitor.AdminHandler = (*lightclient.Client)(nil)
+
+// Command is the cobra.Command specifically for running as a light node
+var Command = cobra.Command{
+	Use:   "lightnode",
+	Short: "Starts a light node",
+	Long:  "Runs a MadNet node that only synchronizes and verifies block headers",
+	Run:   lightNode}
+
+func lightNode(cmd *cobra.Command, args []string) {
+
+	//////////////////////////////////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	//SETUP LOGGING AND CONTEXT///////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+
+	// create execution context for application
+	ctx := context.Background()
+	nodeCtx, cf := context.WithCancel(ctx)
+	defer cf()
+
+	// setup logger for program assembly operations
+	logger := logging.GetLogger(cmd.Name())
+	logger.Infof("Starting light node with args %v", args)
+	defer func() { logger.Warning("Goodbye.") }()
+
+	//////////////////////////////////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	//INITIALIZE LOCAL CONFIG VARS////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+
+	stateDbPath := config.Configuration.Chain.StateDbPath
+	stateDbInMemory := config.Configuration.Chain.StateDbInMemory
+
+	monitorDbPath := config.Configuration.Chain.MonitorDbPath
+	monitorDbInMemory := config.Configuration.Chain.MonitorDbInMemory
+
+	ethEndpoints := config.Configuration.Ethereum.Endpoints()
+	ethKeystore := config.Configuration.Ethereum.Keystore
+	ethPasscodes := config.Configuration.Ethereum.Passcodes
+	ethDefaultAccount := config.Configuration.Ethereum.DefaultAccount
+	ethTimeout := config.Configuration.Ethereum.Timeout
+	ethRetryCount := config.Configuration.Ethereum.RetryCount
+	ethRetryDelay := config.Configuration.Ethereum.RetryDelay
+	ethFinalityDelay := config.Configuration.Ethereum.FinalityDelay
+
+	batchSize := config.Configuration.Monitor.BatchSize
+	registryAddress := common.HexToAddress(config.Configuration.Ethereum.RegistryAddress)
+
+	oneHour := 1 * time.Hour
+	monitorInterval := config.Configuration.Monitor.Interval
+
+	chainID := uint32(config.Configuration.Chain.ID)
+
+	peerLimitMin := config.Configuration.Transport.PeerLimitMin
+	peerLimitMax := config.Configuration.Transport.PeerLimitMax
+	firewallMode := config.Configuration.Transport.FirewallMode
+	firewallHost := config.Configuration.Transport.FirewallHost
+	p2PListeningAddress := config.Configuration.Transport.P2PListeningAddress
+	xportPrivateKey := config.Configuration.Transport.PrivateKey
+
+	lStateListenAddr := config.Configuration.Transport.LocalStateListeningAddress
+
+	//////////////////////////////////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	//INITIALIZE ETHEREUM MONITORING//////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+
+	// Ethereum connection setup
+	logger.Infof("Connecting to Ethereum...")
+	eth, err := blockchain.NewEthereumEndpoint(
+		ethEndpoints,
+		ethKeystore,
+		ethPasscodes,
+		ethDefaultAccount,
+		ethTimeout,
+		ethRetryCount,
+		ethRetryDelay,
+		ethFinalityDelay,
+		config.Configuration.Ethereum.MaxGasPrice,
+		config.Configuration.Ethereum.Signer)
+	if err != nil {
+		logger.Fatalf("NewEthereumEndpoint(...) failed: %v", err)
+		panic(err)
+	}
+	if !eth.IsEthereumAccessible() {
+		logger.Fatal("Ethereum endpoint not accessible...")
+		panic(err)
+	}
+	logger.Infof("Looking up smart contracts on Ethereum...")
+	if err := eth.Contracts().LookupContracts(registryAddress); err != nil {
+		logger.Fatalf("Can't find contract registry: %v", err)
+		panic(err)
+	}
+	utils.LogStatus(logger, eth)
+
+	// The light node never signs, the account only identifies the node
+	acct := eth.GetDefaultAccount()
+	logger.Infof("Account: %v", acct.Address.Hex())
+
+	//////////////////////////////////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	//INITIALIZE DATABASE OBJECTS/////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+
+	// Open consensus state db
+	stateDb, err := mnutils.OpenBadger(
+		nodeCtx.Done(),
+		stateDbPath,
+		stateDbInMemory,
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer stateDb.Close()
+
+	// Open monitor database
+	rawMonDb, err := mnutils.OpenBadger(
+		nodeCtx.Done(),
+		monitorDbPath,
+		monitorDbInMemory,
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer rawMonDb.Close()
+	monitorDb := monitor.NewDatabaseFromExisting(rawMonDb)
+
+	//////////////////////////////////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	//CONSTRUCT AND INITIALIZE OBJECTS////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	inboundRPCDispatch := proto.NewInboundRPCDispatch()
+	stateRPCDispatch := proto.NewLocalStateDispatch()
+	conDB := &db.Database{}
+	rbusHandlers := &request.Handler{}
+	rbusClient := &request.Client{}
+	lc := &lightclient.Client{}
+	dph := &deposit.Handler{}
+	stateRPCHandler := &localrpc.Handlers{}
+
+	// Initialize consensus database
+	if err := conDB.Init(stateDb); err != nil {
+		panic(err)
+	}
+
+	// Setup the peer manager
+	peerManager, err := peering.NewPeerManager(
+		proto.NewGeneratedP2PServer(inboundRPCDispatch),
+		chainID,
+		peerLimitMin,
+		peerLimitMax,
+		firewallMode,
+		firewallHost,
+		p2PListeningAddress,
+		xportPrivateKey,
+		stateDb,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Setup the local RPC server
+	stateRPC, err := localrpc.NewStateServerHandler(
+		logging.GetLogger(constants.LoggerTransport),
+		lStateListenAddr,
+		proto.NewGeneratedLocalStateServer(stateRPCDispatch),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize deposit handler
+	if err := dph.Init(); err != nil {
+		panic(err)
+	}
+
+	// Initialize the request bus client
+	if err := rbusClient.Init(peerManager.Subscribe()); err != nil {
+		panic(err)
+	}
+
+	// Initialize the request bus handler, which only serves block headers
+	if err := rbusHandlers.Init(conDB, nil); err != nil {
+		panic(err)
+	}
+
+	// Initialize the light client
+	if err := lc.Init(chainID, conDB, rbusClient, acct.Address.Bytes()); err != nil {
+		panic(err)
+	}
+
+	// Setup Request Bus Services with the light client taking the place of
+	// the admin handlers
+	svcs := monitor.NewServices(eth, conDB, dph, lc, nil, batchSize, chainID, false)
+
+	// Setup Request Bus
+	mb, err := monitor.NewBus(rbus.NewRBus(), svcs)
+	if err != nil {
+		panic(err)
+	}
+
+	// Setup monitor
+	mon, err := monitor.NewMonitor(monitorDb, mb, monitorInterval, oneHour)
+	if err != nil {
+		panic(err)
+	}
+
+	// Setup the local RPC server handler
+	if err := stateRPCHandler.Init(conDB, nil, nil, nil, lc.Safe, nil, peerManager); err != nil {
+		panic(err)
+	}
+
+	// Expose the metrics registry on the local RPC server
+	stateRPC.Handle("/metrics", metrics.Handler())
+
+	// Register the inboundRPC handlers with the dispatch class
+	inboundRPCDispatch.RegisterP2PGetPeers(peerManager)
+	inboundRPCDispatch.RegisterP2PGetBlockHeaders(rbusHandlers)
+	inboundRPCDispatch.RegisterP2PGetSnapShotHdrNode(rbusHandlers)
+	inboundRPCDispatch.RegisterP2PGetMinedTxs(lc)
+	inboundRPCDispatch.RegisterP2PGetPendingTxs(lc)
+	inboundRPCDispatch.RegisterP2PGetSnapShotNode(lc)
+	inboundRPCDispatch.RegisterP2PGetSnapShotStateData(lc)
+	inboundRPCDispatch.RegisterP2PGossipTransaction(lc)
+	inboundRPCDispatch.RegisterP2PGossipProposal(lc)
+	inboundRPCDispatch.RegisterP2PGossipPreVote(lc)
+	inboundRPCDispatch.RegisterP2PGossipPreVoteNil(lc)
+	inboundRPCDispatch.RegisterP2PGossipPreCommit(lc)
+	inboundRPCDispatch.RegisterP2PGossipPreCommitNil(lc)
+	inboundRPCDispatch.RegisterP2PGossipNextRound(lc)
+	inboundRPCDispatch.RegisterP2PGossipNextHeight(lc)
+	inboundRPCDispatch.RegisterP2PGossipBlockHeader(lc)
+
+	// Register the localState handlers with the dispatch class
+	stateRPCDispatch.RegisterLocalStateGetBlockNumber(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetEpochNumber(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetBlockHeader(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetBlockHeaderProof(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetChainID(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetSnapshotAlerts(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateClearSnapshotAlert(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetKnownPeers(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetActivePeers(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateDisconnectPeer(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateDialPeer(stateRPCHandler)
+
+	//////////////////////////////////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	//LAUNCH ALL SERVICE GOROUTINES///////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	defer func() { logger.Warning("Graceful unwind of core process complete.") }()
+
+	monitorCancelChan, err := mon.StartEventLoop()
+	if err != nil {
+		panic(err)
+	}
+	defer func() { monitorCancelChan <- true }()
+
+	mb.StartLoop()
+	defer mb.StopLoop()
+
+	go peerManager.Start()
+	defer peerManager.Close()
+
+	go stateRPC.Serve()
+	defer stateRPC.Close()
+
+	go stateRPCHandler.Start()
+	defer stateRPCHandler.Stop()
+
+	lc.Start()
+	defer lc.Close()
+
+	//////////////////////////////////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+	//SETUP SHUTDOWN MONITORING///////////////////////////////////////////////////
+	//////////////////////////////////////////////////////////////////////////////
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case <-peerManager.CloseChan():
+	case <-lc.Done():
+	case <-signals:
+	}
+	go countSignals(logger, 5, signals)
+
+	defer func() { logger.Warning("Starting graceful unwind of core processes.") }()
+}
+
+// countSignals will cause a forced exit on repeated Ctrl+C commands
+// this is a convient escape from a deadlock during shutdown
+func countSignals(logger *logrus.Logger, num int, c chan os.Signal) {
+	<-c
+	for count := 0; count < num; count++ {
+		logger.Warnf("Send Ctrl+C %v more times to force shutdown without waiting for services.\n", num-count)
+		<-c
+	}
+	os.Exit(1)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/MadBase/MadNet/cmd/bootnode"
 	"github.com/MadBase/MadNet/cmd/deploy"
+	"github.com/MadBase/MadNet/cmd/lightnode"
 	"github.com/MadBase/MadNet/cmd/utils"
 	"github.com/MadBase/MadNet/cmd/validator"
 	"github.com/MadBase/MadNet/config"
@@ -86,7 +87,7 @@ func main() {
 			{"chain.transactionDBInMemory", "", "", &config.Configuration.Chain.TransactionDbInMemory},
 			{"chain.monitorDB", "", "", &config.Configuration.Chain.MonitorDbPath},
 			{"chain.monitorDBInMemory", "", "", &config.Configuration.Chain.MonitorDbInMemory},
-			{"ethereum.endpoint", "", "", &config.Configuration.Ethereum.Endpoint},
+			{"ethereum.endpoint", "", "Comma separated list of endpoints", &config.Configuration.Ethereum.Endpoint},
 			{"ethereum.endpointPeers", "", "Minimum peers required", &config.Configuration.Ethereum.EndpointMinimumPeers},
 			{"ethereum.keystore", "", "", &config.Configuration.Ethereum.Keystore},
 			{"ethereum.timeout", "", "", &config.Configuration.Ethereum.Timeout},
@@ -96,16 +97,26 @@ func main() {
 			{"ethereum.finalityDelay", "", "Number blocks before we consider a block final", &config.Configuration.Ethereum.FinalityDelay},
 			{"ethereum.retryCount", "", "Number of times to retry an Ethereum operation", &config.Configuration.Ethereum.RetryCount},
 			{"ethereum.retryDelay", "", "Delay between retry attempts", &config.Configuration.Ethereum.RetryDelay},
+			{"ethereum.maxGasPrice", "", "Gas price ceiling in GWei, 0 for none. Transactions are sent as legacy transactions, EIP-1559 dynamic fees are not supported", &config.Configuration.Ethereum.MaxGasPrice},
 			{"ethereum.passcodes", "", "Passcodes for keystore", &config.Configuration.Ethereum.Passcodes},
+			{"ethereum.signer", "", "Remote signer holding the keys, either a URL or the path of a socket; the keystore is used when empty", &config.Configuration.Ethereum.Signer},
 			{"ethereum.startingBlock", "", "The first block we care about", &config.Configuration.Ethereum.StartingBlock},
 			{"ethereum.registryAddress", "", "", &config.Configuration.Ethereum.RegistryAddress},
 			{"monitor.batchSize", "", "", &config.Configuration.Monitor.BatchSize},
 			{"monitor.interval", "", "", &config.Configuration.Monitor.Interval},
+			{"monitor.haltOnSnapshotMismatch", "", "Stop validating when a snapshot on Ethereum differs from the committed block header until the alert is cleared", &config.Configuration.Monitor.HaltOnSnapshotMismatch},
 			{"transport.peerLimitMin", "", "", &config.Configuration.Transport.PeerLimitMin},
 			{"transport.peerLimitMax", "", "", &config.Configuration.Transport.PeerLimitMax},
 			{"transport.privateKey", "", "", &config.Configuration.Transport.PrivateKey},
 			{"transport.originLimit", "", "", &config.Configuration.Transport.OriginLimit},
 			{"transport.whitelist", "", "", &config.Configuration.Transport.Whitelist},
+			{"transport.blacklist", "", "", &config.Configuration.Transport.Blacklist},
+			{"transport.whitelistOnly", "", "", &config.Configuration.Transport.WhitelistOnly},
+			{"transport.peerRateIn", "", "Bytes per second read from each peer, 0 for no limit", &config.Configuration.Transport.PeerRateIn},
+			{"transport.peerRateOut", "", "Bytes per second written to each peer, 0 for no limit", &config.Configuration.Transport.PeerRateOut},
+			{"transport.rateIn", "", "Bytes per second read from all peers and shared fairly between them with priority for consensus messages, 0 for no limit", &config.Configuration.Transport.RateIn},
+			{"transport.rateOut", "", "Bytes per second written to all peers and shared fairly between them, 0 for no limit", &config.Configuration.Transport.RateOut},
+			{"transport.advertisedHost", "", "Public host or IP of the node signed into its node record, defaults to the listening host", &config.Configuration.Transport.AdvertisedHost},
 			{"transport.bootnodeAddresses", "", "", &config.Configuration.Transport.BootNodeAddresses},
 			{"transport.p2pListeningAddress", "", "", &config.Configuration.Transport.P2PListeningAddress},
 			{"transport.discoveryListeningAddress", "", "", &config.Configuration.Transport.DiscoveryListeningAddress},
@@ -124,6 +135,7 @@ func main() {
 		&utils.TransferTokensCommand: {},
 		&utils.UnregisterCommand:     {},
 		&utils.DepositCommand:        {},
+		&utils.EthdkgStatusCommand:   {},
 
 		&bootnode.Command: {
 			{"bootnode.listeningAddress", "", "", &config.Configuration.BootNode.ListeningAddress},
@@ -133,6 +145,8 @@ func main() {
 			{"validator.rewardAccount", "", "", &config.Configuration.Validator.RewardAccount},
 			{"validator.rewardCurveSpec", "", "", &config.Configuration.Validator.RewardCurveSpec}},
 
+		&lightnode.Command: {},
+
 		&deploy.Command: {
 			{"deploy.migrations", "", "", &config.Configuration.Deploy.Migrations},
 			{"deploy.testMigrations", "", "", &config.Configuration.Deploy.TestMigrations}},
@@ -142,6 +156,7 @@ func main() {
 	hierarchy := map[*cobra.Command]*cobra.Command{
 		&bootnode.Command:            &rootCommand,
 		&validator.Command:           &rootCommand,
+		&lightnode.Command:           &rootCommand,
 		&deploy.Command:              &rootCommand,
 		&utils.Command:               &rootCommand,
 		&utils.ApproveTokensCommand:  &utils.Command,
@@ -150,7 +165,8 @@ func main() {
 		&utils.SendWeiCommand:        &utils.Command,
 		&utils.TransferTokensCommand: &utils.Command,
 		&utils.UnregisterCommand:     &utils.Command,
-		&utils.DepositCommand:        &utils.Command}
+		&utils.DepositCommand:        &utils.Command,
+		&utils.EthdkgStatusCommand:   &utils.EthdkgCommand}
 
 	// Convert option abstraction into concrete settings for Cobra and Viper
 	for c := range options {
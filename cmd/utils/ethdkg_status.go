@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/logging"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// EthdkgStatusCommand is the command that reports on ETHDKG as seen by a running node
+var EthdkgStatusCommand = cobra.Command{
+	Use:   "status [address]",
+	Short: "Reports the ETHDKG phase, schedule and progress of every participant",
+	Long:  "status asks the node listening at address, or at the local state listening address when not given, for the state of ETHDKG",
+	Run:   ethdkgStatusNode}
+
+// ethdkgStatusNode asks a running node, so unlike the other utils it needs no Ethereum setup
+func ethdkgStatusNode(cmd *cobra.Command, args []string) {
+	logLevel := logging.GetLogger("utils").Level
+
+	logger := logging.GetLogger(cmd.Name())
+	logger.SetLevel(logLevel)
+
+	os.Exit(ethdkgStatus(logger, cmd, args))
+}
+
+func ethdkgStatus(logger *logrus.Logger, cmd *cobra.Command, args []string) int {
+
+	address := config.Configuration.Transport.LocalStateListeningAddress
+	if len(args) > 0 {
+		address = args[0]
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), constants.MsgTimeout)
+	defer cancelFunc()
+
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		logger.Errorf("Could not connect to node at %v: %v", address, err)
+		return 1
+	}
+	defer conn.Close()
+
+	status, err := pb.NewLocalStateClient(conn).GetEthDKGStatus(ctx, &pb.EthDKGStatusRequest{})
+	if err != nil {
+		logger.Errorf("Could not get ETHDKG status: %v", err)
+		return 1
+	}
+
+	printEthDKGStatus(status)
+
+	return 0
+}
+
+func printEthDKGStatus(status *pb.EthDKGStatusResponse) {
+	fmt.Printf("Phase:           %v\n", status.Phase)
+	fmt.Printf("Account:         %v\n", status.Address)
+	fmt.Printf("Ethereum block:  %v\n", status.CurrentBlock)
+	fmt.Printf("Processed block: %v\n", status.ProcessedBlock)
+	if status.Failures > 0 {
+		fmt.Printf("Failed rounds:   %v\n", status.Failures)
+	}
+	if status.RestartBlock > 0 {
+		fmt.Printf("Restart block:   %v\n", status.RestartBlock)
+	}
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PHASE\tSTART\tEND\tCOUNTDOWN\tOUR TASK")
+	for _, window := range status.Schedule {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", window.Phase, window.StartBlock, window.EndBlock, countdown(status.CurrentBlock, window.StartBlock, window.EndBlock), window.TaskStatus)
+	}
+	w.Flush()
+	fmt.Println()
+
+	accused := 0
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "INDEX\tADDRESS\tSHARES\tKEY SHARE\tGPKJ\tMALICIOUS")
+	for _, p := range status.Participants {
+		us := ""
+		if p.Address == status.Address {
+			us = " (us)"
+		}
+		if p.Malicious {
+			accused++
+		}
+		fmt.Fprintf(w, "%v\t%v%v\t%v\t%v\t%v\t%v\n", p.Index, p.Address, us, p.SharesDistributed, p.KeyShareSubmitted, p.GPKjSubmitted, p.Malicious)
+	}
+	w.Flush()
+	fmt.Printf("\n%v participants, %v found malicious\n", len(status.Participants), accused)
+}
+
+// countdown describes where the current block is relative to a phase
+func countdown(current uint64, start uint64, end uint64) string {
+	switch {
+	case start == 0 && end == 0:
+		return "-"
+	case current < start:
+		return fmt.Sprintf("starts in %v blocks", start-current)
+	case current < end:
+		return fmt.Sprintf("ends in %v blocks", end-current)
+	}
+	return "ended"
+}
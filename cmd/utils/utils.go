@@ -11,7 +11,6 @@ import (
 	"github.com/MadBase/MadNet/config"
 	"github.com/MadBase/MadNet/logging"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -75,14 +74,16 @@ var DepositCommand = cobra.Command{
 func setupEthereum(logger *logrus.Logger) (blockchain.Ethereum, error) {
 	logger.Info("Connecting to Ethereum endpoint ...")
 	eth, err := blockchain.NewEthereumEndpoint(
-		config.Configuration.Ethereum.Endpoint,
+		config.Configuration.Ethereum.Endpoints(),
 		config.Configuration.Ethereum.Keystore,
 		config.Configuration.Ethereum.Passcodes,
 		config.Configuration.Ethereum.DefaultAccount,
 		config.Configuration.Ethereum.Timeout,
 		config.Configuration.Ethereum.RetryCount,
 		config.Configuration.Ethereum.RetryDelay,
-		config.Configuration.Ethereum.FinalityDelay)
+		config.Configuration.Ethereum.FinalityDelay,
+		config.Configuration.Ethereum.MaxGasPrice,
+		config.Configuration.Ethereum.Signer)
 
 	if err != nil {
 		return nil, err
@@ -105,9 +106,9 @@ func LogStatus(logger *logrus.Logger, eth blockchain.Ethereum) {
 		return
 	}
 
-	keys, err := eth.GetAccountKeys(acct.Address)
+	publicKey, err := blockchain.SignerPublicKey(eth.GetSigner(), acct.Address)
 	if err != nil {
-		logger.Warnf("Failed to retrieve account %v keys: %v", acct.Address.Hex(), err)
+		logger.Warnf("Failed to retrieve account %v public key: %v", acct.Address.Hex(), err)
 		return
 	}
 
@@ -172,7 +173,7 @@ func LogStatus(logger *logrus.Logger, eth blockchain.Ethereum) {
 	logger.Infof("  Validators contract: %v", c.ValidatorsAddress.Hex())
 	logger.Info(strings.Repeat("-", 80))
 	logger.Infof(" Default Account: %v", acct.Address.Hex())
-	logger.Infof("              Public key: 0x%x", crypto.FromECDSAPub(&keys.PrivateKey.PublicKey))
+	logger.Infof("              Public key: 0x%x", publicKey)
 	logger.Infof("             Wei balance: %v", weiBalance)
 	logger.Infof("   Staking token balance: %v", stakingTokenBalance)
 	logger.Infof("   Utility token balance: %v", utilityTokenBalance)
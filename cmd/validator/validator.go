@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -25,8 +26,10 @@ import (
 	"github.com/MadBase/MadNet/consensus/request"
 	"github.com/MadBase/MadNet/constants"
 	hashlib "github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
 	"github.com/MadBase/MadNet/localrpc"
 	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/metrics"
 	"github.com/MadBase/MadNet/peering"
 	"github.com/MadBase/MadNet/proto"
 	"github.com/MadBase/MadNet/rbus"
@@ -34,7 +37,6 @@ import (
 	mnutils "github.com/MadBase/MadNet/utils"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 )
 
@@ -78,7 +80,7 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	monitorDbPath := config.Configuration.Chain.MonitorDbPath
 	monitorDbInMemory := config.Configuration.Chain.MonitorDbInMemory
 
-	ethEndpoint := config.Configuration.Ethereum.Endpoint
+	ethEndpoints := config.Configuration.Ethereum.Endpoints()
 	ethKeystore := config.Configuration.Ethereum.Keystore
 	ethPasscodes := config.Configuration.Ethereum.Passcodes
 	ethDefaultAccount := config.Configuration.Ethereum.DefaultAccount
@@ -114,14 +116,16 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	// Ethereum connection setup
 	logger.Infof("Connecting to Ethereum...")
 	eth, err := blockchain.NewEthereumEndpoint(
-		ethEndpoint,
+		ethEndpoints,
 		ethKeystore,
 		ethPasscodes,
 		ethDefaultAccount,
 		ethTimeout,
 		ethRetryCount,
 		ethRetryDelay,
-		ethFinalityDelay)
+		ethFinalityDelay,
+		config.Configuration.Ethereum.MaxGasPrice,
+		config.Configuration.Ethereum.Signer)
 	if err != nil {
 		logger.Fatalf("NewEthereumEndpoint(...) failed: %v", err)
 		panic(err)
@@ -145,13 +149,18 @@ func validatorNode(cmd *cobra.Command, args []string) {
 		logger.Fatalf("Could not unlock account: %v", err)
 		panic(err)
 	}
-	keys, err := eth.GetAccountKeys(acct.Address)
+	signer := eth.GetSigner()
+	publicKey, err := blockchain.SignerPublicKey(signer, acct.Address)
 	if err != nil {
-		logger.Fatalf("Could not get GetAccountKeys: %v", err)
+		logger.Fatalf("Could not get public key: %v", err)
 		panic(err)
 	}
-	publicKey := crypto.FromECDSAPub(&keys.PrivateKey.PublicKey)
 	logger.Infof("Account: %v Public Key: 0x%x", acct.Address.Hex(), publicKey)
+	// Consensus messages are signed as bare digests, which not every signer does
+	if err := blockchain.CheckDigestSigning(signer, acct.Address); err != nil {
+		logger.Fatalf("Signer can not sign consensus messages: %v", err)
+		panic(err)
+	}
 
 	//////////////////////////////////////////////////////////////////////////////
 	//////////////////////////////////////////////////////////////////////////////
@@ -208,8 +217,11 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	dman := &dman.DMan{}
 	gh := &gossip.Handlers{}
 	gc := &gossip.Client{}
-	stateHandler := &lstate.Engine{}
+	storageDB := &dynamics.Database{}
+	storage := &dynamics.Storage{}
+	stateHandler := &lstate.Engine{Parameters: storage}
 	statusLogger := &status.Logger{}
+	health := &status.Health{}
 	cesigner := &hashlib.Secp256k1Signer{}
 	dph := &deposit.Handler{}
 	sync := &consensus.Synchronizer{}
@@ -235,6 +247,7 @@ func validatorNode(cmd *cobra.Command, args []string) {
 		firewallHost,
 		p2PListeningAddress,
 		xportPrivateKey,
+		stateDb,
 	)
 	if err != nil {
 		panic(err)
@@ -255,13 +268,23 @@ func validatorNode(cmd *cobra.Command, args []string) {
 		panic(err)
 	}
 
+	// Initialize the parameters governance may change
+	storageDB.Init(stateDb, logging.GetLogger(constants.LoggerDynamics))
+	if err := storage.Init(storageDB, logging.GetLogger(constants.LoggerDynamics)); err != nil {
+		panic(err)
+	}
+	storage.Start()
+
 	// Initialize the request bus client
 	if err := rbusClient.Init(peerManager.Subscribe()); err != nil {
 		panic(err)
 	}
 
-	// Initialize the consensus engine signer
-	if err := cesigner.SetPrivk(crypto.FromECDSA(keys.PrivateKey)); err != nil {
+	// Initialize the consensus engine signer, the keys stay with the Ethereum signer
+	signHash := func(digestHash []byte) ([]byte, error) {
+		return signer.SignHash(acct.Address, digestHash)
+	}
+	if err := cesigner.SetExternal(publicKey, signHash); err != nil {
 		panic(err)
 	}
 
@@ -311,7 +334,7 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	}
 
 	// Setup Request Bus Services
-	svcs := monitor.NewServices(eth, conDB, dph, ah, batchSize, chainID)
+	svcs := monitor.NewServices(eth, conDB, dph, ah, storage, batchSize, chainID, config.Configuration.Monitor.HaltOnSnapshotMismatch)
 
 	// Setup Request Bus
 	mb, err := monitor.NewBus(rbus.NewRBus(), svcs)
@@ -343,7 +366,7 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	}
 
 	// Setup the local RPC server handler
-	if err := stateRPCHandler.Init(conDB, app, gh, publicKey, sync.Safe); err != nil {
+	if err := stateRPCHandler.Init(conDB, app, gh, publicKey, sync.Safe, svcs, peerManager); err != nil {
 		panic(err)
 	}
 
@@ -352,6 +375,16 @@ func validatorNode(cmd *cobra.Command, args []string) {
 		panic(err)
 	}
 
+	// Initialize the health checks and expose them on the local RPC server
+	if err := health.Init(conDB, peerManager, ah, eth, svcs); err != nil {
+		panic(err)
+	}
+	stateRPC.Handle("/healthz", http.HandlerFunc(health.HandleHealthz))
+	stateRPC.Handle("/readyz", http.HandlerFunc(health.HandleReadyz))
+
+	// Expose the metrics registry on the local RPC server
+	stateRPC.Handle("/metrics", metrics.Handler())
+
 	// Register the inboundRPC handlers with the dispatch class
 	inboundRPCDispatch.RegisterP2PGetPeers(peerManager)
 	inboundRPCDispatch.RegisterP2PGossipTransaction(gh)
@@ -374,6 +407,7 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	stateRPCDispatch.RegisterLocalStateGetBlockNumber(stateRPCHandler)
 	stateRPCDispatch.RegisterLocalStateGetEpochNumber(stateRPCHandler)
 	stateRPCDispatch.RegisterLocalStateGetBlockHeader(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetBlockHeaderProof(stateRPCHandler)
 	stateRPCDispatch.RegisterLocalStateGetChainID(stateRPCHandler)
 	stateRPCDispatch.RegisterLocalStateSendTransaction(stateRPCHandler)
 	stateRPCDispatch.RegisterLocalStateGetValueForOwner(stateRPCHandler)
@@ -385,6 +419,15 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	stateRPCDispatch.RegisterLocalStateIterateNameSpace(stateRPCHandler)
 	stateRPCDispatch.RegisterLocalStateGetData(stateRPCHandler)
 	stateRPCDispatch.RegisterLocalStateGetTxBlockNumber(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetValidatorParticipation(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetConsensusTrace(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetEthDKGStatus(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetSnapshotAlerts(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateClearSnapshotAlert(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetKnownPeers(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateGetActivePeers(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateDisconnectPeer(stateRPCHandler)
+	stateRPCDispatch.RegisterLocalStateDialPeer(stateRPCHandler)
 
 	//////////////////////////////////////////////////////////////////////////////
 	//////////////////////////////////////////////////////////////////////////////
@@ -395,6 +438,9 @@ func validatorNode(cmd *cobra.Command, args []string) {
 	go statusLogger.Run()
 	defer statusLogger.Close()
 
+	health.Start()
+	defer health.Close()
+
 	monitorCancelChan, err := mon.StartEventLoop()
 	if err != nil {
 		panic(err)
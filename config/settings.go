@@ -34,19 +34,22 @@ type ethereumConfig struct {
 	EndpointMinimumPeers int
 	FinalityDelay        int
 	Keystore             string
+	MaxGasPrice          int
 	MerkleProofContract  string
 	Passcodes            string
 	RegistryAddress      string
 	RetryCount           int
 	RetryDelay           time.Duration
+	Signer               string
 	StartingBlock        int
 	TestEther            string
 	Timeout              time.Duration
 }
 
 type monitorConfig struct {
-	BatchSize int
-	Interval  time.Duration
+	BatchSize              int
+	Interval               time.Duration
+	HaltOnSnapshotMismatch bool
 }
 
 type transportConfig struct {
@@ -58,7 +61,14 @@ type transportConfig struct {
 	FirewallMode               bool
 	FirewallHost               string
 	Whitelist                  string
+	Blacklist                  string
+	WhitelistOnly              bool
+	PeerRateIn                 int
+	PeerRateOut                int
+	RateIn                     int
+	RateOut                    int
 	PrivateKey                 string
+	AdvertisedHost             string
 	BootNodeAddresses          string
 	P2PListeningAddress        string
 	DiscoveryListeningAddress  string
@@ -135,6 +145,14 @@ func SetValue(ptr interface{}, value interface{}) {
 	}
 }
 
+func (e ethereumConfig) Endpoints() []string {
+	endpoints := strings.Split(e.Endpoint, ",")
+	for idx := range endpoints {
+		endpoints[idx] = strings.TrimSpace(endpoints[idx])
+	}
+	return endpoints
+}
+
 func (t transportConfig) BootNodes() []string {
 	bootNodeAddresses := strings.Split(t.BootNodeAddresses, ",")
 	for idx := range bootNodeAddresses {
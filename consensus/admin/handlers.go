@@ -43,6 +43,10 @@ type Handlers struct {
 	appHandler  appmock.Application
 	RequestLock chan struct{}
 	ReceiveLock chan interfaces.Lockable
+
+	// Clock starts the first round of a new validator set and defaults to
+	// the wall clock
+	Clock utils.Clock
 }
 
 // Init creates all fields and binds external services
@@ -161,7 +165,7 @@ func (ah *Handlers) AddValidatorSet(v *objs.ValidatorSet) error {
 				VAddr:    ah.ethAcct,
 				GroupKey: v.GroupKey,
 			}
-			ownValidatingState.SetRoundStarted()
+			ownValidatingState.SetRoundStarted(ah.Clock.Now())
 			if err := ah.database.SetOwnValidatingState(txn, ownValidatingState); err != nil {
 				utils.DebugTrace(ah.logger, err)
 				return err
@@ -237,6 +241,25 @@ func (ah *Handlers) AddValidatorSet(v *objs.ValidatorSet) error {
 	})
 }
 
+// RemoveValidatorSet removes the validator set that starts at notBefore. The
+// BC monitor calls this when the Ethereum block that completed the set is
+// reorged out.
+func (ah *Handlers) RemoveValidatorSet(notBefore uint32) error {
+	mutex, ok := ah.getLock()
+	if !ok {
+		return nil
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	return ah.database.Update(func(txn *badger.Txn) error {
+		if err := ah.database.DeleteValidatorSet(txn, notBefore); err != nil {
+			utils.DebugTrace(ah.logger, err)
+			return err
+		}
+		return nil
+	})
+}
+
 // AddSnapshot stores a snapshot to the database
 func (ah *Handlers) AddSnapshot(bh *objs.BlockHeader, startingEthDKG bool) error {
 	mutex, ok := ah.getLock()
@@ -402,6 +425,23 @@ func (ah *Handlers) AddPrivateKey(pk []byte, curveSpec constants.CurveSpec) erro
 	return nil
 }
 
+// SetPrivK stores a secret under an arbitrary name in the encrypted keystore
+// in the DB. The secret can be read back with GetPrivK.
+func (ah *Handlers) SetPrivK(name []byte, privk []byte) error {
+	return ah.database.Update(func(txn *badger.Txn) error {
+		ec := &objs.EncryptedStore{
+			Name:      utils.CopySlice(name),
+			ClearText: utils.CopySlice(privk),
+			Kid:       constants.AdminHandlerKid(),
+		}
+		err := ec.Encrypt(ah)
+		if err != nil {
+			return err
+		}
+		return ah.database.SetEncryptedStore(txn, ec)
+	})
+}
+
 // GetPrivK returns an decrypted private key from an EthDKG run to the caller
 func (ah *Handlers) GetPrivK(name []byte) ([]byte, error) {
 	var privk []byte
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"bytes"
 	"context"
 	"sync"
 
@@ -56,6 +57,14 @@ func (db *Database) GarbageCollect() error {
 	return db.rawDB.GarbageCollect()
 }
 
+// CaughtUp returns true once every subscription to the broadcast values has
+// started and been handed the values written so far. Subscriptions are
+// served by goroutines of their own, so a value written is handed over some
+// time after the write is committed.
+func (db *Database) CaughtUp() (bool, error) {
+	return db.rawDB.caughtUp()
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
@@ -204,6 +213,214 @@ func (db *Database) DeleteBeforeHistoricRoundState(txn *badger.Txn, height uint3
 	return nil
 }
 
+// GetHistoricRoundStates returns the historic round states of all
+// validators for every round that was observed at height
+func (db *Database) GetHistoricRoundStates(txn *badger.Txn, height uint32) ([]*objs.RoundState, error) {
+	prefix, err := db.makeHistoricRoundStateIterKey(height)
+	if err != nil {
+		return nil, err
+	}
+	opts := badger.DefaultIteratorOptions
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	result := []*objs.RoundState{}
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		v, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		rs := &objs.RoundState{}
+		if err := rs.UnmarshalBinary(v); err != nil {
+			utils.DebugTrace(db.logger, err)
+			return nil, err
+		}
+		result = append(result, rs)
+	}
+	return result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+
+func (db *Database) makeParticipationKey(epoch uint32) ([]byte, error) {
+	key := &objs.ParticipationKey{
+		Prefix: dbprefix.PrefixParticipation(),
+		Epoch:  epoch,
+	}
+	return key.MarshalBinary()
+}
+
+func (db *Database) SetParticipation(txn *badger.Txn, v *objs.Participation) error {
+	key, err := db.makeParticipationKey(v.Epoch)
+	if err != nil {
+		return err
+	}
+	err = db.rawDB.SetParticipation(txn, key, v)
+	if err != nil {
+		utils.DebugTrace(db.logger, err)
+		return err
+	}
+	return nil
+}
+
+func (db *Database) GetParticipation(txn *badger.Txn, epoch uint32) (*objs.Participation, error) {
+	key, err := db.makeParticipationKey(epoch)
+	if err != nil {
+		return nil, err
+	}
+	result, err := db.rawDB.GetParticipation(txn, key)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+
+func (db *Database) makeSnapshotAlertKey(height uint32) ([]byte, error) {
+	key := &objs.SnapshotAlertKey{
+		Prefix: dbprefix.PrefixSnapshotAlert(),
+		Height: height,
+	}
+	return key.MarshalBinary()
+}
+
+// SetSnapshotAlert stores v, replacing any alert already raised for the
+// same height
+func (db *Database) SetSnapshotAlert(txn *badger.Txn, v *objs.SnapshotAlert) error {
+	key, err := db.makeSnapshotAlertKey(v.Height)
+	if err != nil {
+		return err
+	}
+	vv, err := v.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	err = db.rawDB.SetValue(txn, key, vv)
+	if err != nil {
+		utils.DebugTrace(db.logger, err)
+		return err
+	}
+	return nil
+}
+
+// DeleteSnapshotAlert removes the alert raised for height. It returns
+// badger.ErrKeyNotFound if there is none.
+func (db *Database) DeleteSnapshotAlert(txn *badger.Txn, height uint32) error {
+	key, err := db.makeSnapshotAlertKey(height)
+	if err != nil {
+		return err
+	}
+	if _, err := txn.Get(key); err != nil {
+		return err
+	}
+	return utils.DeleteValue(txn, key)
+}
+
+// GetSnapshotAlerts returns every snapshot alert ordered by height
+func (db *Database) GetSnapshotAlerts(txn *badger.Txn) ([]*objs.SnapshotAlert, error) {
+	prefix := append(dbprefix.PrefixSnapshotAlert(), []byte("|")...)
+	opts := badger.DefaultIteratorOptions
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	result := []*objs.SnapshotAlert{}
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		v, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		sa := &objs.SnapshotAlert{}
+		if err := sa.UnmarshalBinary(v); err != nil {
+			utils.DebugTrace(db.logger, err)
+			return nil, err
+		}
+		result = append(result, sa)
+	}
+	return result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+
+func (db *Database) makeTraceEventKey(slot uint32) ([]byte, error) {
+	key := &objs.TraceEventKey{
+		Prefix: dbprefix.PrefixTraceEvent(),
+		Slot:   slot,
+	}
+	return key.MarshalBinary()
+}
+
+func (db *Database) makeTraceHeadKey() []byte {
+	return dbprefix.PrefixTraceHead()
+}
+
+func (db *Database) getTraceHead(txn *badger.Txn) (uint64, error) {
+	v, err := db.rawDB.getValue(txn, db.makeTraceHeadKey())
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return utils.UnmarshalUint64(v)
+}
+
+// AppendTraceEvent stores v as the newest entry of the trace ring. The
+// sequence number of v is assigned by this call. Once the ring holds
+// constants.TraceRingSize events the oldest event is overwritten.
+func (db *Database) AppendTraceEvent(txn *badger.Txn, v *objs.TraceEvent) error {
+	head, err := db.getTraceHead(txn)
+	if err != nil {
+		return err
+	}
+	v.Seq = head + 1
+	key, err := db.makeTraceEventKey(uint32(v.Seq % constants.TraceRingSize))
+	if err != nil {
+		return err
+	}
+	vv, err := v.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if err := db.rawDB.SetValue(txn, key, vv); err != nil {
+		utils.DebugTrace(db.logger, err)
+		return err
+	}
+	return db.rawDB.SetValue(txn, db.makeTraceHeadKey(), utils.MarshalUint64(v.Seq))
+}
+
+// GetTraceEvents returns up to count of the most recent trace events,
+// newest first
+func (db *Database) GetTraceEvents(txn *badger.Txn, count int) ([]*objs.TraceEvent, error) {
+	head, err := db.getTraceHead(txn)
+	if err != nil {
+		return nil, err
+	}
+	result := []*objs.TraceEvent{}
+	for seq := head; seq > 0 && head-seq < constants.TraceRingSize && len(result) < count; seq-- {
+		key, err := db.makeTraceEventKey(uint32(seq % constants.TraceRingSize))
+		if err != nil {
+			return nil, err
+		}
+		v, err := db.rawDB.getValue(txn, key)
+		if err != nil {
+			return nil, err
+		}
+		ev := &objs.TraceEvent{}
+		if err := ev.UnmarshalBinary(v); err != nil {
+			return nil, err
+		}
+		result = append(result, ev)
+	}
+	return result, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
@@ -233,24 +450,59 @@ func (db *Database) SetValidatorSet(txn *badger.Txn, v *objs.ValidatorSet) error
 	return nil
 }
 
+// DeleteValidatorSet removes the validator set that starts at notBefore
+func (db *Database) DeleteValidatorSet(txn *badger.Txn, notBefore uint32) error {
+	key, err := db.makeValidatorSetKey(notBefore)
+	if err != nil {
+		return err
+	}
+	return utils.DeleteValue(txn, key)
+}
+
 func (db *Database) GetValidatorSet(txn *badger.Txn, height uint32) (*objs.ValidatorSet, error) {
-	prefix := db.makeValidatorSetIterKey()
 	seek := []byte{}
-	seek = append(seek, prefix...)
+	seek = append(seek, db.makeValidatorSetIterKey()...)
 	seek = append(seek, []byte{255, 255, 255, 255, 255}...)
+	return db.getLastValidatorSet(txn, seek)
+}
+
+// GetValidatorSetForHeight returns the validator set with the greatest
+// NotBefore that is not above height
+func (db *Database) GetValidatorSetForHeight(txn *badger.Txn, height uint32) (*objs.ValidatorSet, error) {
+	seek, err := db.makeValidatorSetKey(height)
+	if err != nil {
+		return nil, err
+	}
+	return db.getLastValidatorSet(txn, seek)
+}
+
+// getLastValidatorSet returns the validator set with the greatest key that is
+// not above seek. A reverse iterator reads all the versions of a key to find
+// the latest one, also for the key below the validator sets that it prefetches.
+// That key is the own state, which is rewritten at every step, so all versions
+// are iterated instead and the latest version of a key is the last one seen.
+func (db *Database) getLastValidatorSet(txn *badger.Txn, seek []byte) (*objs.ValidatorSet, error) {
 	opts := badger.DefaultIteratorOptions
 	opts.Reverse = true
-	opts.Prefix = prefix
+	opts.AllVersions = true
+	opts.Prefix = db.makeValidatorSetIterKey()
 	opts.PrefetchValues = false
 	var lastkey []byte
 	func() {
 		it := txn.NewIterator(opts)
 		defer it.Close()
-		it.Seek(seek)
-		if it.Valid() {
+		var key []byte
+		deleted := true
+		for it.Seek(seek); it.Valid(); it.Next() {
 			item := it.Item()
-			k := item.KeyCopy(nil)
-			lastkey = k
+			if key != nil && !bytes.Equal(item.Key(), key) && !deleted {
+				break
+			}
+			key = item.KeyCopy(nil)
+			deleted = item.IsDeletedOrExpired()
+		}
+		if !deleted {
+			lastkey = key
 		}
 	}()
 	if lastkey == nil {
@@ -2,9 +2,11 @@ package db
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/MadBase/MadNet/consensus/objs"
 	"github.com/MadBase/MadNet/constants"
@@ -403,6 +405,107 @@ func TestValidatorSet(t *testing.T) {
 	}
 }
 
+func TestValidatorSetForHeight(t *testing.T) {
+	tbd, db, _ := newDB(t)
+	defer tbd.Close()
+	err := db.Update(func(txn *badger.Txn) error {
+		for i, notBefore := range []uint32{1, 1024, 2048} {
+			groupSigner := &crypto.BNGroupSigner{}
+			groupSigner.SetPrivk(crypto.Hasher([]byte{byte(i)}))
+			groupKey, err := groupSigner.PubkeyShare()
+			if err != nil {
+				t.Fatal(err)
+			}
+			vSet := &objs.ValidatorSet{
+				Validators: []*objs.Validator{{
+					VAddr:      crypto.Hasher([]byte("s0"))[12:],
+					GroupShare: crypto.Hasher([]byte("g0")),
+				}},
+				GroupKey:  groupKey,
+				NotBefore: notBefore,
+			}
+			if err := db.SetValidatorSet(txn, vSet); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cases := map[uint32]uint32{1: 1, 1023: 1, 1024: 1024, 2047: 1024, 2048: 2048, 5000: 2048}
+		for height, notBefore := range cases {
+			vSet, err := db.GetValidatorSetForHeight(txn, height)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vSet.NotBefore != notBefore {
+				t.Fatalf("height %d: got set %d, expected %d", height, vSet.NotBefore, notBefore)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidatorSetVersions(t *testing.T) {
+	tbd, db, _ := newDB(t)
+	defer tbd.Close()
+	set := func(notBefore uint32, secret byte) []byte {
+		groupSigner := &crypto.BNGroupSigner{}
+		groupSigner.SetPrivk(crypto.Hasher([]byte{secret}))
+		groupKey, err := groupSigner.PubkeyShare()
+		if err != nil {
+			t.Fatal(err)
+		}
+		vSet := &objs.ValidatorSet{
+			Validators: []*objs.Validator{{
+				VAddr:      crypto.Hasher([]byte("s0"))[12:],
+				GroupShare: crypto.Hasher([]byte("g0")),
+			}},
+			GroupKey:  groupKey,
+			NotBefore: notBefore,
+		}
+		err = db.Update(func(txn *badger.Txn) error {
+			return db.SetValidatorSet(txn, vSet)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return groupKey
+	}
+	check := func(notBefore uint32, groupKey []byte) {
+		err := db.View(func(txn *badger.Txn) error {
+			vSet, err := db.GetValidatorSet(txn, 0)
+			if err != nil {
+				return err
+			}
+			if vSet.NotBefore != notBefore || !bytes.Equal(vSet.GroupKey, groupKey) {
+				t.Fatalf("got set %d, expected %d", vSet.NotBefore, notBefore)
+			}
+			vSet, err = db.GetValidatorSetForHeight(txn, 2000)
+			if err != nil {
+				return err
+			}
+			if vSet.NotBefore != notBefore || !bytes.Equal(vSet.GroupKey, groupKey) {
+				t.Fatalf("got set %d for height, expected %d", vSet.NotBefore, notBefore)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	first := set(1, 0)
+	set(1024, 1)
+	second := set(1024, 2)
+	check(1024, second)
+	err := db.Update(func(txn *badger.Txn) error {
+		return db.DeleteValidatorSet(txn, 1024)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(1, first)
+}
+
 func TestSnapShotMany(t *testing.T) {
 	groupSigner := &crypto.BNGroupSigner{}
 	groupSigner.SetPrivk(crypto.Hasher([]byte("secret")))
@@ -754,3 +857,260 @@ func TestStagedBHKeyIter(t *testing.T) {
 	}
 
 }
+
+func TestHistoricRoundStatesAndParticipation(t *testing.T) {
+	groupSigner := &crypto.BNGroupSigner{}
+	groupSigner.SetPrivk(crypto.Hasher([]byte("secret")))
+	groupKey, _ := groupSigner.PubkeyShare()
+
+	tbd, db, p := newDB(t)
+	defer tbd.Close()
+	badgerD := tbd.db
+	err := badgerD.Update(func(txn *badger.Txn) error {
+		sig, err := groupSigner.Sign(p.PrevBlock)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vAddrs := [][]byte{}
+		for i := 0; i < 2; i++ {
+			secpSigner := &crypto.Secp256k1Signer{}
+			err := secpSigner.SetPrivk(crypto.Hasher([]byte{byte(i)}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			secpKey, _ := secpSigner.Pubkey()
+			vAddrs = append(vAddrs, crypto.GetAccount(secpKey))
+		}
+		for _, height := range []uint32{1, 2} {
+			for _, round := range []uint32{1, 2} {
+				for _, vAddr := range vAddrs {
+					rs := &objs.RoundState{
+						VAddr:      vAddr,
+						GroupKey:   groupKey,
+						GroupShare: groupKey,
+						GroupIdx:   1,
+						RCert: &objs.RCert{
+							SigGroup: sig,
+							RClaims: &objs.RClaims{
+								ChainID:   p.ChainID,
+								Height:    height,
+								PrevBlock: p.PrevBlock,
+								Round:     round,
+							},
+						},
+					}
+					if err := db.SetHistoricRoundState(txn, rs); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+		}
+		rss, err := db.GetHistoricRoundStates(txn, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rss) != 4 {
+			t.Fatalf("expected 4 round states, got %v", len(rss))
+		}
+		for _, rs := range rss {
+			if rs.RCert.RClaims.Height != 2 {
+				t.Fatal("round state for wrong height")
+			}
+		}
+
+		_, err = db.GetParticipation(txn, 1)
+		if err != badger.ErrKeyNotFound {
+			t.Fatal("expected ErrKeyNotFound")
+		}
+		part := &objs.Participation{Epoch: 1, LastHeight: 2}
+		part.Get(vAddrs[0]).ProposalsMade = 1
+		part.Get(vAddrs[1]).ProposalsMissed = 1
+		if err := db.SetParticipation(txn, part); err != nil {
+			t.Fatal(err)
+		}
+		part2, err := db.GetParticipation(txn, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if part2.LastHeight != 2 || len(part2.Validators) != 2 {
+			t.Fatal("participation does not agree")
+		}
+		if part2.Get(vAddrs[1]).ProposalsMissed != 1 {
+			t.Fatal("participation does not agree")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTraceEvents(t *testing.T) {
+	tbd, db, _ := newDB(t)
+	defer tbd.Close()
+	badgerD := tbd.db
+	err := badgerD.View(func(txn *badger.Txn) error {
+		evs, err := db.GetTraceEvents(txn, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(evs) != 0 {
+			t.Fatal("expected empty trace")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := int(constants.TraceRingSize) + 10
+	for i := 0; i < total; {
+		err := badgerD.Update(func(txn *badger.Txn) error {
+			for j := 0; j < 1000 && i < total; j++ {
+				ev := &objs.TraceEvent{
+					Height: uint32(i + 1),
+					Round:  1,
+					Step:   "doPreVoteStep",
+				}
+				if err := db.AppendTraceEvent(txn, ev); err != nil {
+					t.Fatal(err)
+				}
+				if ev.Seq != uint64(i+1) {
+					t.Fatal("wrong sequence number")
+				}
+				i++
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = badgerD.View(func(txn *badger.Txn) error {
+		evs, err := db.GetTraceEvents(txn, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(evs) != 3 {
+			t.Fatalf("expected 3 events, got %v", len(evs))
+		}
+		for k, ev := range evs {
+			if ev.Seq != uint64(total-k) || ev.Height != uint32(total-k) {
+				t.Fatal("events out of order")
+			}
+		}
+		evs, err = db.GetTraceEvents(txn, total)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uint64(len(evs)) != constants.TraceRingSize {
+			t.Fatalf("expected ring to be bounded, got %v", len(evs))
+		}
+		if evs[len(evs)-1].Seq != 11 {
+			t.Fatal("oldest events should have been overwritten")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSnapshotAlerts(t *testing.T) {
+	tbd, db, _ := newDB(t)
+	defer tbd.Close()
+	badgerD := tbd.db
+	err := badgerD.Update(func(txn *badger.Txn) error {
+		sas, err := db.GetSnapshotAlerts(txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sas) != 0 {
+			t.Fatal("expected no alerts")
+		}
+		for _, height := range []uint32{2048, 1024} {
+			sa := &objs.SnapshotAlert{Height: height, Epoch: height / 1024, BClaimsMismatch: true}
+			if err := db.SetSnapshotAlert(txn, sa); err != nil {
+				t.Fatal(err)
+			}
+		}
+		sas, err = db.GetSnapshotAlerts(txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sas) != 2 {
+			t.Fatalf("expected 2 alerts, got %v", len(sas))
+		}
+		if sas[0].Height != 1024 || sas[1].Height != 2048 || !sas[1].BClaimsMismatch {
+			t.Fatal("alerts do not agree")
+		}
+		if err := db.DeleteSnapshotAlert(txn, 1024); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.DeleteSnapshotAlert(txn, 1024); err != badger.ErrKeyNotFound {
+			t.Fatalf("expected ErrKeyNotFound, got %v", err)
+		}
+		sas, err = db.GetSnapshotAlerts(txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sas) != 1 || sas[0].Height != 2048 {
+			t.Fatal("expected only the alert at 2048")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func waitCaughtUp(t *testing.T, db *Database) {
+	end := time.Now().Add(5 * time.Second)
+	for {
+		caught, err := db.CaughtUp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if caught {
+			return
+		}
+		if time.Now().After(end) {
+			t.Fatal("subscription did not catch up")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCaughtUp(t *testing.T) {
+	tdb, db, _ := newDB(t)
+	defer tdb.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	release := make(chan struct{})
+	seen := make(chan []byte, 1)
+	db.SubscribeBroadcastTransaction(ctx, func(v []byte) error {
+		<-release
+		seen <- v
+		return nil
+	})
+	waitCaughtUp(t, db)
+	// the subscription is registered with badger just after it starts
+	time.Sleep(10 * time.Millisecond)
+	err := db.Update(func(txn *badger.Txn) error {
+		return db.SetBroadcastTransaction(txn, []byte("tx"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	caught, err := db.CaughtUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if caught {
+		t.Fatal("subscription caught up before its callback returned")
+	}
+	close(release)
+	waitCaughtUp(t, db)
+	if v := <-seen; !bytes.Equal(v, []byte("tx")) {
+		t.Fatalf("subscription was handed %q", v)
+	}
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/MadBase/MadNet/consensus/objs"
@@ -32,6 +33,19 @@ type TxnFunc func(txn *badger.Txn) error
 type rawDataBase struct {
 	db     *badger.DB
 	logger *logrus.Logger
+
+	subsMutex sync.Mutex
+	subs      map[*subscription]struct{}
+}
+
+// subscription is a subscription to the values written under a prefix
+type subscription struct {
+	prefix []byte
+	// started is set once the subscription is about to be registered with
+	// the database and handled is the version of the last value handed to
+	// the callback. Both are accessed atomically.
+	started int32
+	handled uint64
 }
 
 func (db *rawDataBase) View(fn TxnFunc) error {
@@ -64,6 +78,13 @@ func (db *rawDataBase) DropPrefix(k []byte) error {
 
 // subscribe to prefix is used to form the proposal subscription
 func (db *rawDataBase) subscribeToPrefix(ctx context.Context, prefix []byte, cb func([]byte) error) {
+	sub := &subscription{prefix: prefix}
+	db.subsMutex.Lock()
+	if db.subs == nil {
+		db.subs = make(map[*subscription]struct{})
+	}
+	db.subs[sub] = struct{}{}
+	db.subsMutex.Unlock()
 	fn := func(kvs *badger.KVList) error {
 		for i := 0; i < len(kvs.Kv); i++ {
 			kv := kvs.Kv[i]
@@ -73,11 +94,25 @@ func (db *rawDataBase) subscribeToPrefix(ctx context.Context, prefix []byte, cb
 					return err
 				}
 			}
+			atomic.StoreUint64(&sub.handled, kv.Version)
 		}
 		return nil
 	}
 	fn2 := func() {
-		err := db.db.Subscribe(ctx, fn, prefix)
+		defer func() {
+			db.subsMutex.Lock()
+			delete(db.subs, sub)
+			db.subsMutex.Unlock()
+		}()
+		// values written before the subscription is registered are never
+		// handed to the callback
+		version, err := db.lastVersion(prefix)
+		if err != nil {
+			db.logger.Warnf("could not read the last version under prefix: %v", prefix)
+		}
+		atomic.StoreUint64(&sub.handled, version)
+		atomic.StoreInt32(&sub.started, 1)
+		err = db.db.Subscribe(ctx, fn, prefix)
 		if err != nil && err != context.Canceled {
 			db.logger.Warnf("terminating db subscription for prefix: %v", prefix)
 		}
@@ -85,6 +120,49 @@ func (db *rawDataBase) subscribeToPrefix(ctx context.Context, prefix []byte, cb
 	go fn2()
 }
 
+// lastVersion returns the version of the latest value written under prefix
+func (db *rawDataBase) lastVersion(prefix []byte) (uint64, error) {
+	var version uint64
+	err := db.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = prefix
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			if v := it.Item().Version(); v > version {
+				version = v
+			}
+		}
+		return nil
+	})
+	return version, err
+}
+
+// caughtUp returns true once every subscription has started and handed the
+// values written under its prefix since to its callback
+func (db *rawDataBase) caughtUp() (bool, error) {
+	db.subsMutex.Lock()
+	subs := make([]*subscription, 0, len(db.subs))
+	for sub := range db.subs {
+		subs = append(subs, sub)
+	}
+	db.subsMutex.Unlock()
+	for _, sub := range subs {
+		if atomic.LoadInt32(&sub.started) == 0 {
+			return false, nil
+		}
+		version, err := db.lastVersion(sub.prefix)
+		if err != nil {
+			return false, err
+		}
+		if version > atomic.LoadUint64(&sub.handled) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (db *rawDataBase) getValue(txn *badger.Txn, key []byte) ([]byte, error) {
 	return utils.GetValue(txn, key)
 }
@@ -209,6 +287,34 @@ func (db *rawDataBase) GetRoundState(txn *badger.Txn, key []byte) (*objs.RoundSt
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 
+func (db *rawDataBase) SetParticipation(txn *badger.Txn, key []byte, v *objs.Participation) error {
+	vv, err := v.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return utils.SetValue(txn, key, vv)
+}
+
+func (db *rawDataBase) GetParticipation(txn *badger.Txn, key []byte) (*objs.Participation, error) {
+	v, err := db.getValue(txn, key)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+	vv := &objs.Participation{}
+	err = vv.UnmarshalBinary(v)
+	if err != nil {
+		return nil, err
+	}
+	return vv, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+
 func (db *rawDataBase) SetValidatorSet(txn *badger.Txn, key []byte, v *objs.ValidatorSet) error {
 	vv, err := v.MarshalBinary()
 	if err != nil {
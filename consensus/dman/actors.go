@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/MadBase/MadNet/consensus/objs"
 	"github.com/MadBase/MadNet/constants"
@@ -17,6 +18,8 @@ import (
 // Root Actor spawns top level actor types
 type RootActor struct {
 	sync.Mutex
+	// active counts the downloads in progress. It is accessed atomically.
+	active    int64
 	ba        *blockActor
 	wg        *sync.WaitGroup
 	closeChan chan struct{}
@@ -64,6 +67,13 @@ func (a *RootActor) Close() {
 	})
 }
 
+// Downloads returns the number of downloads in progress. A download that
+// is retried counts as a new download and a download of a transaction that
+// may be pending or mined counts as the two downloads it waits on.
+func (a *RootActor) Downloads() int {
+	return int(atomic.LoadInt64(&a.active))
+}
+
 // TODO verify blockheader cache is being cleaned
 func (a *RootActor) FlushCacheToDisk(txn *badger.Txn, height uint32) error {
 	txList, txHashList := a.txc.GetHeight(height + 1)
@@ -116,12 +126,16 @@ func (a *RootActor) download(b DownloadRequest, retry bool) {
 		return
 	default:
 		a.wg.Add(1)
+		atomic.AddInt64(&a.active, 1)
 		go a.doDownload(b, retry)
 	}
 }
 
 func (a *RootActor) doDownload(b DownloadRequest, retry bool) {
 	defer a.wg.Done()
+	// a download that waits on child downloads hands its count to them
+	active := int64(-1)
+	defer func() { atomic.AddInt64(&a.active, active) }()
 	switch b.DownloadType() {
 	case PendingTxRequest, MinedTxRequest:
 		ok := func() bool {
@@ -176,13 +190,17 @@ func (a *RootActor) doDownload(b DownloadRequest, retry bool) {
 		}
 		ptxc := make(chan struct{})
 		mtxc := make(chan struct{})
+		atomic.AddInt64(&a.active, 1)
+		active = 0
 		go func() {
 			defer close(ptxc)
+			defer atomic.AddInt64(&a.active, -1)
 			a.dispatchQ <- bc0
 			a.await(bc0)
 		}()
 		go func() {
 			defer close(mtxc)
+			defer atomic.AddInt64(&a.active, -1)
 			a.dispatchQ <- bc1
 			a.await(bc1)
 		}()
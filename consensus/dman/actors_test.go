@@ -8,6 +8,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/MadBase/MadNet/consensus/appmock"
 	"github.com/MadBase/MadNet/consensus/objs"
 	"github.com/MadBase/MadNet/crypto"
 	"github.com/MadBase/MadNet/interfaces"
@@ -336,3 +337,36 @@ func generateChain() ([]*objs.BClaims, [][][]byte, error) {
 	chain = append(chain, bclaims)
 	return chain, txHashes, nil
 }
+
+// blockingProxy fails every block header request once released
+type blockingProxy struct {
+	*testingProxy
+	release chan struct{}
+}
+
+func (bp *blockingProxy) RequestP2PGetBlockHeaders(ctx context.Context, blockNums []uint32) ([]*objs.BlockHeader, error) {
+	<-bp.release
+	return nil, errors.New("no block header")
+}
+
+func TestDManDownloads(t *testing.T) {
+	bp := &blockingProxy{testingProxy: &testingProxy{}, release: make(chan struct{})}
+	dm := &DMan{}
+	if err := dm.Init(bp, appmock.New(), bp); err != nil {
+		t.Fatal(err)
+	}
+	dm.Start()
+	if n := dm.Downloads(); n != 0 {
+		t.Fatalf("%d downloads before any was started", n)
+	}
+	dm.downloadActor.DownloadBlockHeader(1, 1)
+	if n := dm.Downloads(); n != 1 {
+		t.Fatalf("%d downloads in progress", n)
+	}
+	// the failed download is retried until the download manager is closed
+	close(bp.release)
+	dm.Close()
+	if n := dm.Downloads(); n != 0 {
+		t.Fatalf("%d downloads left after close", n)
+	}
+}
@@ -45,8 +45,15 @@ func (dm *DMan) Start() {
 	dm.downloadActor.Start()
 }
 
+// Close stops retrying failed downloads and waits for the downloads in
+// progress
 func (dm *DMan) Close() {
-	dm.downloadActor.wg.Wait()
+	dm.downloadActor.Close()
+}
+
+// Downloads returns the number of downloads in progress
+func (dm *DMan) Downloads() int {
+	return dm.downloadActor.Downloads()
 }
 
 func (dm *DMan) FlushCacheToDisk(txn *badger.Txn, height uint32) error {
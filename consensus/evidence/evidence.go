@@ -17,10 +17,11 @@ type Pool struct {
 	database *db.Database
 	sstore   *lstate.Store
 
-	ctx       context.Context
-	cancelCtx func()
-	logger    *logrus.Logger
-	maxnum    int
+	ctx        context.Context
+	cancelCtx  func()
+	logger     *logrus.Logger
+	maxnum     int
+	maxHeights int
 }
 
 // Init will start the in and out gossip busses
@@ -35,6 +36,7 @@ func (ep *Pool) Init(database *db.Database) error {
 	}
 
 	ep.maxnum = 2000
+	ep.maxHeights = 256
 	background := context.Background()
 	ctx, cf := context.WithCancel(background)
 	ep.cancelCtx = cf
@@ -0,0 +1,161 @@
+package evidence
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// UpdateParticipation tallies the historic round states of every committed
+// height that has not yet been counted into the participation aggregate of
+// its epoch. At most maxHeights heights are tallied per call. The aggregate
+// of the previous epoch is completed before the current epoch is advanced.
+func (ep *Pool) UpdateParticipation() error {
+	var current *objs.Participation
+	err := ep.database.Update(func(txn *badger.Txn) error {
+		os, err := ep.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		height := os.SyncToBH.BClaims.Height
+		epoch := utils.Epoch(height)
+		budget := ep.maxHeights
+		if epoch > 1 {
+			prev, err := ep.database.GetParticipation(txn, epoch-1)
+			if err != nil && err != badger.ErrKeyNotFound {
+				return err
+			}
+			if prev != nil {
+				budget, err = ep.tally(txn, prev, height, budget)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		p, err := ep.database.GetParticipation(txn, epoch)
+		if err != nil {
+			if err != badger.ErrKeyNotFound {
+				return err
+			}
+			p = &objs.Participation{
+				Epoch:      epoch,
+				LastHeight: (epoch - 1) * constants.EpochLength,
+			}
+		}
+		if _, err := ep.tally(txn, p, height, budget); err != nil {
+			return err
+		}
+		current = p
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ep.updateParticipationMetrics(current)
+	return nil
+}
+
+// tally counts the heights after p.LastHeight up to and including the last
+// height of the epoch or height, whichever is lower, and stores the result.
+// The unused part of the budget is returned.
+func (ep *Pool) tally(txn *badger.Txn, p *objs.Participation, height uint32, budget int) (int, error) {
+	end := p.Epoch * constants.EpochLength
+	if height < end {
+		end = height
+	}
+	if p.LastHeight >= end {
+		return budget, nil
+	}
+	for h := p.LastHeight + 1; h <= end && budget > 0; h++ {
+		if err := ep.tallyHeight(txn, p, h); err != nil {
+			return budget, err
+		}
+		p.LastHeight = h
+		budget--
+	}
+	if err := ep.database.SetParticipation(txn, p); err != nil {
+		return budget, err
+	}
+	return budget, nil
+}
+
+// tallyHeight adds the participation of every validator in the validator
+// set for each round observed at height. A round is attributed a proposal
+// made if the round state of the designated proposer holds a proposal and a
+// proposal missed otherwise. Both nil and non nil votes count as signed
+// since either proves the validator was live for that round.
+func (ep *Pool) tallyHeight(txn *badger.Txn, p *objs.Participation, height uint32) error {
+	vs, err := ep.database.GetValidatorSet(txn, height)
+	if err != nil {
+		return err
+	}
+	rss, err := ep.database.GetHistoricRoundStates(txn, height)
+	if err != nil {
+		return err
+	}
+	rounds := []uint32{}
+	byRound := make(map[uint32]map[string]*objs.RoundState)
+	for i := 0; i < len(rss); i++ {
+		rs := rss[i]
+		if rs.RCert == nil || rs.RCert.RClaims == nil {
+			continue
+		}
+		round := rs.RCert.RClaims.Round
+		if _, ok := byRound[round]; !ok {
+			byRound[round] = make(map[string]*objs.RoundState)
+			rounds = append(rounds, round)
+		}
+		byRound[round][string(rs.VAddr)] = rs
+	}
+	numv := len(vs.Validators)
+	if numv == 0 {
+		return nil
+	}
+	for _, round := range rounds {
+		proposerIdx := int(objs.GetProposerIdx(numv, height, round))
+		for idx, v := range vs.Validators {
+			vp := p.Get(v.VAddr)
+			vp.Rounds++
+			rs := byRound[round][string(v.VAddr)]
+			if idx == proposerIdx {
+				if rs != nil && rs.Proposal != nil {
+					vp.ProposalsMade++
+				} else {
+					vp.ProposalsMissed++
+				}
+			}
+			if rs == nil {
+				continue
+			}
+			if rs.PreVote != nil || rs.PreVoteNil != nil {
+				vp.PreVotes++
+			}
+			if rs.PreCommit != nil || rs.PreCommitNil != nil {
+				vp.PreCommits++
+			}
+		}
+	}
+	return nil
+}
+
+func (ep *Pool) updateParticipationMetrics(p *objs.Participation) {
+	if p == nil {
+		return
+	}
+	metrics.GetOrRegisterGauge("participation/epoch").Update(int64(p.Epoch))
+	metrics.GetOrRegisterGauge("participation/height").Update(int64(p.LastHeight))
+	for i := 0; i < len(p.Validators); i++ {
+		vp := p.Validators[i]
+		name := fmt.Sprintf("participation/%s/", hex.EncodeToString(vp.VAddr))
+		metrics.GetOrRegisterGauge(name + "rounds").Update(int64(vp.Rounds))
+		metrics.GetOrRegisterGauge(name + "proposals_made").Update(int64(vp.ProposalsMade))
+		metrics.GetOrRegisterGauge(name + "proposals_missed").Update(int64(vp.ProposalsMissed))
+		metrics.GetOrRegisterGauge(name + "prevotes").Update(int64(vp.PreVotes))
+		metrics.GetOrRegisterGauge(name + "precommits").Update(int64(vp.PreCommits))
+	}
+}
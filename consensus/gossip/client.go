@@ -152,7 +152,8 @@ func (mb *Client) getReGossipTxs(txn *badger.Txn, height uint32) ([][]byte, erro
 	return txout, nil
 }
 
-// ReGossip performs the reGossip logic
+// ReGossip performs the reGossip logic. The messages are handed to the peer
+// subscription, which sends them in the background, before it returns.
 func (mb *Client) ReGossip() error {
 	var isValidator, isSync bool
 	var height uint32
@@ -189,7 +190,7 @@ func (mb *Client) ReGossip() error {
 		}
 		for i := 0; i < len(txs); i++ {
 			tx := txs[i]
-			go mb.gossipTransaction(tx)
+			mb.gossipTransaction(tx)
 		}
 
 		if mb.lastHeight != height || mb.lastRound != round {
@@ -207,7 +208,7 @@ func (mb *Client) ReGossip() error {
 			utils.DebugTrace(mb.logger, err)
 			return err
 		}
-		go mb.gossipBlockHeader(bhBytes)
+		mb.gossipBlockHeader(bhBytes)
 		if !isValidator {
 			return nil
 		}
@@ -236,7 +237,7 @@ func (mb *Client) ReGossip() error {
 			utils.DebugTrace(mb.logger, err)
 			return err
 		}
-		go mb.gossipProposal(b)
+		mb.gossipProposal(b)
 	}
 	if pv != nil {
 		err := mb.gossipPreValidate(pv)
@@ -249,7 +250,7 @@ func (mb *Client) ReGossip() error {
 			utils.DebugTrace(mb.logger, err)
 			return err
 		}
-		go mb.gossipPreVote(b)
+		mb.gossipPreVote(b)
 	}
 	if pvn != nil {
 		err := mb.gossipPreValidate(pvn)
@@ -262,7 +263,7 @@ func (mb *Client) ReGossip() error {
 			utils.DebugTrace(mb.logger, err)
 			return err
 		}
-		go mb.gossipPreVoteNil(b)
+		mb.gossipPreVoteNil(b)
 	}
 	if pc != nil {
 		err := mb.gossipPreValidate(pc)
@@ -275,7 +276,7 @@ func (mb *Client) ReGossip() error {
 			utils.DebugTrace(mb.logger, err)
 			return err
 		}
-		go mb.gossipPreCommit(b)
+		mb.gossipPreCommit(b)
 	}
 	if pcn != nil {
 		err := mb.gossipPreValidate(pcn)
@@ -288,7 +289,7 @@ func (mb *Client) ReGossip() error {
 			utils.DebugTrace(mb.logger, err)
 			return err
 		}
-		go mb.gossipPreCommitNil(b)
+		mb.gossipPreCommitNil(b)
 	}
 	if nr != nil {
 		err := mb.gossipPreValidate(nr)
@@ -301,7 +302,7 @@ func (mb *Client) ReGossip() error {
 			utils.DebugTrace(mb.logger, err)
 			return err
 		}
-		go mb.gossipNextRound(b)
+		mb.gossipNextRound(b)
 	}
 	if nh != nil {
 		err := mb.gossipPreValidate(nh)
@@ -314,7 +315,7 @@ func (mb *Client) ReGossip() error {
 			utils.DebugTrace(mb.logger, err)
 			return err
 		}
-		go mb.gossipNextHeight(b)
+		mb.gossipNextHeight(b)
 	}
 	return nil
 }
@@ -622,6 +623,11 @@ func (mb *Client) gossipBlockHeader(blockHeader []byte) {
 	if err != nil {
 		return
 	}
+	// every validator sets the block at height 1 itself, so peers reject
+	// it as invalid
+	if bh.BClaims.Height <= 1 {
+		return
+	}
 	hsh := utils.MarshalUint32(bh.BClaims.Height)
 	fn := func(ctx context.Context, peer interfaces.PeerLease) error {
 		bh := &objs.BlockHeader{}
@@ -0,0 +1,78 @@
+package gossip
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func TestClientGossipBlockHeader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	DB, err := badger.Open(badger.DefaultOptions(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DB.Close()
+	database := &db.Database{}
+	if err := database.Init(DB); err != nil {
+		t.Fatal(err)
+	}
+	makeBH := func(height uint32) *objs.BlockHeader {
+		return &objs.BlockHeader{
+			TxHshLst: [][]byte{},
+			BClaims: &objs.BClaims{
+				ChainID:    tChainID,
+				Height:     height,
+				PrevBlock:  make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				HeaderRoot: make([]byte, 32),
+				TxRoot:     make([]byte, 32),
+			},
+			SigGroup: make([]byte, 192),
+		}
+	}
+	bh := makeBH(2)
+	err = DB.Update(func(txn *badger.Txn) error {
+		return database.SetOwnState(txn, makeOwnState(make([]byte, 20), bh, bh, bh, bh))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	peerSub := &testPeerSub{}
+	client := &Client{}
+	if err := client.Init(database, peerSub, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	testCases := []struct {
+		name     string
+		height   uint32
+		gossiped int
+	}{
+		{"initial block", 1, 0},
+		{"later block", 2, 1},
+	}
+	for _, tc := range testCases {
+		bhBytes, err := makeBH(tc.height).MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.gossipBlockHeader(bhBytes)
+		if n := peerSub.gossips(); n != tc.gossiped {
+			t.Fatalf("%s: %d gossiped", tc.name, n)
+		}
+	}
+}
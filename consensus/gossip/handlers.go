@@ -17,6 +17,7 @@ import (
 	"github.com/MadBase/MadNet/interfaces"
 	"github.com/MadBase/MadNet/logging"
 	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/types"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/peer"
@@ -35,6 +36,7 @@ var _ pb.P2PGossipTransactionHandler = (*Handlers)(nil)
 type appHandler interface {
 	PendingTxAdd(txn *badger.Txn, chainID uint32, height uint32, tx []interfaces.Transaction) error
 	UnmarshalTx([]byte) (interfaces.Transaction, error)
+	PreValidatePendingTx(chainID uint32, tx interfaces.Transaction) error
 }
 
 // Handlers consumes gossip and updates local state
@@ -376,12 +378,81 @@ func (mb *Handlers) UpdateBlocksFromGossip() error {
 	}
 }
 
-func (mb *Handlers) preventGossip(ctx context.Context, rawmsg []byte, isTx bool, isBh bool) {
-	peerAddr, ok := peer.FromContext(ctx)
+// peerAddr returns the address of the peer that sent the message handled
+// in ctx
+func peerAddr(ctx context.Context) (interfaces.NodeAddr, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	nodeAddr, ok := p.Addr.(interfaces.NodeAddr)
+	return nodeAddr, ok
+}
+
+// penalize lowers the reputation of the peer that sent the message handled
+// in ctx
+func (mb *Handlers) penalize(ctx context.Context, offense types.PeerOffense) {
+	nodeAddr, ok := peerAddr(ctx)
 	if !ok {
 		return
 	}
-	nodeAddr, ok := peerAddr.Addr.(interfaces.NodeAddr)
+	mb.peerSub.Penalize(nodeAddr, offense)
+}
+
+// checkSize rejects messages larger than any valid message
+func (mb *Handlers) checkSize(ctx context.Context, rawmsg []byte) error {
+	if len(rawmsg) > constants.MaxBytes {
+		mb.penalize(ctx, types.OversizedMessage)
+		return errorz.ErrInvalid{}.New("message too large")
+	}
+	return nil
+}
+
+// rejectMsg penalizes the peer for a message that failed to decode or
+// validate unless the message was only stale or could not be validated
+// because of the state of this node, such as while it is behind
+func (mb *Handlers) rejectMsg(ctx context.Context, err error) error {
+	etestStale := &errorz.ErrStale{}
+	if errors.As(err, &etestStale) {
+		return err
+	}
+	etestLocal := &errorz.ErrLocalState{}
+	if errors.As(err, &etestLocal) {
+		return err
+	}
+	mb.penalize(ctx, types.InvalidMessage)
+	return err
+}
+
+// preValidateTx decodes a relayed tx and performs the checks that do not
+// depend on local state. Only these failures are penalized: the relaying
+// peer performed the same checks, whereas conflicts with the tx pool may
+// be a race between honest peers.
+func (mb *Handlers) preValidateTx(ctx context.Context, rawmsg []byte) error {
+	var chainID uint32
+	err := mb.database.View(func(txn *badger.Txn) error {
+		os, err := mb.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		chainID = os.SyncToBH.BClaims.ChainID
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	tx, err := mb.app.UnmarshalTx(rawmsg)
+	if err != nil {
+		return mb.rejectMsg(ctx, err)
+	}
+	if err := mb.app.PreValidatePendingTx(chainID, tx); err != nil {
+		return mb.rejectMsg(ctx, err)
+	}
+	return nil
+}
+
+func (mb *Handlers) preventGossip(ctx context.Context, rawmsg []byte, isTx bool, isBh bool) {
+	nodeAddr, ok := peerAddr(ctx)
 	if !ok {
 		return
 	}
@@ -434,6 +505,13 @@ func (mb *Handlers) HandleP2PGossipTransaction(pctx context.Context, msg *pb.Gos
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.Transaction
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
+	if err := mb.preValidateTx(ctx, rawmsg); err != nil {
+		utils.DebugTrace(mb.logger, err)
+		return &pb.GossipTransactionAck{}, err
+	}
 	mb.preventGossip(ctx, rawmsg, true, false)
 	mobj := &transactionMsg{ctx, cf, rawmsg, eC}
 	select {
@@ -456,15 +534,18 @@ func (mb *Handlers) HandleP2PGossipProposal(pctx context.Context, msg *pb.Gossip
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.Proposal
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
 	obj := &objs.Proposal{}
 	err = obj.UnmarshalBinary(rawmsg)
 	if err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipProposalAck{}, err
+		return &pb.GossipProposalAck{}, mb.rejectMsg(ctx, err)
 	}
 	if err := mb.shandlers.PreValidate(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipProposalAck{}, err
+		return &pb.GossipProposalAck{}, mb.rejectMsg(ctx, err)
 	}
 	mb.preventGossip(ctx, rawmsg, false, false)
 	mobj := &proposalMsg{ctx, cf, obj, eC}
@@ -488,15 +569,18 @@ func (mb *Handlers) HandleP2PGossipPreVote(pctx context.Context, msg *pb.GossipP
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.PreVote
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
 	obj := &objs.PreVote{}
 	err = obj.UnmarshalBinary(rawmsg)
 	if err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipPreVoteAck{}, err
+		return &pb.GossipPreVoteAck{}, mb.rejectMsg(ctx, err)
 	}
 	if err := mb.shandlers.PreValidate(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipPreVoteAck{}, err
+		return &pb.GossipPreVoteAck{}, mb.rejectMsg(ctx, err)
 	}
 	mb.preventGossip(ctx, rawmsg, false, false)
 	mobj := &preVoteMsg{ctx, cf, obj, eC}
@@ -520,15 +604,18 @@ func (mb *Handlers) HandleP2PGossipPreVoteNil(pctx context.Context, msg *pb.Goss
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.PreVoteNil
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
 	obj := &objs.PreVoteNil{}
 	err = obj.UnmarshalBinary(rawmsg)
 	if err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipPreVoteNilAck{}, err
+		return &pb.GossipPreVoteNilAck{}, mb.rejectMsg(ctx, err)
 	}
 	if err := mb.shandlers.PreValidate(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipPreVoteNilAck{}, err
+		return &pb.GossipPreVoteNilAck{}, mb.rejectMsg(ctx, err)
 	}
 	mb.preventGossip(ctx, rawmsg, false, false)
 	mobj := &preVoteNilMsg{ctx, cf, obj, eC}
@@ -552,15 +639,18 @@ func (mb *Handlers) HandleP2PGossipPreCommit(pctx context.Context, msg *pb.Gossi
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.PreCommit
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
 	obj := &objs.PreCommit{}
 	err = obj.UnmarshalBinary(rawmsg)
 	if err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipPreCommitAck{}, err
+		return &pb.GossipPreCommitAck{}, mb.rejectMsg(ctx, err)
 	}
 	if err := mb.shandlers.PreValidate(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipPreCommitAck{}, err
+		return &pb.GossipPreCommitAck{}, mb.rejectMsg(ctx, err)
 	}
 	mb.preventGossip(ctx, rawmsg, false, false)
 	mobj := &preCommitMsg{ctx, cf, obj, eC}
@@ -584,15 +674,18 @@ func (mb *Handlers) HandleP2PGossipPreCommitNil(pctx context.Context, msg *pb.Go
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.PreCommitNil
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
 	obj := &objs.PreCommitNil{}
 	err = obj.UnmarshalBinary(rawmsg)
 	if err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipPreCommitNilAck{}, err
+		return &pb.GossipPreCommitNilAck{}, mb.rejectMsg(ctx, err)
 	}
 	if err := mb.shandlers.PreValidate(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipPreCommitNilAck{}, err
+		return &pb.GossipPreCommitNilAck{}, mb.rejectMsg(ctx, err)
 	}
 	mb.preventGossip(ctx, rawmsg, false, false)
 	mobj := &preCommitNilMsg{ctx, cf, obj, eC}
@@ -618,15 +711,18 @@ func (mb *Handlers) HandleP2PGossipNextRound(pctx context.Context, msg *pb.Gossi
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.NextRound
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
 	obj := &objs.NextRound{}
 	err = obj.UnmarshalBinary(rawmsg)
 	if err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipNextRoundAck{}, err
+		return &pb.GossipNextRoundAck{}, mb.rejectMsg(ctx, err)
 	}
 	if err := mb.shandlers.PreValidate(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipNextRoundAck{}, err
+		return &pb.GossipNextRoundAck{}, mb.rejectMsg(ctx, err)
 	}
 	mb.preventGossip(ctx, rawmsg, false, false)
 	mobj := &nextRoundMsg{ctx, cf, obj, eC}
@@ -652,15 +748,18 @@ func (mb *Handlers) HandleP2PGossipNextHeight(pctx context.Context, msg *pb.Goss
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.NextHeight
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
 	obj := &objs.NextHeight{}
 	err = obj.UnmarshalBinary(rawmsg)
 	if err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipNextHeightAck{}, err
+		return &pb.GossipNextHeightAck{}, mb.rejectMsg(ctx, err)
 	}
 	if err := mb.shandlers.PreValidate(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipNextHeightAck{}, err
+		return &pb.GossipNextHeightAck{}, mb.rejectMsg(ctx, err)
 	}
 	mb.preventGossip(ctx, rawmsg, false, false)
 	mobj := &nextHeightMsg{ctx, cf, obj, eC}
@@ -686,15 +785,18 @@ func (mb *Handlers) HandleP2PGossipBlockHeader(pctx context.Context, msg *pb.Gos
 	defer mb.wg.Done()
 	defer cf()
 	rawmsg := msg.BlockHeader
+	if err := mb.checkSize(ctx, rawmsg); err != nil {
+		return nil, err
+	}
 	obj := &objs.BlockHeader{}
 	err = obj.UnmarshalBinary(rawmsg)
 	if err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipBlockHeaderAck{}, err
+		return &pb.GossipBlockHeaderAck{}, mb.rejectMsg(ctx, err)
 	}
 	if err := mb.shandlers.PreValidate(obj); err != nil {
 		utils.DebugTrace(mb.logger, err)
-		return &pb.GossipBlockHeaderAck{}, err
+		return &pb.GossipBlockHeaderAck{}, mb.rejectMsg(ctx, err)
 	}
 	mb.preventGossip(ctx, rawmsg, false, true)
 	mobj := &blockHeaderMsg{ctx, cf, obj, eC}
@@ -0,0 +1,251 @@
+package gossip
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/types"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/peer"
+)
+
+type testTx []byte
+
+func (tx testTx) TxHash() ([]byte, error)        { return crypto.Hasher(tx), nil }
+func (tx testTx) MarshalBinary() ([]byte, error) { return tx, nil }
+func (tx testTx) XXXIsTx()                       {}
+
+// testApp decodes any tx but "undecodable", rejects "malformed" without
+// looking at state and finds every other tx spending a spent utxo
+type testApp struct{}
+
+func (a *testApp) UnmarshalTx(b []byte) (interfaces.Transaction, error) {
+	if string(b) == "undecodable" {
+		return nil, errorz.ErrInvalid{}.New("bad encoding")
+	}
+	return testTx(b), nil
+}
+
+func (a *testApp) PreValidatePendingTx(chainID uint32, tx interfaces.Transaction) error {
+	if chainID != tChainID {
+		return errorz.ErrInvalid{}.New("wrong chainID")
+	}
+	if string(tx.(testTx)) == "malformed" {
+		return errorz.ErrInvalid{}.New("bad signature")
+	}
+	return nil
+}
+
+func (a *testApp) PendingTxAdd(txn *badger.Txn, chainID uint32, height uint32, txs []interfaces.Transaction) error {
+	return errorz.ErrInvalid{}.New("spent")
+}
+
+type testNodeAddr struct{}
+
+func (a *testNodeAddr) Network() string                { return "test" }
+func (a *testNodeAddr) String() string                 { return "test" }
+func (a *testNodeAddr) Identity() string               { return "test" }
+func (a *testNodeAddr) P2PAddr() string                { return "test" }
+func (a *testNodeAddr) ChainID() types.ChainIdentifier { return types.ChainIdentifier(tChainID) }
+func (a *testNodeAddr) Host() string                   { return "test" }
+func (a *testNodeAddr) Port() int                      { return 0 }
+
+// testPeerSub records the offenses of peers
+type testPeerSub struct {
+	interfaces.PeerSubscription
+	sync.Mutex
+	offenses []types.PeerOffense
+	gossiped int
+}
+
+func (ps *testPeerSub) PreventGossipTx(addr interfaces.NodeAddr, hsh []byte) {}
+
+func (ps *testPeerSub) Penalize(addr interfaces.NodeAddr, offense types.PeerOffense) {
+	ps.Lock()
+	defer ps.Unlock()
+	ps.offenses = append(ps.offenses, offense)
+}
+
+func (ps *testPeerSub) GossipConsensus(hsh []byte, fn func(context.Context, interfaces.PeerLease) error) {
+	ps.Lock()
+	defer ps.Unlock()
+	ps.gossiped++
+}
+
+func (ps *testPeerSub) gossips() int {
+	ps.Lock()
+	defer ps.Unlock()
+	return ps.gossiped
+}
+
+func (ps *testPeerSub) penalties() int {
+	ps.Lock()
+	defer ps.Unlock()
+	return len(ps.offenses)
+}
+
+func TestHandleP2PGossipTransactionPenalties(t *testing.T) {
+	_, bnSigners, bnShares, secpSigners, secpPubks := makeSigners(t)
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	DB, err := badger.Open(badger.DefaultOptions(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DB.Close()
+	database := &db.Database{}
+	if err := database.Init(DB); err != nil {
+		t.Fatal(err)
+	}
+	pbh, _, _, _, _, _, _, _, _ := buildRound(t, bnSigners, bnShares, secpSigners, 2, 1, crypto.Hasher([]byte("0")))
+	err = DB.Update(func(txn *badger.Txn) error {
+		return database.SetOwnState(txn, makeOwnState(secpPubks[0], pbh, pbh, pbh, pbh))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	peerSub := &testPeerSub{}
+	mb := &Handlers{}
+	if err := mb.Init(database, peerSub, &testApp{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer mb.Close()
+	forceExit := make(chan struct{})
+	defer close(forceExit)
+	go mb.UpdateStateFromGossip(forceExit, &sync.Mutex{}, func() bool { return true })
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &testNodeAddr{}})
+	testCases := []struct {
+		name      string
+		tx        string
+		penalties int
+	}{
+		{"conflicting tx", "conflicting", 0},
+		{"undecodable tx", "undecodable", 1},
+		{"malformed tx", "malformed", 2},
+	}
+	for _, tc := range testCases {
+		_, err := mb.HandleP2PGossipTransaction(ctx, &pb.GossipTransactionMessage{Transaction: []byte(tc.tx)})
+		etestInvalid := &errorz.ErrInvalid{}
+		if !errors.As(err, &etestInvalid) {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if n := peerSub.penalties(); n != tc.penalties {
+			t.Fatalf("%s: %d penalties", tc.name, n)
+		}
+	}
+}
+
+func TestHandleP2PGossipPreVotePenalties(t *testing.T) {
+	groupk, bnSigners, bnShares, secpSigners, secpPubks := makeSigners(t)
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	DB, err := badger.Open(badger.DefaultOptions(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DB.Close()
+	database := &db.Database{}
+	if err := database.Init(DB); err != nil {
+		t.Fatal(err)
+	}
+	pbh, _, pvl, _, _, _, _, _, _ := buildRound(t, bnSigners, bnShares, secpSigners, 3, 1, crypto.Hasher([]byte("0")))
+	err = DB.Update(func(txn *badger.Txn) error {
+		return database.SetOwnState(txn, makeOwnState(secpPubks[0], pbh, pbh, pbh, pbh))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pv, err := pvl[1].MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	badSig := &objs.PreVote{}
+	if err := badSig.UnmarshalBinary(pv); err != nil {
+		t.Fatal(err)
+	}
+	badSig.Signature[10] ^= 0xff
+	pvBadSig, err := badSig.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shandlers := &lstate.Handlers{}
+	if err := shandlers.Init(database, nil); err != nil {
+		t.Fatal(err)
+	}
+	peerSub := &testPeerSub{}
+	mb := &Handlers{}
+	if err := mb.Init(database, peerSub, &testApp{}, shandlers); err != nil {
+		t.Fatal(err)
+	}
+	defer mb.Close()
+
+	setValidatorSet := func(groupKey []byte) {
+		err := DB.Update(func(txn *badger.Txn) error {
+			return database.SetValidatorSet(txn, makeValidatorSet(1, groupKey, secpSigners, bnSigners))
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	otherGroupk := utils.CopySlice(groupk)
+	otherGroupk[0] ^= 0xff
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &testNodeAddr{}})
+	testCases := []struct {
+		name      string
+		setup     func()
+		msg       []byte
+		local     bool
+		penalties int
+	}{
+		// this node has not received a validator set yet
+		{"missing validator set", func() {}, pv, true, 0},
+		// the validator set of this node is outdated
+		{"group key mismatch", func() { setValidatorSet(otherGroupk) }, pv, true, 0},
+		{"bad signature", func() { setValidatorSet(groupk) }, pvBadSig, false, 1},
+		{"undecodable", func() {}, []byte("undecodable"), false, 2},
+	}
+	for _, tc := range testCases {
+		tc.setup()
+		_, err := mb.HandleP2PGossipPreVote(ctx, &pb.GossipPreVoteMessage{PreVote: tc.msg})
+		if err == nil {
+			t.Fatalf("%s: should have raised error", tc.name)
+		}
+		etestLocal := &errorz.ErrLocalState{}
+		if errors.As(err, &etestLocal) != tc.local {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if n := peerSub.penalties(); n != tc.penalties {
+			t.Fatalf("%s: %d penalties", tc.name, n)
+		}
+	}
+}
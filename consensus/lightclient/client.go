@@ -0,0 +1,433 @@
+// Package lightclient implements a header only node. The light client
+// downloads committed block headers from its peers and accepts a header once
+// its group signature verifies against the validator set reported by
+// Ethereum and it extends the chain of headers already accepted. Accepted
+// headers are stored in the consensus database, which maintains the header
+// trie, so block headers and header proofs can be served from it. The light
+// client does not run the application and does not take part in consensus.
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/sirupsen/logrus"
+)
+
+type reqBusView interface {
+	RequestP2PGetBlockHeaders(ctx context.Context, blockNums []uint32) ([]*objs.BlockHeader, error)
+}
+
+// Client synchronizes and verifies committed block headers
+type Client struct {
+	sync.RWMutex
+	wg sync.WaitGroup
+
+	ctx       context.Context
+	cancelCtx func()
+
+	logger   *logrus.Logger
+	chainID  uint32
+	database *db.Database
+	reqBus   reqBusView
+	bnVal    *crypto.BNGroupValidator
+	vAddr    []byte
+
+	// caughtUp is set once the peers have no header above the local chain
+	caughtUp bool
+	// ethSynced is set while the Ethereum endpoint is in sync
+	ethSynced bool
+}
+
+// Init initializes the light client. The address of the Ethereum account is
+// only used to fill the own state of the node, which the local RPC server
+// reads the height and chain id from.
+func (c *Client) Init(chainID uint32, database *db.Database, reqBus reqBusView, vAddr []byte) error {
+	background := context.Background()
+	ctx, cf := context.WithCancel(background)
+	c.ctx = ctx
+	c.cancelCtx = cf
+	c.wg = sync.WaitGroup{}
+	c.logger = logging.GetLogger(constants.LoggerLightClient)
+	c.chainID = chainID
+	c.database = database
+	c.reqBus = reqBus
+	c.bnVal = &crypto.BNGroupValidator{}
+	c.vAddr = utils.CopySlice(vAddr)
+	return nil
+}
+
+// Start launches the header synchronization loop
+func (c *Client) Start() {
+	c.wg.Add(1)
+	go c.loop()
+}
+
+// Close stops the light client and waits for the synchronization loop to
+// exit
+func (c *Client) Close() {
+	c.cancelCtx()
+	c.wg.Wait()
+}
+
+// Done returns a channel that is closed once the light client is closed
+func (c *Client) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+// Safe returns true once the light client has caught up with its peers
+// while Ethereum is in sync. It is the safe function of the local RPC
+// server.
+func (c *Client) Safe() bool {
+	c.RLock()
+	defer c.RUnlock()
+	return c.caughtUp && c.ethSynced
+}
+
+func (c *Client) loop() {
+	defer c.wg.Done()
+	for {
+		caughtUp, err := c.Sync()
+		if err != nil {
+			utils.DebugTrace(c.logger, err)
+		}
+		wait := time.Duration(0)
+		if caughtUp || err != nil {
+			wait = constants.LightClientSyncInterval
+		}
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Sync downloads and verifies the next batch of block headers. It returns
+// true when no further header could be accepted, in which case the caller
+// should wait before trying again.
+func (c *Client) Sync() (bool, error) {
+	tip, err := c.tip()
+	if err != nil {
+		return false, err
+	}
+	next := uint32(1)
+	if tip != nil {
+		next = tip.BClaims.Height + 1
+	}
+	hdrs := c.request(next)
+	// the genesis header is not signed, it is only accepted together with
+	// the signed header that follows it
+	if len(hdrs) == 0 || (next == 1 && len(hdrs) < 2) {
+		c.setCaughtUp(tip != nil)
+		return true, nil
+	}
+	var verr error
+	var accepted bool
+	err = c.database.Update(func(txn *badger.Txn) error {
+		prev := tip
+		for i := 0; i < len(hdrs); i++ {
+			bh := hdrs[i]
+			ok := true
+			if bh.BClaims.Height == 1 {
+				verr = c.verifyGenesis(txn, bh, hdrs[1])
+			} else {
+				ok, verr = c.verify(txn, prev, bh)
+			}
+			if verr != nil || !ok {
+				break
+			}
+			if err := c.database.SetCommittedBlockHeader(txn, bh); err != nil {
+				utils.DebugTrace(c.logger, err)
+				return err
+			}
+			prev = bh
+		}
+		if prev == tip {
+			return nil
+		}
+		if prev.BClaims.Height == 1 {
+			// the genesis header is discarded together with the header
+			// that failed to authenticate it
+			if verr == nil {
+				verr = errorz.ErrInvalid{}.New("first signed header can not be verified yet")
+			}
+			return verr
+		}
+		accepted = true
+		return c.setOwnState(txn, prev)
+	})
+	if err != nil {
+		c.setCaughtUp(false)
+		return false, err
+	}
+	if verr != nil {
+		c.logger.Warnf("Rejected block header from peer: %v", verr)
+		return true, verr
+	}
+	return !accepted, nil
+}
+
+// request fetches up to a batch of headers starting at height next. Peers
+// refuse requests for heights they have not committed, so a failed batch is
+// retried with the headers required for a single step.
+func (c *Client) request(next uint32) []*objs.BlockHeader {
+	heights := func(count uint32) []uint32 {
+		out := []uint32{}
+		for i := uint32(0); i < count; i++ {
+			out = append(out, next+i)
+		}
+		return out
+	}
+	single := uint32(1)
+	if next == 1 {
+		single = 2
+	}
+	for _, count := range []uint32{constants.LightClientBatchSize, single} {
+		ctx, cf := context.WithTimeout(c.ctx, constants.MsgTimeout)
+		hdrs, err := c.reqBus.RequestP2PGetBlockHeaders(ctx, heights(count))
+		cf()
+		if err != nil {
+			utils.DebugTrace(c.logger, err)
+			continue
+		}
+		for i := 0; i < len(hdrs); i++ {
+			if hdrs[i].BClaims.Height != next+uint32(i) {
+				c.logger.Warnf("Peer returned block header %v in place of %v", hdrs[i].BClaims.Height, next+uint32(i))
+				return hdrs[:i]
+			}
+		}
+		return hdrs
+	}
+	return nil
+}
+
+// verifyGenesis checks the genesis header against the first validator set
+// and the signed header that follows it
+func (c *Client) verifyGenesis(txn *badger.Txn, bh *objs.BlockHeader, child *objs.BlockHeader) error {
+	vs, err := c.database.GetValidatorSetForHeight(txn, 1)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return errorz.ErrInvalid{}.New("no validator set for the genesis header")
+		}
+		return err
+	}
+	if bh.BClaims.ChainID != c.chainID {
+		return errorz.ErrInvalid{}.New("genesis header chain id mismatch")
+	}
+	vlst := [][]byte{}
+	for i := 0; i < len(vs.Validators); i++ {
+		vlst = append(vlst, crypto.Hasher(vs.Validators[i].VAddr))
+	}
+	prevBlock, err := objs.MakeTxRoot(vlst)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(bh.BClaims.PrevBlock, prevBlock) {
+		return errorz.ErrInvalid{}.New("genesis header does not match the validator set")
+	}
+	if !bytes.Equal(bh.BClaims.HeaderRoot, make([]byte, constants.HashLen)) {
+		return errorz.ErrInvalid{}.New("genesis header has a header root")
+	}
+	bhsh, err := bh.BlockHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(child.BClaims.PrevBlock, bhsh) {
+		return errorz.ErrInvalid{}.New("genesis header is not the parent of the first signed header")
+	}
+	return nil
+}
+
+// verify checks the header bh that follows prev. A header that can not be
+// verified yet, because the validator set that signed it has not been
+// received from Ethereum, is reported as not ok without an error.
+func (c *Client) verify(txn *badger.Txn, prev *objs.BlockHeader, bh *objs.BlockHeader) (bool, error) {
+	height := bh.BClaims.Height
+	if prev == nil || height != prev.BClaims.Height+1 {
+		return false, errorz.ErrInvalid{}.New("block header out of order")
+	}
+	if bh.BClaims.ChainID != c.chainID {
+		return false, errorz.ErrInvalid{}.New("block header chain id mismatch")
+	}
+	prevHash, err := prev.BlockHash()
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(bh.BClaims.PrevBlock, prevHash) {
+		return false, errorz.ErrInvalid{}.New("block header does not extend the local chain")
+	}
+	headerRoot, err := c.database.GetHeaderTrieRoot(txn, prev.BClaims.Height)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(bh.BClaims.HeaderRoot, headerRoot) {
+		return false, errorz.ErrInvalid{}.New("block header root mismatch")
+	}
+	if err := bh.ValidateSignatures(c.bnVal); err != nil {
+		return false, err
+	}
+	vs, err := c.database.GetValidatorSetForHeight(txn, height)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			c.logger.Debugf("No validator set for block header %v", height)
+			return false, nil
+		}
+		return false, err
+	}
+	if !bytes.Equal(bh.GroupKey, vs.GroupKey) {
+		// the validator set for this height may still be in transit from
+		// Ethereum
+		c.logger.Debugf("Block header %v is not signed by the known validator set", height)
+		return false, nil
+	}
+	return true, nil
+}
+
+// tip returns the most recent header accepted by the light client or nil
+func (c *Client) tip() (*objs.BlockHeader, error) {
+	var bh *objs.BlockHeader
+	err := c.database.View(func(txn *badger.Txn) error {
+		os, err := c.database.GetOwnState(txn)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		bh = os.SyncToBH
+		return nil
+	})
+	return bh, err
+}
+
+func (c *Client) setOwnState(txn *badger.Txn, bh *objs.BlockHeader) error {
+	snapshot, err := c.database.GetLastSnapshot(txn)
+	if err != nil {
+		utils.DebugTrace(c.logger, err)
+		return err
+	}
+	vs, err := c.database.GetValidatorSetForHeight(txn, bh.BClaims.Height)
+	if err != nil {
+		utils.DebugTrace(c.logger, err)
+		return err
+	}
+	os := &objs.OwnState{
+		VAddr:             utils.CopySlice(c.vAddr),
+		GroupKey:          utils.CopySlice(vs.GroupKey),
+		SyncToBH:          bh,
+		MaxBHSeen:         bh,
+		CanonicalSnapShot: snapshot,
+		PendingSnapShot:   snapshot,
+	}
+	return c.database.SetOwnState(txn, os)
+}
+
+func (c *Client) setCaughtUp(v bool) {
+	c.Lock()
+	defer c.Unlock()
+	c.caughtUp = v
+}
+
+// Height returns the height of the most recent header accepted by the light
+// client
+func (c *Client) Height() (uint32, error) {
+	bh, err := c.tip()
+	if err != nil {
+		return 0, err
+	}
+	if bh == nil {
+		return 0, nil
+	}
+	return bh.BClaims.Height, nil
+}
+
+// AddValidatorSet stores a validator set reported by Ethereum. Headers from
+// the height NotBefore onwards are verified against it.
+func (c *Client) AddValidatorSet(v *objs.ValidatorSet) error {
+	if v.NotBefore <= 2 {
+		v.NotBefore = 1
+	}
+	return c.database.Update(func(txn *badger.Txn) error {
+		if err := c.database.SetValidatorSet(txn, v); err != nil {
+			utils.DebugTrace(c.logger, err)
+			return err
+		}
+		return nil
+	})
+}
+
+// RemoveValidatorSet removes a validator set whose Ethereum block was
+// reorged out
+func (c *Client) RemoveValidatorSet(notBefore uint32) error {
+	return c.database.Update(func(txn *badger.Txn) error {
+		if err := c.database.DeleteValidatorSet(txn, notBefore); err != nil {
+			utils.DebugTrace(c.logger, err)
+			return err
+		}
+		return nil
+	})
+}
+
+// AddSnapshot compares a snapshot reported by Ethereum to the header the
+// light client accepted at the same height
+func (c *Client) AddSnapshot(bh *objs.BlockHeader, startingEthDKG bool) error {
+	return c.database.View(func(txn *badger.Txn) error {
+		local, err := c.database.GetCommittedBlockHeader(txn, bh.BClaims.Height)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		localHash, err := local.BlockHash()
+		if err != nil {
+			return err
+		}
+		bhsh, err := bh.BlockHash()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(localHash, bhsh) {
+			c.logger.Errorf("Snapshot at height %v does not match the local block header: 0x%x != 0x%x", bh.BClaims.Height, bhsh, localHash)
+		}
+		return nil
+	})
+}
+
+// AddPrivateKey is a no-op since the light client does not sign
+func (c *Client) AddPrivateKey(pk []byte, curveSpec constants.CurveSpec) error {
+	return nil
+}
+
+// SetPrivK refuses the secret since the light client does not take part in
+// ETHDKG
+func (c *Client) SetPrivK(name []byte, privk []byte) error {
+	return errorz.ErrInvalid{}.New("light node does not hold keys")
+}
+
+// GetPrivK returns an error since the light client holds no keys
+func (c *Client) GetPrivK(name []byte) ([]byte, error) {
+	return nil, errorz.ErrInvalid{}.New("light node does not hold keys")
+}
+
+// RegisterSnapshotCallback is a no-op since the light client does not take
+// snapshots
+func (c *Client) RegisterSnapshotCallback(fn func(bh *objs.BlockHeader) error) {}
+
+// SetSynchronized records if the Ethereum endpoint is in sync
+func (c *Client) SetSynchronized(v bool) {
+	c.Lock()
+	defer c.Unlock()
+	c.ethSynced = v
+}
@@ -0,0 +1,161 @@
+package lightclient
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/request"
+	"github.com/MadBase/MadNet/consensus/simulator"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func newTestClient(t *testing.T, n *simulator.Network) *Client {
+	opts := badger.DefaultOptions("").WithInMemory(true)
+	opts.Logger = nil
+	rawDB, err := badger.Open(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rawDB.Close() })
+	database := &db.Database{}
+	if err := database.Init(rawDB); err != nil {
+		t.Fatal(err)
+	}
+	peerSub, err := n.Subscribe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqClient := &request.Client{}
+	if err := reqClient.Init(peerSub); err != nil {
+		t.Fatal(err)
+	}
+	c := &Client{}
+	if err := c.Init(simulator.DefaultConfig().ChainID, database, reqClient, crypto.Hasher([]byte("light"))[12:]); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func newTestNetwork(t *testing.T, height uint32) *simulator.Network {
+	n, err := simulator.New(simulator.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(n.Close)
+	if err := n.RunUntilHeight(height, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// syncAll runs Sync until no further header is accepted
+func syncAll(t *testing.T, c *Client) error {
+	for i := 0; i < 100; i++ {
+		done, err := c.Sync()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+	t.Fatal("light client did not settle")
+	return nil
+}
+
+func TestClientSync(t *testing.T) {
+	n := newTestNetwork(t, 6)
+	c := newTestClient(t, n)
+	if err := c.AddValidatorSet(n.ValidatorSet()); err != nil {
+		t.Fatal(err)
+	}
+	if err := syncAll(t, c); err != nil {
+		t.Fatal(err)
+	}
+	height, err := c.Height()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if height < 6 {
+		t.Fatalf("light client synchronized to %d", height)
+	}
+	err = c.database.View(func(txn *badger.Txn) error {
+		root, err := c.database.GetHeaderRootForProposal(txn)
+		if err != nil {
+			return err
+		}
+		for h := uint32(1); h <= height; h++ {
+			expected, err := n.Node(0).BlockHash(h)
+			if err != nil {
+				return err
+			}
+			bh, proof, err := c.database.GetCommittedBlockHeaderWithProof(txn, root, h)
+			if err != nil {
+				return err
+			}
+			bhsh, err := bh.BlockHash()
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(bhsh, expected) {
+				t.Fatalf("block header %d does not match the network", h)
+			}
+			ok, err := c.database.ValidateCommittedBlockHeaderWithProof(txn, root, bh, proof)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				t.Fatalf("invalid proof for block header %d", h)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Safe() {
+		t.Fatal("should not be safe before Ethereum is in sync")
+	}
+	c.SetSynchronized(true)
+	if !c.Safe() {
+		t.Fatal("should be safe once caught up")
+	}
+}
+
+func TestClientNoValidatorSet(t *testing.T) {
+	n := newTestNetwork(t, 3)
+	c := newTestClient(t, n)
+	if err := syncAll(t, c); err == nil {
+		t.Fatal("should have raised error")
+	}
+	height, err := c.Height()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if height != 0 {
+		t.Fatalf("light client accepted headers up to %d without a validator set", height)
+	}
+}
+
+func TestClientWrongGroupKey(t *testing.T) {
+	n := newTestNetwork(t, 3)
+	c := newTestClient(t, n)
+	vs := n.ValidatorSet()
+	vs.GroupKey = n.ValidatorSet().Validators[0].GroupShare
+	if err := c.AddValidatorSet(vs); err != nil {
+		t.Fatal(err)
+	}
+	if err := syncAll(t, c); err == nil {
+		t.Fatal("should have raised error")
+	}
+	height, err := c.Height()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if height != 0 {
+		t.Fatalf("light client accepted headers up to %d signed by another group", height)
+	}
+}
@@ -0,0 +1,92 @@
+package lightclient
+
+import (
+	"context"
+
+	"github.com/MadBase/MadNet/errorz"
+	pb "github.com/MadBase/MadNet/proto"
+)
+
+var _ pb.P2PGetMinedTxsHandler = (*Client)(nil)
+var _ pb.P2PGetPendingTxsHandler = (*Client)(nil)
+var _ pb.P2PGetSnapShotNodeHandler = (*Client)(nil)
+var _ pb.P2PGetSnapShotStateDataHandler = (*Client)(nil)
+var _ pb.P2PGossipTransactionHandler = (*Client)(nil)
+var _ pb.P2PGossipProposalHandler = (*Client)(nil)
+var _ pb.P2PGossipPreVoteHandler = (*Client)(nil)
+var _ pb.P2PGossipPreVoteNilHandler = (*Client)(nil)
+var _ pb.P2PGossipPreCommitHandler = (*Client)(nil)
+var _ pb.P2PGossipPreCommitNilHandler = (*Client)(nil)
+var _ pb.P2PGossipNextRoundHandler = (*Client)(nil)
+var _ pb.P2PGossipNextHeightHandler = (*Client)(nil)
+var _ pb.P2PGossipBlockHeaderHandler = (*Client)(nil)
+
+// The light client holds no application state, so the peer requests for it
+// are refused rather than left to time out. Consensus gossip is acknowledged
+// and dropped.
+
+// HandleP2PGetMinedTxs refuses the request
+func (c *Client) HandleP2PGetMinedTxs(ctx context.Context, req *pb.GetMinedTxsRequest) (*pb.GetMinedTxsResponse, error) {
+	return nil, errorz.ErrInvalid{}.New("light node does not hold transactions")
+}
+
+// HandleP2PGetPendingTxs refuses the request
+func (c *Client) HandleP2PGetPendingTxs(ctx context.Context, req *pb.GetPendingTxsRequest) (*pb.GetPendingTxsResponse, error) {
+	return nil, errorz.ErrInvalid{}.New("light node does not hold transactions")
+}
+
+// HandleP2PGetSnapShotNode refuses the request
+func (c *Client) HandleP2PGetSnapShotNode(ctx context.Context, req *pb.GetSnapShotNodeRequest) (*pb.GetSnapShotNodeResponse, error) {
+	return nil, errorz.ErrInvalid{}.New("light node does not hold state")
+}
+
+// HandleP2PGetSnapShotStateData refuses the request
+func (c *Client) HandleP2PGetSnapShotStateData(ctx context.Context, req *pb.GetSnapShotStateDataRequest) (*pb.GetSnapShotStateDataResponse, error) {
+	return nil, errorz.ErrInvalid{}.New("light node does not hold state")
+}
+
+// HandleP2PGossipTransaction drops the message
+func (c *Client) HandleP2PGossipTransaction(ctx context.Context, msg *pb.GossipTransactionMessage) (*pb.GossipTransactionAck, error) {
+	return &pb.GossipTransactionAck{}, nil
+}
+
+// HandleP2PGossipProposal drops the message
+func (c *Client) HandleP2PGossipProposal(ctx context.Context, msg *pb.GossipProposalMessage) (*pb.GossipProposalAck, error) {
+	return &pb.GossipProposalAck{}, nil
+}
+
+// HandleP2PGossipPreVote drops the message
+func (c *Client) HandleP2PGossipPreVote(ctx context.Context, msg *pb.GossipPreVoteMessage) (*pb.GossipPreVoteAck, error) {
+	return &pb.GossipPreVoteAck{}, nil
+}
+
+// HandleP2PGossipPreVoteNil drops the message
+func (c *Client) HandleP2PGossipPreVoteNil(ctx context.Context, msg *pb.GossipPreVoteNilMessage) (*pb.GossipPreVoteNilAck, error) {
+	return &pb.GossipPreVoteNilAck{}, nil
+}
+
+// HandleP2PGossipPreCommit drops the message
+func (c *Client) HandleP2PGossipPreCommit(ctx context.Context, msg *pb.GossipPreCommitMessage) (*pb.GossipPreCommitAck, error) {
+	return &pb.GossipPreCommitAck{}, nil
+}
+
+// HandleP2PGossipPreCommitNil drops the message
+func (c *Client) HandleP2PGossipPreCommitNil(ctx context.Context, msg *pb.GossipPreCommitNilMessage) (*pb.GossipPreCommitNilAck, error) {
+	return &pb.GossipPreCommitNilAck{}, nil
+}
+
+// HandleP2PGossipNextRound drops the message
+func (c *Client) HandleP2PGossipNextRound(ctx context.Context, msg *pb.GossipNextRoundMessage) (*pb.GossipNextRoundAck, error) {
+	return &pb.GossipNextRoundAck{}, nil
+}
+
+// HandleP2PGossipNextHeight drops the message
+func (c *Client) HandleP2PGossipNextHeight(ctx context.Context, msg *pb.GossipNextHeightMessage) (*pb.GossipNextHeightAck, error) {
+	return &pb.GossipNextHeightAck{}, nil
+}
+
+// HandleP2PGossipBlockHeader drops the message. Block headers are pulled
+// from peers and verified by the synchronization loop instead.
+func (c *Client) HandleP2PGossipBlockHeader(ctx context.Context, msg *pb.GossipBlockHeaderMessage) (*pb.GossipBlockHeaderAck, error) {
+	return &pb.GossipBlockHeaderAck{}, nil
+}
@@ -33,7 +33,7 @@ func (ce *Engine) AddPendingTx(txn *badger.Txn, d []interfaces.Transaction) erro
 
 func (ce *Engine) getValidValue(txn *badger.Txn, rs *RoundStates) ([][]byte, []byte, []byte, []byte, error) {
 	chainID := rs.OwnState.SyncToBH.BClaims.ChainID
-	txs, stateRoot, err := ce.appHandler.GetValidProposal(txn, chainID, rs.OwnState.SyncToBH.BClaims.Height+1, constants.MaxProposalSize)
+	txs, stateRoot, err := ce.appHandler.GetValidProposal(txn, chainID, rs.OwnState.SyncToBH.BClaims.Height+1, ce.maxProposalSize())
 	if err != nil {
 		utils.DebugTrace(ce.logger, err)
 		return nil, nil, nil, nil, err
@@ -119,3 +119,17 @@ func (ce *Engine) applyState(txn *badger.Txn, rs *RoundStates, chainID uint32, t
 	}
 	return nil
 }
+
+// maxProposalSize is the size allowed for the proposal of the current epoch.
+// Gossip drops messages larger than constants.MaxBytes, so the size can
+// only be lowered.
+func (ce *Engine) maxProposalSize() uint32 {
+	size := uint32(constants.MaxProposalSize)
+	if ce.Parameters == nil {
+		return size
+	}
+	if v := ce.Parameters.GetMaxProposalSize(); v > 0 && v < size {
+		return v
+	}
+	return size
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/MadBase/MadNet/consensus/request"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/dynamics"
 	"github.com/MadBase/MadNet/logging"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/sirupsen/logrus"
@@ -45,8 +46,24 @@ type Engine struct {
 	EthPubk []byte
 
 	dm *dman.DMan
+
+	// Clock is the source of time for the step timeouts and the trace and
+	// defaults to the wall clock
+	Clock utils.Clock
+
+	// Parameters holds the values governance may change. The constants are
+	// used when it is nil.
+	Parameters Parameters
+}
+
+// Parameters are the consensus parameters that may change from one epoch
+// to the next
+type Parameters interface {
+	GetMaxProposalSize() uint32
 }
 
+var _ Parameters = (*dynamics.Storage)(nil)
+
 // Init will initialize the Consensus Engine and all sub modules
 func (ce *Engine) Init(database *db.Database, dm *dman.DMan, app appmock.Application, signer *crypto.Secp256k1Signer, adminHandlers *admin.Handlers, publicKey []byte, rbusClient *request.Client) error {
 	background := context.Background()
@@ -148,7 +165,7 @@ func (ce *Engine) UpdateLocalState() (bool, error) {
 				VAddr:    ownState.VAddr,
 				GroupKey: ownState.GroupKey,
 			}
-			ovs.SetRoundStarted()
+			ovs.SetRoundStarted(ce.Clock.Now())
 			err := ce.database.SetOwnValidatingState(txn, ovs)
 			if err != nil {
 				return err
@@ -276,6 +293,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 			}
 		}
 		if maxHR != nil {
+			ce.trace(txn, rs, "doHeightJumpStep", traceTriggerMessage, maxHR.RCert, nil)
 			err := ce.doHeightJumpStep(txn, rs, maxHR.RCert)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -300,6 +318,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 			}
 		}
 		if maxRCert != nil {
+			ce.trace(txn, rs, "doRoundJump", traceTriggerMessage, maxRCert, nil)
 			err := ce.doRoundJump(txn, rs, maxRCert)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -314,6 +333,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	if rs.OwnRoundState().NextRound != nil {
 		if rs.OwnRoundState().NRCurrent(rcert) {
 			if rcert.RClaims.Round == constants.DEADBLOCKROUNDNR {
+				ce.trace(txn, rs, "doNextRoundStep", traceTriggerMessage, nil, nil)
 				err := ce.doNextRoundStep(txn, rs)
 				if err != nil {
 					utils.DebugTrace(ce.logger, err)
@@ -334,6 +354,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 		return false, err
 	}
 	if len(NHs) > 0 && !os.NHCurrent(rcert) {
+		ce.trace(txn, rs, "castNextHeightFromNextHeight", traceTriggerMessage, nil, NHs[0].NHClaims.Proposal)
 		err := ce.castNextHeightFromNextHeight(txn, rs, NHs[0])
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -342,6 +363,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 		return true, nil
 	}
 	if len(NHs) > 0 && os.NHCurrent(rcert) {
+		ce.trace(txn, rs, "doNextHeightStep", traceTriggerMessage, nil, NHs[0].NHClaims.Proposal)
 		err := ce.doNextHeightStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -362,6 +384,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 				maxRCert = vroundState.RCert
 			}
 		}
+		ce.trace(txn, rs, "doRoundJump", traceTriggerMessage, maxRCert, nil)
 		err := ce.doRoundJump(txn, rs, maxRCert)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -379,12 +402,13 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	PCCurrent := os.PCCurrent(rcert)
 	PCNCurrent := os.PCNCurrent(rcert)
 	NRCurrent := os.NRCurrent(rcert)
-	PTOExpired := rs.OwnValidatingState.PTOExpired()
-	PVTOExpired := rs.OwnValidatingState.PVTOExpired()
-	PCTOExpired := rs.OwnValidatingState.PCTOExpired()
+	PTOExpired := rs.OwnValidatingState.PTOExpired(ce.Clock.Now())
+	PVTOExpired := rs.OwnValidatingState.PVTOExpired(ce.Clock.Now())
+	PCTOExpired := rs.OwnValidatingState.PCTOExpired(ce.Clock.Now())
 
 	// dispatch to handlers
 	if NRCurrent {
+		ce.trace(txn, rs, "doNextRoundStep", traceTriggerMessage, nil, nil)
 		err := ce.doNextRoundStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -394,6 +418,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	}
 	if PCCurrent {
 		if PCTOExpired {
+			ce.trace(txn, rs, "doPendingNext", traceTriggerTimeout, nil, os.PreCommit.Proposal)
 			err := ce.doPendingNext(txn, rs)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -401,6 +426,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 			}
 			return true, nil
 		}
+		ce.trace(txn, rs, "doPreCommitStep", traceTriggerMessage, nil, os.PreCommit.Proposal)
 		err := ce.doPreCommitStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -410,6 +436,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	}
 	if PCNCurrent {
 		if PCTOExpired {
+			ce.trace(txn, rs, "doPendingNext", traceTriggerTimeout, nil, nil)
 			err := ce.doPendingNext(txn, rs)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -417,6 +444,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 			}
 			return true, nil
 		}
+		ce.trace(txn, rs, "doPreCommitNilStep", traceTriggerMessage, nil, nil)
 		err := ce.doPreCommitNilStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -427,6 +455,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 
 	if PVCurrent {
 		if PVTOExpired {
+			ce.trace(txn, rs, "doPendingPreCommit", traceTriggerTimeout, nil, os.PreVote.Proposal)
 			err := ce.doPendingPreCommit(txn, rs)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -434,6 +463,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 			}
 			return true, nil
 		}
+		ce.trace(txn, rs, "doPreVoteStep", traceTriggerMessage, nil, os.PreVote.Proposal)
 		err := ce.doPreVoteStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -443,6 +473,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 	}
 	if PVNCurrent {
 		if PVTOExpired {
+			ce.trace(txn, rs, "doPendingPreCommit", traceTriggerTimeout, nil, nil)
 			err := ce.doPendingPreCommit(txn, rs)
 			if err != nil {
 				utils.DebugTrace(ce.logger, err)
@@ -450,6 +481,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 			}
 			return true, nil
 		}
+		ce.trace(txn, rs, "doPreVoteNilStep", traceTriggerMessage, nil, nil)
 		err := ce.doPreVoteNilStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -458,6 +490,11 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 		return true, nil
 	}
 	if PTOExpired {
+		var p *objs.Proposal
+		if PCurrent {
+			p = os.Proposal
+		}
+		ce.trace(txn, rs, "doPendingPreVoteStep", traceTriggerTimeout, nil, p)
 		err := ce.doPendingPreVoteStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
@@ -466,6 +503,7 @@ func (ce *Engine) updateLocalStateInternal(txn *badger.Txn, rs *RoundStates) (bo
 		return true, nil
 	}
 	if ISProposer && !PCurrent {
+		ce.trace(txn, rs, "doPendingProposalStep", traceTriggerProposer, nil, nil)
 		err := ce.doPendingProposalStep(txn, rs)
 		if err != nil {
 			utils.DebugTrace(ce.logger, err)
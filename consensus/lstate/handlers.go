@@ -101,7 +101,7 @@ func (mb *Handlers) Store(v interface{}) error {
 			if err != nil {
 				return err
 			}
-			go mb.dm.DownloadTxs(roundState.height, roundState.round, txHshLst)
+			mb.dm.DownloadTxs(roundState.height, roundState.round, txHshLst)
 		case *objs.PreVote:
 			err = roundState.SetPreVote(obj)
 			if err != nil {
@@ -183,7 +183,9 @@ func (mb *Handlers) Store(v interface{}) error {
 }
 
 // PreValidate checks a message for validity and performs cryptographic
-// validation
+// validation. Failures caused by the state of this node rather than by the
+// message, such as a validator set that is missing or not yet updated, are
+// returned as errorz.ErrLocalState.
 func (mb *Handlers) PreValidate(v interface{}) error {
 	var Voter []byte
 	var Proposer []byte
@@ -195,7 +197,7 @@ func (mb *Handlers) PreValidate(v interface{}) error {
 	err := mb.database.View(func(txn *badger.Txn) error {
 		os, err := mb.database.GetOwnState(txn)
 		if err != nil {
-			return err
+			return errorz.ErrLocalState{}.New(err.Error())
 		}
 		h, cid := objs.ExtractHCID(os.SyncToBH)
 		if cid != chainID {
@@ -301,7 +303,7 @@ func (mb *Handlers) PreValidate(v interface{}) error {
 		err := mb.database.View(func(txn *badger.Txn) error {
 			vSet, err := mb.database.GetValidatorSet(txn, height)
 			if err != nil {
-				return err
+				return errorz.ErrLocalState{}.New(err.Error())
 			}
 			GroupKey = gUtils.CopySlice(vSet.GroupKey)
 			return nil
@@ -313,10 +315,11 @@ func (mb *Handlers) PreValidate(v interface{}) error {
 	return mb.database.View(func(txn *badger.Txn) error {
 		vSet, err := mb.database.GetValidatorSet(txn, height)
 		if err != nil {
-			return err
+			return errorz.ErrLocalState{}.New(err.Error())
 		}
+		// the validator set of this node may not have been updated yet
 		if !bytes.Equal(GroupKey, vSet.GroupKey) {
-			return errorz.ErrInvalid{}.New("group key mismatch in state handlers")
+			return errorz.ErrLocalState{}.New("group key mismatch in state handlers")
 		}
 		if Voter != nil && GroupShare != nil {
 			if !vSet.IsValidTriplet(Voter, GroupShare, GroupKey) {
@@ -0,0 +1,59 @@
+package lstate
+
+import (
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// The triggers of a state transition as recorded in the trace
+const (
+	// traceTriggerMessage marks steps driven by messages from validators
+	traceTriggerMessage = "message"
+	// traceTriggerTimeout marks steps driven by an expired step timeout
+	traceTriggerTimeout = "timeout"
+	// traceTriggerProposer marks the proposal step of the local proposer
+	traceTriggerProposer = "proposer"
+)
+
+// trace records the step about to be taken by updateLocalStateInternal in
+// the trace ring of the database. Consecutive identical transitions are
+// recorded once since the engine retries a step until it is able to advance.
+// The target is the round certificate of a jump and p is the value the step
+// acts upon; both may be nil. Failure to record a trace event is logged but
+// never prevents the step from being taken.
+func (ce *Engine) trace(txn *badger.Txn, rs *RoundStates, step string, trigger string, target *objs.RCert, p *objs.Proposal) {
+	rcert := rs.OwnRoundState().RCert
+	ev := &objs.TraceEvent{
+		Time:         ce.Clock.Now().UnixNano(),
+		Height:       rcert.RClaims.Height,
+		Round:        rcert.RClaims.Round,
+		TargetHeight: rcert.RClaims.Height,
+		TargetRound:  rcert.RClaims.Round,
+		Step:         step,
+		Trigger:      trigger,
+	}
+	if target != nil {
+		ev.TargetHeight = target.RClaims.Height
+		ev.TargetRound = target.RClaims.Round
+	}
+	if p != nil && p.PClaims != nil && p.PClaims.BClaims != nil {
+		hsh, err := p.PClaims.BClaims.BlockHash()
+		if err != nil {
+			utils.DebugTrace(ce.logger, err)
+			return
+		}
+		ev.Hash = hsh
+	}
+	last, err := ce.database.GetTraceEvents(txn, 1)
+	if err != nil {
+		utils.DebugTrace(ce.logger, err)
+		return
+	}
+	if len(last) > 0 && last[0].Same(ev) {
+		return
+	}
+	if err := ce.database.AppendTraceEvent(txn, ev); err != nil {
+		utils.DebugTrace(ce.logger, err)
+	}
+}
@@ -0,0 +1,163 @@
+package objs
+
+import (
+	"bytes"
+
+	"github.com/MadBase/MadNet/errorz"
+
+	gUtils "github.com/MadBase/MadNet/utils"
+)
+
+// ParticipationKey ...
+type ParticipationKey struct {
+	Prefix []byte
+	Epoch  uint32
+}
+
+// MarshalBinary takes the ParticipationKey object and returns
+// the canonical byte slice
+func (b *ParticipationKey) MarshalBinary() ([]byte, error) {
+	if b == nil || b.Epoch == 0 {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	key := []byte{}
+	Prefix := gUtils.CopySlice(b.Prefix)
+	Epoch := gUtils.MarshalUint32(b.Epoch)
+	key = append(key, Prefix...)
+	key = append(key, []byte("|")...)
+	key = append(key, Epoch...)
+	return key, nil
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// ParticipationKey object
+func (b *ParticipationKey) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(data) < 5 {
+		return errorz.ErrInvalid{}.New("data incorrect length for unmarshalling ParticipationKey")
+	}
+	epochBytes := data[len(data)-4:]
+	prefix := data[:len(data)-4]
+	if !bytes.HasSuffix(prefix, []byte("|")) {
+		return errorz.ErrCorrupt
+	}
+	Epoch, err := gUtils.UnmarshalUint32(epochBytes)
+	if err != nil {
+		return err
+	}
+	if Epoch == 0 {
+		return errorz.ErrInvalid{}.New("invalid epoch for unmarshalling")
+	}
+	b.Prefix = gUtils.CopySlice(prefix[:len(prefix)-1])
+	b.Epoch = Epoch
+	return nil
+}
+
+// ValidatorParticipation holds the liveness counters of a single validator
+// over the heights of an epoch that have been tallied so far.
+type ValidatorParticipation struct {
+	VAddr           []byte
+	Rounds          uint32
+	ProposalsMade   uint32
+	ProposalsMissed uint32
+	PreVotes        uint32
+	PreCommits      uint32
+}
+
+// participationEntryLen is the length of the counters that follow the vaddr
+// of each ValidatorParticipation in the canonical encoding
+const participationEntryLen = 20
+
+// Participation is the per epoch aggregate of validator participation as
+// observed through the historic round states. LastHeight is the last height
+// that has been included in the aggregate.
+type Participation struct {
+	Epoch      uint32
+	LastHeight uint32
+	Validators []*ValidatorParticipation
+}
+
+// Get returns the counters for vaddr. If the validator is not yet tracked
+// the counters are added to the aggregate.
+func (b *Participation) Get(vaddr []byte) *ValidatorParticipation {
+	for i := 0; i < len(b.Validators); i++ {
+		if bytes.Equal(b.Validators[i].VAddr, vaddr) {
+			return b.Validators[i]
+		}
+	}
+	vp := &ValidatorParticipation{VAddr: gUtils.CopySlice(vaddr)}
+	b.Validators = append(b.Validators, vp)
+	return vp
+}
+
+// MarshalBinary takes the Participation object and returns the canonical
+// byte slice
+func (b *Participation) MarshalBinary() ([]byte, error) {
+	if b == nil || b.Epoch == 0 {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	out := []byte{}
+	out = append(out, gUtils.MarshalUint32(b.Epoch)...)
+	out = append(out, gUtils.MarshalUint32(b.LastHeight)...)
+	out = append(out, gUtils.MarshalUint32(uint32(len(b.Validators)))...)
+	for i := 0; i < len(b.Validators); i++ {
+		vp := b.Validators[i]
+		if vp == nil || len(vp.VAddr) == 0 || len(vp.VAddr) > 255 {
+			return nil, errorz.ErrInvalid{}.New("invalid validator participation")
+		}
+		out = append(out, uint8(len(vp.VAddr)))
+		out = append(out, vp.VAddr...)
+		out = append(out, gUtils.MarshalUint32(vp.Rounds)...)
+		out = append(out, gUtils.MarshalUint32(vp.ProposalsMade)...)
+		out = append(out, gUtils.MarshalUint32(vp.ProposalsMissed)...)
+		out = append(out, gUtils.MarshalUint32(vp.PreVotes)...)
+		out = append(out, gUtils.MarshalUint32(vp.PreCommits)...)
+	}
+	return out, nil
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// Participation object
+func (b *Participation) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(data) < 12 {
+		return errorz.ErrInvalid{}.New("data incorrect length for unmarshalling Participation")
+	}
+	epoch, _ := gUtils.UnmarshalUint32(data[0:4])
+	if epoch == 0 {
+		return errorz.ErrInvalid{}.New("invalid epoch for unmarshalling Participation")
+	}
+	lastHeight, _ := gUtils.UnmarshalUint32(data[4:8])
+	count, _ := gUtils.UnmarshalUint32(data[8:12])
+	data = data[12:]
+	validators := []*ValidatorParticipation{}
+	for i := uint32(0); i < count; i++ {
+		if len(data) < 1 {
+			return errorz.ErrCorrupt
+		}
+		vlen := int(data[0])
+		if vlen == 0 || len(data) < 1+vlen+participationEntryLen {
+			return errorz.ErrCorrupt
+		}
+		vp := &ValidatorParticipation{VAddr: gUtils.CopySlice(data[1 : 1+vlen])}
+		data = data[1+vlen:]
+		vp.Rounds, _ = gUtils.UnmarshalUint32(data[0:4])
+		vp.ProposalsMade, _ = gUtils.UnmarshalUint32(data[4:8])
+		vp.ProposalsMissed, _ = gUtils.UnmarshalUint32(data[8:12])
+		vp.PreVotes, _ = gUtils.UnmarshalUint32(data[12:16])
+		vp.PreCommits, _ = gUtils.UnmarshalUint32(data[16:20])
+		data = data[participationEntryLen:]
+		validators = append(validators, vp)
+	}
+	if len(data) != 0 {
+		return errorz.ErrCorrupt
+	}
+	b.Epoch = epoch
+	b.LastHeight = lastHeight
+	b.Validators = validators
+	return nil
+}
@@ -0,0 +1,115 @@
+package objs
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParticipationKey(t *testing.T) {
+	pk := &ParticipationKey{
+		Prefix: []byte("Prefix"),
+		Epoch:  uint32(7),
+	}
+	data, err := pk.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk2 := &ParticipationKey{}
+	err = pk2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pk.Prefix, pk2.Prefix) {
+		t.Fatal("fail")
+	}
+	if pk.Epoch != pk2.Epoch {
+		t.Fatal("fail")
+	}
+}
+
+func TestParticipationKeyBad(t *testing.T) {
+	pk := &ParticipationKey{}
+	_, err := pk.MarshalBinary()
+	if err == nil {
+		t.Fatal("Should have raised error (0)")
+	}
+	err = pk.UnmarshalBinary([]byte("ab"))
+	if err == nil {
+		t.Fatal("Should have raised error (1)")
+	}
+	err = pk.UnmarshalBinary([]byte("ab|\x00\x00\x00\x00"))
+	if err == nil {
+		t.Fatal("Should have raised error (2)")
+	}
+	err = pk.UnmarshalBinary([]byte("abc\x00\x00\x00\x01"))
+	if err == nil {
+		t.Fatal("Should have raised error (3)")
+	}
+}
+
+func TestParticipation(t *testing.T) {
+	p := &Participation{
+		Epoch:      3,
+		LastHeight: 2100,
+	}
+	v1 := p.Get([]byte("validator1"))
+	v1.Rounds = 10
+	v1.ProposalsMade = 2
+	v1.ProposalsMissed = 1
+	v1.PreVotes = 9
+	v1.PreCommits = 8
+	v2 := p.Get([]byte("validator2"))
+	v2.Rounds = 10
+	if p.Get([]byte("validator1")) != v1 {
+		t.Fatal("Get should return existing entry")
+	}
+	if len(p.Validators) != 2 {
+		t.Fatal("wrong number of validators")
+	}
+	data, err := p.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2 := &Participation{}
+	err = p2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p2.Epoch != p.Epoch || p2.LastHeight != p.LastHeight {
+		t.Fatal("fail")
+	}
+	if len(p2.Validators) != 2 {
+		t.Fatal("fail")
+	}
+	for i := 0; i < 2; i++ {
+		a := p.Validators[i]
+		b := p2.Validators[i]
+		if !bytes.Equal(a.VAddr, b.VAddr) || a.Rounds != b.Rounds ||
+			a.ProposalsMade != b.ProposalsMade || a.ProposalsMissed != b.ProposalsMissed ||
+			a.PreVotes != b.PreVotes || a.PreCommits != b.PreCommits {
+			t.Fatal("fail")
+		}
+	}
+	err = p2.UnmarshalBinary(data[:len(data)-1])
+	if err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+func TestParticipationBad(t *testing.T) {
+	p := &Participation{}
+	_, err := p.MarshalBinary()
+	if err == nil {
+		t.Fatal("Should have raised error (0)")
+	}
+	p.Epoch = 1
+	p.Validators = []*ValidatorParticipation{{}}
+	_, err = p.MarshalBinary()
+	if err == nil {
+		t.Fatal("Should have raised error (1)")
+	}
+	err = p.UnmarshalBinary([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	if err == nil {
+		t.Fatal("Should have raised error (2)")
+	}
+}
@@ -0,0 +1,137 @@
+package objs
+
+import (
+	"bytes"
+
+	"github.com/MadBase/MadNet/errorz"
+
+	gUtils "github.com/MadBase/MadNet/utils"
+)
+
+// SnapshotAlertKey ...
+type SnapshotAlertKey struct {
+	Prefix []byte
+	Height uint32
+}
+
+// MarshalBinary takes the SnapshotAlertKey object and returns
+// the canonical byte slice
+func (b *SnapshotAlertKey) MarshalBinary() ([]byte, error) {
+	if b == nil || b.Height == 0 {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	key := []byte{}
+	Prefix := gUtils.CopySlice(b.Prefix)
+	Height := gUtils.MarshalUint32(b.Height)
+	key = append(key, Prefix...)
+	key = append(key, []byte("|")...)
+	key = append(key, Height...)
+	return key, nil
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// SnapshotAlertKey object
+func (b *SnapshotAlertKey) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(data) < 5 {
+		return errorz.ErrInvalid{}.New("data incorrect length for unmarshalling SnapshotAlertKey")
+	}
+	heightBytes := data[len(data)-4:]
+	prefix := data[:len(data)-4]
+	if !bytes.HasSuffix(prefix, []byte("|")) {
+		return errorz.ErrCorrupt
+	}
+	Height, err := gUtils.UnmarshalUint32(heightBytes)
+	if err != nil {
+		return err
+	}
+	if Height == 0 {
+		return errorz.ErrInvalid{}.New("invalid height for unmarshalling")
+	}
+	b.Prefix = gUtils.CopySlice(prefix[:len(prefix)-1])
+	b.Height = Height
+	return nil
+}
+
+// SnapshotAlert records a snapshot taken on Ethereum that does not match the
+// block header this node committed at the same height. Validator is the
+// Ethereum address of the validator that took the snapshot.
+type SnapshotAlert struct {
+	Height          uint32
+	Epoch           uint32
+	Validator       []byte
+	BClaimsMismatch bool
+	SigMismatch     bool
+	LocalBlockHash  []byte
+	RemoteBlockHash []byte
+}
+
+// MarshalBinary takes the SnapshotAlert object and returns the canonical
+// byte slice
+func (b *SnapshotAlert) MarshalBinary() ([]byte, error) {
+	if b == nil || b.Height == 0 {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	out := []byte{}
+	out = append(out, gUtils.MarshalUint32(b.Height)...)
+	out = append(out, gUtils.MarshalUint32(b.Epoch)...)
+	flags := uint8(0)
+	if b.BClaimsMismatch {
+		flags |= 1
+	}
+	if b.SigMismatch {
+		flags |= 2
+	}
+	out = append(out, flags)
+	for _, field := range [][]byte{b.Validator, b.LocalBlockHash, b.RemoteBlockHash} {
+		if len(field) > 255 {
+			return nil, errorz.ErrInvalid{}.New("invalid snapshot alert")
+		}
+		out = append(out, uint8(len(field)))
+		out = append(out, field...)
+	}
+	return out, nil
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// SnapshotAlert object
+func (b *SnapshotAlert) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(data) < 9 {
+		return errorz.ErrInvalid{}.New("data incorrect length for unmarshalling SnapshotAlert")
+	}
+	height, _ := gUtils.UnmarshalUint32(data[0:4])
+	if height == 0 {
+		return errorz.ErrInvalid{}.New("invalid height for unmarshalling SnapshotAlert")
+	}
+	epoch, _ := gUtils.UnmarshalUint32(data[4:8])
+	flags := data[8]
+	data = data[9:]
+	fields := [][]byte{}
+	for i := 0; i < 3; i++ {
+		if len(data) < 1 {
+			return errorz.ErrCorrupt
+		}
+		flen := int(data[0])
+		if len(data) < 1+flen {
+			return errorz.ErrCorrupt
+		}
+		fields = append(fields, gUtils.CopySlice(data[1:1+flen]))
+		data = data[1+flen:]
+	}
+	if len(data) != 0 {
+		return errorz.ErrCorrupt
+	}
+	b.Height = height
+	b.Epoch = epoch
+	b.BClaimsMismatch = flags&1 != 0
+	b.SigMismatch = flags&2 != 0
+	b.Validator = fields[0]
+	b.LocalBlockHash = fields[1]
+	b.RemoteBlockHash = fields[2]
+	return nil
+}
@@ -0,0 +1,71 @@
+package objs
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSnapshotAlertKey(t *testing.T) {
+	sk := &SnapshotAlertKey{
+		Prefix: []byte("Prefix"),
+		Height: uint32(1024),
+	}
+	data, err := sk.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk2 := &SnapshotAlertKey{}
+	err = sk2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sk.Prefix, sk2.Prefix) {
+		t.Fatal("fail")
+	}
+	if sk.Height != sk2.Height {
+		t.Fatal("fail")
+	}
+	err = sk2.UnmarshalBinary([]byte("ab|\x00\x00\x00\x00"))
+	if err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+func TestSnapshotAlert(t *testing.T) {
+	sa := &SnapshotAlert{
+		Height:          2048,
+		Epoch:           2,
+		Validator:       []byte("validator-address123"),
+		SigMismatch:     true,
+		LocalBlockHash:  bytes.Repeat([]byte{1}, 32),
+		RemoteBlockHash: bytes.Repeat([]byte{2}, 32),
+	}
+	data, err := sa.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sa2 := &SnapshotAlert{}
+	err = sa2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sa2.Height != sa.Height || sa2.Epoch != sa.Epoch {
+		t.Fatal("fail")
+	}
+	if sa2.BClaimsMismatch || !sa2.SigMismatch {
+		t.Fatal("fail")
+	}
+	if !bytes.Equal(sa.Validator, sa2.Validator) ||
+		!bytes.Equal(sa.LocalBlockHash, sa2.LocalBlockHash) ||
+		!bytes.Equal(sa.RemoteBlockHash, sa2.RemoteBlockHash) {
+		t.Fatal("fail")
+	}
+	err = sa2.UnmarshalBinary(data[:len(data)-1])
+	if err == nil {
+		t.Fatal("Should have raised error (0)")
+	}
+	_, err = (&SnapshotAlert{}).MarshalBinary()
+	if err == nil {
+		t.Fatal("Should have raised error (1)")
+	}
+}
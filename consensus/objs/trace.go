@@ -0,0 +1,151 @@
+package objs
+
+import (
+	"bytes"
+
+	"github.com/MadBase/MadNet/errorz"
+
+	gUtils "github.com/MadBase/MadNet/utils"
+)
+
+// TraceEventKey ...
+type TraceEventKey struct {
+	Prefix []byte
+	Slot   uint32
+}
+
+// MarshalBinary takes the TraceEventKey object and returns
+// the canonical byte slice
+func (b *TraceEventKey) MarshalBinary() ([]byte, error) {
+	if b == nil || len(b.Prefix) == 0 {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	key := []byte{}
+	Prefix := gUtils.CopySlice(b.Prefix)
+	Slot := gUtils.MarshalUint32(b.Slot)
+	key = append(key, Prefix...)
+	key = append(key, []byte("|")...)
+	key = append(key, Slot...)
+	return key, nil
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// TraceEventKey object
+func (b *TraceEventKey) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(data) < 6 {
+		return errorz.ErrInvalid{}.New("data incorrect length for unmarshalling TraceEventKey")
+	}
+	prefix := data[:len(data)-4]
+	if !bytes.HasSuffix(prefix, []byte("|")) {
+		return errorz.ErrCorrupt
+	}
+	Slot, err := gUtils.UnmarshalUint32(data[len(data)-4:])
+	if err != nil {
+		return err
+	}
+	b.Prefix = gUtils.CopySlice(prefix[:len(prefix)-1])
+	b.Slot = Slot
+	return nil
+}
+
+// TraceEvent records a single state transition of the consensus engine.
+// Height and Round are the round of the local node when the step was taken.
+// TargetHeight and TargetRound are the round the step moves towards if the
+// step is a jump and equal Height and Round otherwise. Hash is the block
+// hash of the value the step acted upon if there is one.
+type TraceEvent struct {
+	Seq          uint64
+	Time         int64
+	Height       uint32
+	Round        uint32
+	TargetHeight uint32
+	TargetRound  uint32
+	Step         string
+	Trigger      string
+	Hash         []byte
+}
+
+// traceEventFixedLen is the length of the fixed width fields at the start of
+// the canonical encoding of a TraceEvent
+const traceEventFixedLen = 32
+
+// Same returns true if both events describe the same transition, ignoring
+// the sequence number and time at which they were recorded.
+func (b *TraceEvent) Same(a *TraceEvent) bool {
+	if b == nil || a == nil {
+		return false
+	}
+	return b.Height == a.Height &&
+		b.Round == a.Round &&
+		b.TargetHeight == a.TargetHeight &&
+		b.TargetRound == a.TargetRound &&
+		b.Step == a.Step &&
+		b.Trigger == a.Trigger &&
+		bytes.Equal(b.Hash, a.Hash)
+}
+
+// MarshalBinary takes the TraceEvent object and returns the canonical
+// byte slice
+func (b *TraceEvent) MarshalBinary() ([]byte, error) {
+	if b == nil || len(b.Step) == 0 {
+		return nil, errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(b.Step) > 255 || len(b.Trigger) > 255 || len(b.Hash) > 255 {
+		return nil, errorz.ErrInvalid{}.New("trace event field too long")
+	}
+	out := []byte{}
+	out = append(out, gUtils.MarshalUint64(b.Seq)...)
+	out = append(out, gUtils.MarshalInt64(b.Time)...)
+	out = append(out, gUtils.MarshalUint32(b.Height)...)
+	out = append(out, gUtils.MarshalUint32(b.Round)...)
+	out = append(out, gUtils.MarshalUint32(b.TargetHeight)...)
+	out = append(out, gUtils.MarshalUint32(b.TargetRound)...)
+	for _, field := range [][]byte{[]byte(b.Step), []byte(b.Trigger), b.Hash} {
+		out = append(out, uint8(len(field)))
+		out = append(out, field...)
+	}
+	return out, nil
+}
+
+// UnmarshalBinary takes a byte slice and returns the corresponding
+// TraceEvent object
+func (b *TraceEvent) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return errorz.ErrInvalid{}.New("not initialized")
+	}
+	if len(data) < traceEventFixedLen {
+		return errorz.ErrInvalid{}.New("data incorrect length for unmarshalling TraceEvent")
+	}
+	b.Seq, _ = gUtils.UnmarshalUint64(data[0:8])
+	b.Time, _ = gUtils.UnmarshalInt64(data[8:16])
+	b.Height, _ = gUtils.UnmarshalUint32(data[16:20])
+	b.Round, _ = gUtils.UnmarshalUint32(data[20:24])
+	b.TargetHeight, _ = gUtils.UnmarshalUint32(data[24:28])
+	b.TargetRound, _ = gUtils.UnmarshalUint32(data[28:32])
+	data = data[traceEventFixedLen:]
+	fields := [][]byte{}
+	for i := 0; i < 3; i++ {
+		if len(data) < 1 || len(data) < 1+int(data[0]) {
+			return errorz.ErrCorrupt
+		}
+		flen := int(data[0])
+		fields = append(fields, gUtils.CopySlice(data[1:1+flen]))
+		data = data[1+flen:]
+	}
+	if len(data) != 0 {
+		return errorz.ErrCorrupt
+	}
+	if len(fields[0]) == 0 {
+		return errorz.ErrInvalid{}.New("invalid step for unmarshalling TraceEvent")
+	}
+	b.Step = string(fields[0])
+	b.Trigger = string(fields[1])
+	b.Hash = fields[2]
+	if len(b.Hash) == 0 {
+		b.Hash = nil
+	}
+	return nil
+}
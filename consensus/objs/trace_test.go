@@ -0,0 +1,94 @@
+package objs
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTraceEventKey(t *testing.T) {
+	tk := &TraceEventKey{
+		Prefix: []byte("Prefix"),
+		Slot:   uint32(124),
+	}
+	data, err := tk.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk2 := &TraceEventKey{}
+	err = tk2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tk.Prefix, tk2.Prefix) || tk.Slot != tk2.Slot {
+		t.Fatal("fail")
+	}
+	tk3 := &TraceEventKey{}
+	_, err = tk3.MarshalBinary()
+	if err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+func TestTraceEvent(t *testing.T) {
+	ev := &TraceEvent{
+		Seq:          7,
+		Time:         1234567,
+		Height:       10,
+		Round:        2,
+		TargetHeight: 10,
+		TargetRound:  3,
+		Step:         "doRoundJump",
+		Trigger:      "message",
+		Hash:         []byte("hash"),
+	}
+	data, err := ev.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev2 := &TraceEvent{}
+	err = ev2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ev.Same(ev2) || ev.Seq != ev2.Seq || ev.Time != ev2.Time {
+		t.Fatal("fail")
+	}
+	ev2.Round = 3
+	if ev.Same(ev2) {
+		t.Fatal("events should differ")
+	}
+
+	ev.Hash = nil
+	data, err = ev.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev3 := &TraceEvent{}
+	err = ev3.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ev.Same(ev3) {
+		t.Fatal("fail")
+	}
+	err = ev3.UnmarshalBinary(data[:len(data)-1])
+	if err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
+
+func TestTraceEventBad(t *testing.T) {
+	ev := &TraceEvent{}
+	_, err := ev.MarshalBinary()
+	if err == nil {
+		t.Fatal("Should have raised error (0)")
+	}
+	err = ev.UnmarshalBinary(make([]byte, 31))
+	if err == nil {
+		t.Fatal("Should have raised error (1)")
+	}
+	err = ev.UnmarshalBinary(make([]byte, 35))
+	if err == nil {
+		t.Fatal("Should have raised error (2)")
+	}
+}
@@ -0,0 +1,196 @@
+package simulator
+
+import (
+	"github.com/MadBase/MadNet/consensus/appmock"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// Application is the application a node runs. Besides the interface used by
+// the consensus algorithm it checks and lists the transactions the node
+// gossips.
+type Application interface {
+	appmock.Application
+	PreValidatePendingTx(chainID uint32, tx interfaces.Transaction) error
+	GetTxsForGossip(txn *badger.Txn, currentHeight uint32) ([]interfaces.Transaction, error)
+}
+
+var _ Application = (*application)(nil)
+
+// application is the mock application with a fixed state root. Any byte
+// string is a valid transaction. Transactions are kept in a pending pool in
+// the database of the node until a block mines them, which keeps the
+// simulation focused on the consensus algorithm while blocks still carry
+// transactions.
+type application struct {
+	*appmock.MockApplication
+	database  *db.Database
+	stateRoot []byte
+}
+
+func newApplication(chainID uint32, database *db.Database) *application {
+	return &application{
+		MockApplication: appmock.New(),
+		database:        database,
+		stateRoot:       crypto.Hasher(utils.MarshalUint32(chainID)),
+	}
+}
+
+func (a *application) pendingKey(txHash []byte) []byte {
+	return append([]byte("simulator/pending/"), txHash...)
+}
+
+func (a *application) minedKey(txHash []byte) []byte {
+	return append([]byte("simulator/mined/"), txHash...)
+}
+
+// get returns the transactions stored under keyFn and the hashes that are
+// missing
+func (a *application) get(txn *badger.Txn, keyFn func([]byte) []byte, txHashes [][]byte) ([]interfaces.Transaction, [][]byte, error) {
+	txs := []interfaces.Transaction{}
+	missing := [][]byte{}
+	for _, txHash := range txHashes {
+		txb, err := utils.GetValue(txn, keyFn(txHash))
+		if err != nil {
+			if err != badger.ErrKeyNotFound {
+				return nil, nil, err
+			}
+			missing = append(missing, utils.CopySlice(txHash))
+			continue
+		}
+		txs = append(txs, &appmock.MockTransaction{V: txb})
+	}
+	return txs, missing, nil
+}
+
+// pending returns the pending transactions ordered by hash
+func (a *application) pending(txn *badger.Txn) ([]interfaces.Transaction, error) {
+	prefix := a.pendingKey(nil)
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	txs := []interfaces.Transaction{}
+	for it.Rewind(); it.Valid(); it.Next() {
+		txb, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, &appmock.MockTransaction{V: txb})
+	}
+	return txs, nil
+}
+
+// ApplyState is defined on the interface object
+func (a *application) ApplyState(txn *badger.Txn, chainID uint32, height uint32, txs []interfaces.Transaction) ([]byte, error) {
+	for _, tx := range txs {
+		txHash, err := tx.TxHash()
+		if err != nil {
+			return nil, err
+		}
+		txb, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		if err := txn.Delete(a.pendingKey(txHash)); err != nil {
+			return nil, err
+		}
+		if err := utils.SetValue(txn, a.minedKey(txHash), txb); err != nil {
+			return nil, err
+		}
+	}
+	return utils.CopySlice(a.stateRoot), nil
+}
+
+// GetValidProposal is defined on the interface object
+func (a *application) GetValidProposal(txn *badger.Txn, chainID, height, maxBytes uint32) ([]interfaces.Transaction, []byte, error) {
+	pending, err := a.pending(txn)
+	if err != nil {
+		return nil, nil, err
+	}
+	txs := []interfaces.Transaction{}
+	size := uint32(0)
+	for _, tx := range pending {
+		txb, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		if size+uint32(len(txb)) > maxBytes {
+			break
+		}
+		size += uint32(len(txb))
+		txs = append(txs, tx)
+	}
+	return txs, utils.CopySlice(a.stateRoot), nil
+}
+
+// PendingTxAdd is defined on the interface object. New transactions are
+// broadcast to the peers of the node.
+func (a *application) PendingTxAdd(txn *badger.Txn, chainID uint32, height uint32, txs []interfaces.Transaction) error {
+	added := 0
+	for _, tx := range txs {
+		txHash, err := tx.TxHash()
+		if err != nil {
+			return err
+		}
+		_, missing, err := a.get(txn, a.pendingKey, [][]byte{txHash})
+		if err != nil {
+			return err
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		_, missing, err = a.get(txn, a.minedKey, [][]byte{txHash})
+		if err != nil {
+			return err
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		txb, err := tx.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if err := utils.SetValue(txn, a.pendingKey(txHash), txb); err != nil {
+			return err
+		}
+		if err := a.database.SetBroadcastTransaction(txn, txb); err != nil {
+			return err
+		}
+		added++
+	}
+	if added == 0 && len(txs) > 0 {
+		return errorz.ErrInvalid{}.New("duplicate")
+	}
+	return nil
+}
+
+// MinedTxGet is defined on the interface object
+func (a *application) MinedTxGet(txn *badger.Txn, txHashes [][]byte) ([]interfaces.Transaction, [][]byte, error) {
+	return a.get(txn, a.minedKey, txHashes)
+}
+
+// PendingTxGet is defined on the interface object
+func (a *application) PendingTxGet(txn *badger.Txn, height uint32, txHashes [][]byte) ([]interfaces.Transaction, [][]byte, error) {
+	return a.get(txn, a.pendingKey, txHashes)
+}
+
+// PendingTxContains is defined on the interface object
+func (a *application) PendingTxContains(txn *badger.Txn, height uint32, txHashes [][]byte) ([][]byte, error) {
+	_, missing, err := a.get(txn, a.pendingKey, txHashes)
+	return missing, err
+}
+
+// PreValidatePendingTx is defined on the interface object
+func (a *application) PreValidatePendingTx(chainID uint32, tx interfaces.Transaction) error {
+	return nil
+}
+
+// GetTxsForGossip is defined on the interface object
+func (a *application) GetTxsForGossip(txn *badger.Txn, currentHeight uint32) ([]interfaces.Transaction, error) {
+	return a.pending(txn)
+}
@@ -0,0 +1,94 @@
+package simulator
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/crypto"
+	bn256 "github.com/MadBase/MadNet/crypto/bn256/cloudflare"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// validatorKeys holds the key material of a single validator
+type validatorKeys struct {
+	secpPrivk  []byte
+	secpPubk   []byte
+	vAddr      []byte
+	bnPrivk    []byte
+	groupShare []byte
+}
+
+// makeKeys deterministically derives the keys of n validators from rng. The
+// group keys are the result of a dealerless distributed key generation run
+// between all validators, as ETHDKG would produce on chain.
+func makeKeys(rng *rand.Rand, n int) ([]*validatorKeys, []byte, error) {
+	threshold := crypto.CalcThreshold(n)
+	coefs := make([][]*big.Int, n)
+	msk := big.NewInt(0)
+	for j := 0; j < n; j++ {
+		coefs[j] = make([]*big.Int, threshold+1)
+		for k := 0; k < threshold+1; k++ {
+			coefs[j][k] = new(big.Int).Rand(rng, bn256.Order)
+		}
+		msk.Add(msk, coefs[j][0])
+	}
+	msk.Mod(msk, bn256.Order)
+	groupKey := new(bn256.G2).ScalarBaseMult(msk).Marshal()
+	keys := make([]*validatorKeys, n)
+	for i := 0; i < n; i++ {
+		shares := make([]*big.Int, n)
+		for j := 0; j < n; j++ {
+			shares[j] = bn256.PrivatePolyEval(coefs[j], i+1)
+		}
+		gsk := bn256.GenerateGroupSecretKeyPortion(shares)
+		bnSigner := &crypto.BNGroupSigner{}
+		bnSigner.SetPrivk(gsk.Bytes())
+		groupShare, err := bnSigner.PubkeyShare()
+		if err != nil {
+			return nil, nil, err
+		}
+		secpPrivk := make([]byte, 32)
+		rng.Read(secpPrivk)
+		keys[i] = &validatorKeys{
+			bnPrivk:    gsk.Bytes(),
+			groupShare: groupShare,
+		}
+		if err := keys[i].setSecpPrivk(crypto.Hasher(secpPrivk)); err != nil {
+			return nil, nil, err
+		}
+	}
+	return keys, groupKey, nil
+}
+
+// setSecpPrivk replaces the secp256k1 key of the validator and the address
+// derived from it
+func (k *validatorKeys) setSecpPrivk(privk []byte) error {
+	secpSigner := &crypto.Secp256k1Signer{}
+	if err := secpSigner.SetPrivk(privk); err != nil {
+		return err
+	}
+	secpPubk, err := secpSigner.Pubkey()
+	if err != nil {
+		return err
+	}
+	k.secpPrivk = utils.CopySlice(privk)
+	k.secpPubk = secpPubk
+	k.vAddr = crypto.GetAccount(secpPubk)
+	return nil
+}
+
+// makeValidatorSet returns the validator set formed by keys in order
+func makeValidatorSet(keys []*validatorKeys, groupKey []byte) *objs.ValidatorSet {
+	vs := &objs.ValidatorSet{
+		GroupKey:  utils.CopySlice(groupKey),
+		NotBefore: 1,
+	}
+	for i := 0; i < len(keys); i++ {
+		vs.Validators = append(vs.Validators, &objs.Validator{
+			VAddr:      utils.CopySlice(keys[i].vAddr),
+			GroupShare: utils.CopySlice(keys[i].groupShare),
+		})
+	}
+	return vs
+}
@@ -0,0 +1,580 @@
+// Package simulator runs a network of complete consensus nodes in a single
+// process. Every node runs the services of a validator over its own in
+// memory database: the consensus engine, the state handlers, the download
+// manager, the request handlers and the gossip handlers and client. The
+// gossip and the requests of the services travel over simulated links
+// between the nodes and are handled exactly as they are between
+// validators.
+//
+// The network is stepped on a virtual clock. Every step advances the clock,
+// lets each running node take one step of the consensus algorithm in index
+// order and then hands every message that has arrived to its recipient in
+// order of arrival. After each of these the network waits until the
+// services of the node have settled: the gossip caused by the node is
+// queued and its downloads are either done or, if no peer could answer
+// them, held back until the next step. Messages are then put on their links
+// by a scheduler that draws losses, delays and reordering from the seed and
+// delivers them on the virtual clock. The step timeouts of the consensus
+// engine of every node read the virtual clock too, so a simulation covers
+// minutes of consensus in seconds and two networks with the same
+// configuration take exactly the same steps. Only inputs from outside the
+// network, such as the admin handlers fed by an Ethereum monitor, a local
+// RPC server or a client subscribed to the network, are not scheduled.
+//
+// Faults are injected through the network: partitions, lossy, slow and
+// reordering links, crashed validators and byzantine validators whose gossip
+// is rewritten by a Filter. After every step the network checks that no two
+// nodes committed different blocks at the same height.
+package simulator
+
+import (
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/peering"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/types"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// Config is the configuration of a simulated network
+type Config struct {
+	// Validators is the number of validators in the network
+	Validators int
+	// ChainID is the chain id of the network
+	ChainID uint32
+	// Seed seeds the keys of the validators and the faults of the links
+	Seed int64
+	// Tick is the virtual time that passes in every step
+	Tick time.Duration
+	// Link is the initial configuration of the links between nodes
+	Link LinkConfig
+	// ReGossip is the interval of virtual time at which validators send
+	// the messages of their current round again if they have not left it
+	ReGossip time.Duration
+	// Keys are the secp256k1 private keys of the validators, such as the
+	// keys of their Ethereum accounts. They are derived from Seed if nil.
+	Keys [][]byte
+	// Application returns the application node idx runs over its
+	// database. Nodes run a mock application that accepts any transaction
+	// if nil.
+	Application func(idx int, database *db.Database) (Application, error)
+	// NoGenesis starts the nodes without group keys or a validator set.
+	// These are handed to the admin handlers of each node from outside, as
+	// the Ethereum monitor of a validator does once ETHDKG completes.
+	NoGenesis bool
+	// Trace records every message delivered or dropped and every round
+	// entered in the trace of the network
+	Trace bool
+}
+
+// DefaultConfig returns the configuration of a network of four validators
+// over instant and reliable links
+func DefaultConfig() Config {
+	return Config{
+		Validators: 4,
+		ChainID:    42,
+		Seed:       1,
+		Tick:       100 * time.Millisecond,
+		ReGossip:   constants.MsgTimeout * 4,
+	}
+}
+
+// Network is a simulated network of validators
+type Network struct {
+	// now is the virtual time in nanoseconds since the unix epoch. It is
+	// read atomically by the consensus engine of every node.
+	now int64
+
+	// the lock guards the fault state of the network and the services of
+	// the nodes, which are replaced when a node restarts
+	sync.RWMutex
+
+	cfg       Config
+	logger    *logrus.Logger
+	start     time.Time
+	closeOnce sync.Once
+
+	mem       *transport.MemoryNetwork
+	nodes     []*Node
+	observers []*peering.PeerManager
+	groups    []int
+	filters   map[int]Filter
+	failed    error
+
+	nextReGossip time.Time
+
+	committed map[uint32][]byte
+
+	keys     []*validatorKeys
+	groupKey []byte
+
+	// msgs guards the messages in flight and the state of the scheduler.
+	// It is taken after the lock.
+	msgs     sync.Mutex
+	rng      *rand.Rand
+	seq      uint64
+	inflight queue
+	sent     map[sent]int64
+	trace    []Event
+}
+
+// New creates a network of validators that share a genesis block
+func New(cfg Config) (*Network, error) {
+	if cfg.Validators < 1 {
+		return nil, fmt.Errorf("simulator: invalid number of validators %d", cfg.Validators)
+	}
+	if cfg.Tick <= 0 {
+		return nil, fmt.Errorf("simulator: invalid tick %v", cfg.Tick)
+	}
+	if cfg.Keys != nil && len(cfg.Keys) != cfg.Validators {
+		return nil, fmt.Errorf("simulator: %d keys for %d validators", len(cfg.Keys), cfg.Validators)
+	}
+	n := &Network{
+		cfg:       cfg,
+		logger:    logging.GetLogger(constants.LoggerSimulator),
+		start:     time.Unix(1600000000, 0),
+		mem:       transport.NewMemoryNetwork(types.ChainIdentifier(cfg.ChainID), cfg.Seed),
+		groups:    make([]int, cfg.Validators),
+		filters:   make(map[int]Filter),
+		committed: make(map[uint32][]byte),
+		rng:       rand.New(rand.NewSource(cfg.Seed)),
+		sent:      make(map[sent]int64),
+	}
+	heap.Init(&n.inflight)
+	atomic.StoreInt64(&n.now, n.start.UnixNano())
+	n.nextReGossip = n.start.Add(cfg.ReGossip)
+	keys, groupKey, err := makeKeys(rand.New(rand.NewSource(cfg.Seed)), cfg.Validators)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(cfg.Keys); i++ {
+		if err := keys[i].setSecpPrivk(cfg.Keys[i]); err != nil {
+			return nil, err
+		}
+	}
+	n.keys = keys
+	n.groupKey = groupKey
+	for i := 0; i < cfg.Validators; i++ {
+		nd, err := newNode(n, i, keys[i])
+		if err != nil {
+			n.Close()
+			return nil, err
+		}
+		n.nodes = append(n.nodes, nd)
+	}
+	for _, nd := range n.nodes {
+		if err := n.boot(nd); err != nil {
+			n.Close()
+			return nil, err
+		}
+	}
+	n.connect()
+	return n, nil
+}
+
+// boot starts the services of node nd and stores its genesis state
+func (n *Network) boot(nd *Node) error {
+	if err := nd.start(); err != nil {
+		return err
+	}
+	if err := nd.settle(); err != nil {
+		return err
+	}
+	if n.cfg.NoGenesis {
+		return nil
+	}
+	return nd.genesis(n.ValidatorSet())
+}
+
+// Close shuts down every node and every client subscribed to the network
+func (n *Network) Close() {
+	n.closeOnce.Do(func() {
+		n.Lock()
+		observers := n.observers
+		n.observers = nil
+		n.Unlock()
+		for _, pm := range observers {
+			pm.Close()
+		}
+		for _, nd := range n.nodes {
+			nd.stop()
+			nd.rawDB.Close()
+		}
+	})
+}
+
+// Now returns the virtual time of the network
+func (n *Network) Now() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&n.now))
+}
+
+// Elapsed returns the virtual time passed since the network was created
+func (n *Network) Elapsed() time.Duration {
+	return n.Now().Sub(n.start)
+}
+
+// Node returns the node at index i
+func (n *Network) Node(i int) *Node {
+	return n.nodes[i]
+}
+
+// Nodes returns every node of the network
+func (n *Network) Nodes() []*Node {
+	return append([]*Node{}, n.nodes...)
+}
+
+// SetLink replaces the configuration of the links for the messages sent
+// from now on
+func (n *Network) SetLink(link LinkConfig) {
+	n.Lock()
+	defer n.Unlock()
+	n.cfg.Link = link
+}
+
+// SetFilter installs fn on the gossip sent by node i. A nil fn makes the
+// node honest again.
+func (n *Network) SetFilter(i int, fn Filter) {
+	n.Lock()
+	defer n.Unlock()
+	if fn == nil {
+		delete(n.filters, i)
+		return
+	}
+	n.filters[i] = fn
+}
+
+// Partition splits the network into the given groups of node indices. Nodes
+// that are not listed form one further group. Messages between groups are
+// dropped and the connections between them are closed until the partition
+// is healed.
+func (n *Network) Partition(groups ...[]int) {
+	n.Lock()
+	defer n.Unlock()
+	for i := range n.groups {
+		n.groups[i] = 0
+	}
+	for g, group := range groups {
+		for _, i := range group {
+			n.groups[i] = g + 1
+		}
+	}
+	n.partition()
+	n.forget(func(a, b int) bool {
+		return n.groups[a] != n.groups[b]
+	})
+}
+
+// partition cuts the links between the transports of nodes in different
+// groups and restores the others. The caller must hold the lock.
+func (n *Network) partition() {
+	for a := range n.nodes {
+		for b := a + 1; b < len(n.nodes); b++ {
+			addrA := n.nodes[a].transport.NodeAddr()
+			addrB := n.nodes[b].transport.NodeAddr()
+			if n.groups[a] != n.groups[b] {
+				n.mem.Partition(addrA, addrB)
+				continue
+			}
+			n.mem.Heal(addrA, addrB)
+		}
+	}
+}
+
+// Heal removes every partition
+func (n *Network) Heal() {
+	n.Partition()
+}
+
+// Crash stops node i. A crashed node takes no steps and its connections
+// are closed. Its database survives the crash.
+func (n *Network) Crash(i int) {
+	n.Lock()
+	nd := n.nodes[i]
+	if nd.crashed {
+		n.Unlock()
+		return
+	}
+	nd.crashed = true
+	n.Unlock()
+	nd.stop()
+}
+
+// Restart starts node i again over the database it had when it crashed. It
+// rejoins the network with a new transport.
+func (n *Network) Restart(i int) error {
+	n.Lock()
+	defer n.Unlock()
+	nd := n.nodes[i]
+	if !nd.crashed {
+		return nil
+	}
+	if err := nd.start(); err != nil {
+		return err
+	}
+	nd.crashed = false
+	n.partition()
+	n.forget(func(a, b int) bool {
+		return a == i || b == i
+	})
+	return nil
+}
+
+// ValidatorSet returns the validator set of the network as Ethereum reports
+// it
+func (n *Network) ValidatorSet() *objs.ValidatorSet {
+	return makeValidatorSet(n.keys, n.groupKey)
+}
+
+// Subscribe returns a peer subscription for a client outside the validator
+// set, such as a light client. The client has a peer manager of its own
+// that is connected to every running node regardless of partitions. It
+// serves no requests of its own.
+func (n *Network) Subscribe() (interfaces.PeerSubscription, error) {
+	mt, err := n.mem.NewTransport(logging.GetLogger(constants.LoggerTransport))
+	if err != nil {
+		return nil, err
+	}
+	pm, err := peering.NewPeerManagerWithTransport(&pb.UnimplementedP2PServer{}, mt, 1, n.cfg.Validators, false, "", nil)
+	if err != nil {
+		mt.Close()
+		return nil, err
+	}
+	go pm.Start()
+	n.Lock()
+	n.observers = append(n.observers, pm)
+	n.Unlock()
+	sub := pm.Subscribe()
+	for _, nd := range n.nodes {
+		if nd.Crashed() {
+			continue
+		}
+		if err := pm.Dial(nd.addr().P2PAddr()); err != nil {
+			return nil, err
+		}
+	}
+	return sub, nil
+}
+
+// observer is the node index of clients outside the validator set
+const observer = -1
+
+// index returns the index of the node at addr or observer
+func (n *Network) index(addr interfaces.NodeAddr) int {
+	n.RLock()
+	defer n.RUnlock()
+	for i, nd := range n.nodes {
+		if nd.transport != nil && nd.transport.NodeAddr().Identity() == addr.Identity() {
+			return i
+		}
+	}
+	return observer
+}
+
+// connect dials the peers each running node is not connected to. Each pair
+// of nodes in the same group is dialed by its lower index and every client
+// subscribed to the network dials every node.
+func (n *Network) connect() {
+	n.RLock()
+	running := []*Node{}
+	groups := []int{}
+	for i, nd := range n.nodes {
+		if nd.crashed {
+			continue
+		}
+		running = append(running, nd)
+		groups = append(groups, n.groups[i])
+	}
+	observers := append([]*peering.PeerManager{}, n.observers...)
+	n.RUnlock()
+	for a := range running {
+		for b := a + 1; b < len(running); b++ {
+			if groups[a] != groups[b] {
+				continue
+			}
+			if err := running[a].PeerManager().Dial(running[b].addr().P2PAddr()); err != nil {
+				utils.DebugTrace(n.logger, err)
+			}
+		}
+	}
+	for _, pm := range observers {
+		for _, nd := range running {
+			if err := pm.Dial(nd.addr().P2PAddr()); err != nil {
+				utils.DebugTrace(n.logger, err)
+			}
+		}
+	}
+}
+
+// fail records the first error of a service of a node. It is returned by
+// the next step.
+func (n *Network) fail(err error) {
+	n.Lock()
+	defer n.Unlock()
+	if n.failed == nil {
+		n.failed = err
+	}
+}
+
+// err returns the error recorded by fail
+func (n *Network) err() error {
+	n.RLock()
+	defer n.RUnlock()
+	return n.failed
+}
+
+// Step advances the virtual clock by one tick, connects the nodes that are
+// not connected and lets each running node take one step of the consensus
+// algorithm in index order. It then delivers the messages that have
+// arrived. It returns an error if a node failed or the safety check fails.
+func (n *Network) Step() error {
+	atomic.AddInt64(&n.now, int64(n.cfg.Tick))
+	n.expire()
+	running := []*Node{}
+	for _, nd := range n.nodes {
+		if !nd.Crashed() {
+			running = append(running, nd)
+		}
+	}
+	// requests held back in the last step fail and are retried now
+	for _, nd := range running {
+		n.release(nd)
+	}
+	for _, nd := range running {
+		if err := n.advance(nd); err != nil {
+			return err
+		}
+	}
+	n.connect()
+	for _, nd := range running {
+		if err := nd.update(); err != nil {
+			return fmt.Errorf("simulator: node %d: %v", nd.idx, err)
+		}
+		if err := n.advance(nd); err != nil {
+			return err
+		}
+	}
+	if n.cfg.ReGossip > 0 && !n.Now().Before(n.nextReGossip) {
+		n.nextReGossip = n.nextReGossip.Add(n.cfg.ReGossip)
+		for _, nd := range running {
+			if err := nd.reGossip(); err != nil {
+				return fmt.Errorf("simulator: node %d: %v", nd.idx, err)
+			}
+			if err := n.advance(nd); err != nil {
+				return err
+			}
+		}
+	}
+	if err := n.deliver(); err != nil {
+		return err
+	}
+	if err := n.err(); err != nil {
+		return err
+	}
+	return n.CheckSafety()
+}
+
+// Submit adds the transaction tx to the pending transactions of node i, as
+// the local RPC server of a validator does, and sends it to the peers of
+// the node
+func (n *Network) Submit(i int, tx []byte) error {
+	nd := n.nodes[i]
+	if nd.Crashed() {
+		return fmt.Errorf("simulator: node %d is crashed", i)
+	}
+	if err := nd.submit(tx); err != nil {
+		return fmt.Errorf("simulator: node %d: %v", i, err)
+	}
+	return n.advance(nd)
+}
+
+// Run steps the network for the duration d of virtual time
+func (n *Network) Run(d time.Duration) error {
+	end := n.Now().Add(d)
+	for n.Now().Before(end) {
+		if err := n.Step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunUntilHeight steps the network until every running node has committed
+// height. It returns an error if this takes longer than timeout of virtual
+// time, which is a violation of liveness.
+func (n *Network) RunUntilHeight(height uint32, timeout time.Duration) error {
+	end := n.Now().Add(timeout)
+	for {
+		done, heights, err := n.reached(height)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if !n.Now().Before(end) {
+			return fmt.Errorf("simulator: liveness: height %d not reached within %v, heights %v", height, timeout, heights)
+		}
+		if err := n.Step(); err != nil {
+			return err
+		}
+	}
+}
+
+// reached returns true if every running node has committed height
+func (n *Network) reached(height uint32) (bool, []uint32, error) {
+	done := true
+	heights := make([]uint32, len(n.nodes))
+	for i, nd := range n.nodes {
+		h, err := nd.Height()
+		if err != nil {
+			return false, nil, err
+		}
+		heights[i] = h
+		if h < height && !nd.Crashed() {
+			done = false
+		}
+	}
+	return done, heights, nil
+}
+
+// CheckSafety verifies that no two nodes have committed different blocks at
+// the same height. Crashed nodes are checked as well since their committed
+// blocks are final.
+func (n *Network) CheckSafety() error {
+	for _, nd := range n.nodes {
+		height, err := nd.Height()
+		if err != nil {
+			return err
+		}
+		for h := nd.checked + 1; h <= height; h++ {
+			hsh, err := nd.BlockHash(h)
+			if err != nil {
+				return err
+			}
+			prev, ok := n.committed[h]
+			if !ok {
+				n.committed[h] = hsh
+				continue
+			}
+			if string(prev) != string(hsh) {
+				return fmt.Errorf("simulator: safety: conflicting blocks %x and %x committed at height %d", prev, hsh, h)
+			}
+		}
+		if height > nd.checked {
+			nd.checked = height
+		}
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package simulator
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func newTestNetwork(t *testing.T, cfg Config) *Network {
+	n, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(n.Close)
+	return n
+}
+
+func heights(t *testing.T, n *Network) []uint32 {
+	out := []uint32{}
+	for _, nd := range n.Nodes() {
+		h, err := nd.Height()
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, h)
+	}
+	return out
+}
+
+func TestNetworkLiveness(t *testing.T) {
+	n := newTestNetwork(t, DefaultConfig())
+	if err := n.RunUntilHeight(5, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetworkTraceTime(t *testing.T) {
+	n := newTestNetwork(t, DefaultConfig())
+	if err := n.RunUntilHeight(3, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	var events []*objs.TraceEvent
+	err := n.Node(0).Database().View(func(txn *badger.Txn) error {
+		var err error
+		events, err = n.Node(0).Database().GetTraceEvents(txn, 1000)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) == 0 {
+		t.Fatal("no trace events recorded")
+	}
+	// events are returned newest first and stamped with the virtual clock
+	last := n.Now().UnixNano()
+	for _, ev := range events {
+		if ev.Time > last || ev.Time < n.start.UnixNano() {
+			t.Fatalf("trace event at %d outside of the virtual time %d to %d", ev.Time, n.start.UnixNano(), n.Now().UnixNano())
+		}
+		last = ev.Time
+	}
+}
+
+func TestNetworkReproducible(t *testing.T) {
+	txs := [][]byte{}
+	for i := 0; i < 8; i++ {
+		txs = append(txs, []byte(fmt.Sprintf("tx%d", i)))
+	}
+	run := func() ([]Event, [][]byte) {
+		cfg := DefaultConfig()
+		cfg.Trace = true
+		cfg.Link = LinkConfig{
+			Latency:     10 * time.Millisecond,
+			Jitter:      40 * time.Millisecond,
+			DropRate:    0.02,
+			ReorderRate: 0.1,
+			DelayRate:   0.05,
+			Delay:       time.Second,
+		}
+		n := newTestNetwork(t, cfg)
+		defer n.Close()
+		for i, tx := range txs[:4] {
+			if err := n.Submit(i%cfg.Validators, tx); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := n.RunUntilHeight(3, 2*time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		n.Crash(3)
+		for i, tx := range txs[4:] {
+			if err := n.Submit(i%3, tx); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := n.RunUntilHeight(5, 2*time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		if err := n.Restart(3); err != nil {
+			t.Fatal(err)
+		}
+		if err := n.Run(10 * time.Second); err != nil {
+			t.Fatal(err)
+		}
+		nd := n.Node(0)
+		hashes := [][]byte{}
+		for h := uint32(1); h <= 5; h++ {
+			hsh, err := nd.BlockHash(h)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hashes = append(hashes, hsh)
+		}
+		txHashes := [][]byte{}
+		for _, tx := range txs {
+			txHashes = append(txHashes, crypto.Hasher(tx))
+		}
+		err := nd.Database().View(func(txn *badger.Txn) error {
+			mined, _, err := nd.Application().MinedTxGet(txn, txHashes)
+			if err != nil {
+				return err
+			}
+			if len(mined) == 0 {
+				t.Fatal("no transaction was mined")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n.Trace(), hashes
+	}
+	trace1, hashes1 := run()
+	trace2, hashes2 := run()
+	kinds := make(map[EventKind]bool)
+	for _, ev := range trace1 {
+		kinds[ev.Kind] = true
+	}
+	if !kinds[EventDeliver] || !kinds[EventDrop] || !kinds[EventRound] {
+		t.Fatalf("trace is missing deliveries, drops or rounds: %v", kinds)
+	}
+	for i := 0; i < len(trace1) && i < len(trace2); i++ {
+		if trace1[i].String() != trace2[i].String() {
+			t.Fatalf("runs diverged at event %d: %v != %v", i, trace1[i], trace2[i])
+		}
+	}
+	if len(trace1) != len(trace2) {
+		t.Fatalf("runs recorded %d and %d events", len(trace1), len(trace2))
+	}
+	for i := range hashes1 {
+		if !bytes.Equal(hashes1[i], hashes2[i]) {
+			t.Fatalf("runs committed different blocks at height %d", i+1)
+		}
+	}
+}
+
+func TestNetworkUnreliableLinks(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Seed = 7
+	cfg.Link = LinkConfig{
+		Latency:     20 * time.Millisecond,
+		Jitter:      100 * time.Millisecond,
+		DropRate:    0.05,
+		ReorderRate: 0.2,
+		DelayRate:   0.1,
+		Delay:       2 * time.Second,
+	}
+	n := newTestNetwork(t, cfg)
+	if err := n.RunUntilHeight(4, 5*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetworkPartition(t *testing.T) {
+	n := newTestNetwork(t, DefaultConfig())
+	if err := n.RunUntilHeight(3, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	// neither side of an even split holds a quorum
+	n.Partition([]int{0, 1}, []int{2, 3})
+	if err := n.Run(5 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	before := heights(t, n)
+	if err := n.Run(30 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	after := heights(t, n)
+	for i := range before {
+		if before[i] != after[i] {
+			t.Fatalf("node %d advanced from %d to %d without a quorum", i, before[i], after[i])
+		}
+	}
+	n.Heal()
+	max := uint32(0)
+	for _, h := range after {
+		if h > max {
+			max = h
+		}
+	}
+	if err := n.RunUntilHeight(max+2, 5*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetworkCrash(t *testing.T) {
+	n := newTestNetwork(t, DefaultConfig())
+	if err := n.RunUntilHeight(2, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	n.Crash(3)
+	if !n.Node(3).Crashed() {
+		t.Fatal("node should be crashed")
+	}
+	if err := n.RunUntilHeight(6, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Restart(3); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.RunUntilHeight(8, 5*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetworkByzantine(t *testing.T) {
+	n := newTestNetwork(t, DefaultConfig())
+	// node 0 corrupts everything it sends to node 1 and withholds its
+	// messages from node 2
+	n.SetFilter(0, func(m *Message) []*Message {
+		switch m.To {
+		case 1:
+			data := append([]byte{}, m.Data...)
+			data[len(data)/2] ^= 0xff
+			return []*Message{{From: m.From, To: m.To, Kind: m.Kind, Data: data}}
+		case 2:
+			return nil
+		default:
+			return []*Message{m}
+		}
+	})
+	if err := n.RunUntilHeight(5, 5*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetworkSafetyCheck(t *testing.T) {
+	n := newTestNetwork(t, DefaultConfig())
+	if err := n.RunUntilHeight(2, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	n.committed[2] = make([]byte, 32)
+	for _, nd := range n.Nodes() {
+		nd.checked = 1
+	}
+	if err := n.CheckSafety(); err == nil {
+		t.Fatal("Should have raised error")
+	}
+}
@@ -0,0 +1,507 @@
+package simulator
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MadBase/MadNet/consensus/admin"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/dman"
+	"github.com/MadBase/MadNet/consensus/gossip"
+	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/consensus/request"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/peering"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// Node is a single validator of the simulated network. A node is made of the
+// same services a validator runs, wired to a private in memory database and
+// a transport of the memory network of the simulation.
+type Node struct {
+	net  *Network
+	idx  int
+	keys *validatorKeys
+
+	rawDB    *badger.DB
+	database *db.Database
+	app      Application
+
+	// lock is held by every step of the node, by the gossip handlers while
+	// they store a message and by the admin handlers for their writes, as
+	// the synchronizer of a validator does
+	lock sync.Mutex
+	done chan struct{}
+	wg   sync.WaitGroup
+	// synced is set while the node is in sync with the network and may
+	// handle gossip
+	synced int32
+
+	transport     *transport.MemoryTransport
+	server        interfaces.P2PServer
+	peerManager   *peering.PeerManager
+	adminHandlers *admin.Handlers
+	reqHandler    *request.Handler
+	reqClient     *request.Client
+	gossipHandler *gossip.Handlers
+	gossipClient  *gossip.Client
+	dm            *dman.DMan
+	handlers      *lstate.Handlers
+	engine        *lstate.Engine
+
+	crashed bool
+	// checked is the last height verified by the safety check
+	checked uint32
+
+	// the message lock of the network guards the messages the node queued
+	// to send, the requests it has held back and the last round recorded
+	// in the trace
+	outbox     []*Message
+	parked     int
+	release    chan struct{}
+	unanswered map[string]int64
+	height     uint32
+	rnd        uint32
+}
+
+// settleTimeout is the wall time the services of a node may take to settle
+// before the step fails
+const settleTimeout = 30 * time.Second
+
+// newNode creates the node at index idx over a new database. Its services
+// are started by start.
+func newNode(n *Network, idx int, keys *validatorKeys) (*Node, error) {
+	opts := badger.DefaultOptions("").WithInMemory(true)
+	opts.Logger = nil
+	rawDB, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	nd := &Node{
+		net:      n,
+		idx:      idx,
+		keys:     keys,
+		rawDB:    rawDB,
+		database: &db.Database{},
+	}
+	if err := nd.database.Init(rawDB); err != nil {
+		return nil, err
+	}
+	if n.cfg.Application != nil {
+		nd.app, err = n.cfg.Application(idx, nd.database)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		nd.app = newApplication(n.cfg.ChainID, nd.database)
+	}
+	return nd, nil
+}
+
+// start wires up the services of the node over its database and a new
+// transport, as a validator process does when it starts
+func (nd *Node) start() error {
+	mt, err := nd.net.mem.NewTransport(logging.GetLogger(constants.LoggerTransport))
+	if err != nil {
+		return err
+	}
+	dispatch := pb.NewInboundRPCDispatch()
+	srv := pb.NewGeneratedP2PServer(dispatch)
+	pm, err := peering.NewPeerManagerWithTransport(srv, mt, 1, nd.net.cfg.Validators, false, "", nil)
+	if err != nil {
+		mt.Close()
+		return err
+	}
+	nd.transport = mt
+	nd.server = srv
+	nd.peerManager = pm
+	nd.done = make(chan struct{})
+	nd.net.msgs.Lock()
+	nd.outbox = nil
+	nd.parked = 0
+	nd.release = make(chan struct{})
+	nd.unanswered = make(map[string]int64)
+	nd.net.msgs.Unlock()
+	// the services send and request over the links of the network
+	sub := &subscription{net: nd.net, idx: nd.idx, done: nd.done}
+	nd.adminHandlers = &admin.Handlers{Clock: nd.net.Now}
+	nd.reqHandler = &request.Handler{}
+	nd.reqClient = &request.Client{}
+	nd.gossipHandler = &gossip.Handlers{}
+	nd.gossipClient = &gossip.Client{}
+	nd.dm = &dman.DMan{}
+	nd.handlers = &lstate.Handlers{}
+	nd.engine = &lstate.Engine{Clock: nd.net.Now}
+	atomic.StoreInt32(&nd.synced, 0)
+	secpSigner := &crypto.Secp256k1Signer{}
+	if err := secpSigner.SetPrivk(nd.keys.secpPrivk); err != nil {
+		return err
+	}
+	if err := nd.reqClient.Init(sub); err != nil {
+		return err
+	}
+	if err := nd.reqHandler.Init(nd.database, nd.app); err != nil {
+		return err
+	}
+	if err := nd.dm.Init(nd.database, nd.app, nd.reqClient); err != nil {
+		return err
+	}
+	if err := nd.handlers.Init(nd.database, nd.dm); err != nil {
+		return err
+	}
+	if err := nd.gossipHandler.Init(nd.database, sub, nd.app, nd.handlers); err != nil {
+		return err
+	}
+	if err := nd.gossipClient.Init(nd.database, sub, nd.app); err != nil {
+		return err
+	}
+	secret := crypto.Hasher(nd.keys.secpPrivk)
+	if err := nd.adminHandlers.Init(nd.net.cfg.ChainID, nd.database, secret, nd.app, nd.keys.secpPubk); err != nil {
+		return err
+	}
+	if err := nd.engine.Init(nd.database, nd.dm, nd.app, secpSigner, nd.adminHandlers, nd.keys.secpPubk, nd.reqClient); err != nil {
+		return err
+	}
+	dispatch.RegisterP2PGetPeers(pm)
+	dispatch.RegisterP2PGossipTransaction(nd.gossipHandler)
+	dispatch.RegisterP2PGossipProposal(nd.gossipHandler)
+	dispatch.RegisterP2PGossipPreVote(nd.gossipHandler)
+	dispatch.RegisterP2PGossipPreVoteNil(nd.gossipHandler)
+	dispatch.RegisterP2PGossipPreCommit(nd.gossipHandler)
+	dispatch.RegisterP2PGossipPreCommitNil(nd.gossipHandler)
+	dispatch.RegisterP2PGossipNextRound(nd.gossipHandler)
+	dispatch.RegisterP2PGossipNextHeight(nd.gossipHandler)
+	dispatch.RegisterP2PGossipBlockHeader(nd.gossipHandler)
+	dispatch.RegisterP2PGetBlockHeaders(nd.reqHandler)
+	dispatch.RegisterP2PGetMinedTxs(nd.reqHandler)
+	dispatch.RegisterP2PGetPendingTxs(nd.reqHandler)
+	dispatch.RegisterP2PGetSnapShotNode(nd.reqHandler)
+	dispatch.RegisterP2PGetSnapShotStateData(nd.reqHandler)
+	dispatch.RegisterP2PGetSnapShotHdrNode(nd.reqHandler)
+
+	go pm.Start()
+	if err := nd.gossipClient.Start(); err != nil {
+		return err
+	}
+	nd.dm.Start()
+	nd.wg.Add(2)
+	go nd.serveLock(nd.adminHandlers, nd.done)
+	go nd.gossipIn(nd.gossipHandler, nd.done)
+	return nil
+}
+
+// stop shuts down the services of the node; the database is retained.
+// Closing done fails the requests the node holds back, so its downloads
+// end.
+func (nd *Node) stop() {
+	if nd.done == nil {
+		return
+	}
+	close(nd.done)
+	nd.done = nil
+	nd.peerManager.Close()
+	nd.gossipClient.Close()
+	nd.gossipHandler.Close()
+	nd.dm.Close()
+	nd.adminHandlers.Close()
+	nd.reqHandler.Exit()
+	nd.wg.Wait()
+	atomic.StoreInt32(&nd.synced, 0)
+}
+
+// serveLock hands the lock of the node to the admin handlers whenever they
+// request it until done is closed
+func (nd *Node) serveLock(ah *admin.Handlers, done <-chan struct{}) {
+	defer nd.wg.Done()
+	for {
+		select {
+		case <-ah.RequestLock:
+			select {
+			case ah.ReceiveLock <- &nd.lock:
+			case <-done:
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// gossipIn stores the gossip the node receives while it is in sync until
+// done is closed
+func (nd *Node) gossipIn(gh *gossip.Handlers, done <-chan struct{}) {
+	defer nd.wg.Done()
+	err := gh.UpdateStateFromGossip(done, &nd.lock, nd.safe)
+	if err != nil && err != errorz.ErrClosing {
+		nd.net.fail(fmt.Errorf("simulator: node %d: %v", nd.idx, err))
+	}
+}
+
+// safe returns true if the node may handle gossip
+func (nd *Node) safe() bool {
+	return atomic.LoadInt32(&nd.synced) == 1
+}
+
+// genesis stores the group key share of the node and the initial validator
+// set
+func (nd *Node) genesis(vs *objs.ValidatorSet) error {
+	if err := nd.adminHandlers.AddPrivateKey(nd.keys.bnPrivk, constants.CurveBN256Eth); err != nil {
+		return err
+	}
+	return nd.adminHandlers.AddValidatorSet(vs)
+}
+
+// Index returns the position of the node in the validator set
+func (nd *Node) Index() int {
+	return nd.idx
+}
+
+// VAddr returns the validator address of the node
+func (nd *Node) VAddr() []byte {
+	return utils.CopySlice(nd.keys.vAddr)
+}
+
+// Database returns the consensus database of the node
+func (nd *Node) Database() *db.Database {
+	return nd.database
+}
+
+// Application returns the application of the node
+func (nd *Node) Application() Application {
+	return nd.app
+}
+
+// AdminHandlers returns the admin handlers of the node, through which an
+// Ethereum monitor feeds it keys, validator sets and snapshots. They are
+// replaced when the node restarts.
+func (nd *Node) AdminHandlers() *admin.Handlers {
+	nd.net.RLock()
+	defer nd.net.RUnlock()
+	return nd.adminHandlers
+}
+
+// GossipHandlers returns the gossip handlers of the node, through which a
+// local RPC server submits transactions. They are replaced when the node
+// restarts.
+func (nd *Node) GossipHandlers() *gossip.Handlers {
+	nd.net.RLock()
+	defer nd.net.RUnlock()
+	return nd.gossipHandler
+}
+
+// PeerManager returns the peer manager of the node. It is replaced when the
+// node restarts.
+func (nd *Node) PeerManager() *peering.PeerManager {
+	nd.net.RLock()
+	defer nd.net.RUnlock()
+	return nd.peerManager
+}
+
+// Safe returns true while the node is in sync with the network, as the
+// synchronizer of a validator reports it
+func (nd *Node) Safe() bool {
+	return nd.safe()
+}
+
+// Crashed returns true if the node is currently crashed
+func (nd *Node) Crashed() bool {
+	nd.net.RLock()
+	defer nd.net.RUnlock()
+	return nd.crashed
+}
+
+// addr returns the address of the transport of the node
+func (nd *Node) addr() interfaces.NodeAddr {
+	nd.net.RLock()
+	defer nd.net.RUnlock()
+	return nd.transport.NodeAddr()
+}
+
+// Height returns the height of the last block committed by the node. It is
+// zero until the node has a validator set.
+func (nd *Node) Height() (uint32, error) {
+	var height uint32
+	err := nd.database.View(func(txn *badger.Txn) error {
+		os, err := nd.database.GetOwnState(txn)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		height = os.SyncToBH.BClaims.Height
+		return nil
+	})
+	return height, err
+}
+
+// BlockHash returns the hash of the block committed by the node at height
+func (nd *Node) BlockHash(height uint32) ([]byte, error) {
+	var hsh []byte
+	err := nd.database.View(func(txn *badger.Txn) error {
+		bh, err := nd.database.GetCommittedBlockHeader(txn, height)
+		if err != nil {
+			return err
+		}
+		hsh, err = bh.BlockHash()
+		return err
+	})
+	return hsh, err
+}
+
+// update runs a single iteration of the consensus engine. A node that is
+// not in sync synchronizes instead and takes in the block headers it was
+// gossiped, as the synchronizer of a validator does. Nodes without a
+// validator set wait.
+func (nd *Node) update() error {
+	nd.lock.Lock()
+	defer nd.lock.Unlock()
+	var initialized bool
+	err := nd.database.View(func(txn *badger.Txn) error {
+		_, err := nd.database.GetOwnState(txn)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		initialized = true
+		return nil
+	})
+	if err != nil || !initialized {
+		return err
+	}
+	var synced bool
+	if nd.safe() {
+		synced, err = nd.engine.UpdateLocalState()
+	} else {
+		synced, err = nd.engine.Sync()
+		if err == nil && !synced {
+			err = nd.gossipHandler.UpdateBlocksFromGossip()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if synced {
+		atomic.StoreInt32(&nd.synced, 1)
+	} else {
+		atomic.StoreInt32(&nd.synced, 0)
+	}
+	return nil
+}
+
+// settle waits until the services of the node have handed everything the
+// node wrote to its peers and every download of the node has either
+// finished or is held back until the next step
+func (nd *Node) settle() error {
+	end := time.Now().Add(settleTimeout)
+	for {
+		caught, err := nd.database.CaughtUp()
+		if err != nil {
+			return err
+		}
+		if caught && nd.dm.Downloads() == nd.net.parked(nd) {
+			return nil
+		}
+		if time.Now().After(end) {
+			return fmt.Errorf("simulator: services did not settle within %v", settleTimeout)
+		}
+		time.Sleep(50 * time.Microsecond)
+	}
+}
+
+// receive hands m, sent by the node at addr, to the P2P server srv of the
+// node and waits until it is handled. A node that is not in sync takes in
+// block headers as its synchronizer does.
+func (nd *Node) receive(srv interfaces.P2PServer, addr interfaces.NodeAddr, m *Message) error {
+	errC := make(chan error, 1)
+	go func() {
+		errC <- serve(srv, addr, m)
+	}()
+	ticker := time.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-errC:
+			if err != nil {
+				utils.DebugTrace(nd.net.logger, err)
+			}
+			return nil
+		case <-ticker.C:
+		}
+		if err := nd.net.err(); err != nil {
+			return err
+		}
+		if m.Kind == KindBlockHeader && !nd.safe() {
+			nd.lock.Lock()
+			err := nd.gossipHandler.UpdateBlocksFromGossip()
+			nd.lock.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// submit adds tx to the pending transactions of the node as the gossip
+// handlers do for a transaction they receive
+func (nd *Node) submit(tx []byte) error {
+	nd.lock.Lock()
+	defer nd.lock.Unlock()
+	return nd.database.Update(func(txn *badger.Txn) error {
+		os, err := nd.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		txi, err := nd.app.UnmarshalTx(utils.CopySlice(tx))
+		if err != nil {
+			return err
+		}
+		return nd.app.PendingTxAdd(txn, os.SyncToBH.BClaims.ChainID, os.SyncToBH.BClaims.Height+1, []interfaces.Transaction{txi})
+	})
+}
+
+// round returns the height and round the node is in. Both are zero until
+// the node has a validator set.
+func (nd *Node) round() (uint32, uint32, error) {
+	var height, round uint32
+	err := nd.database.View(func(txn *badger.Txn) error {
+		os, err := nd.database.GetOwnState(txn)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		rs, err := nd.database.GetCurrentRoundState(txn, os.VAddr)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		height = rs.RCert.RClaims.Height
+		round = rs.RCert.RClaims.Round
+		return nil
+	})
+	return height, round, err
+}
+
+// reGossip sends the messages of the current round of the node again
+func (nd *Node) reGossip() error {
+	if !nd.safe() {
+		return nil
+	}
+	return nd.gossipClient.ReGossip()
+}
@@ -0,0 +1,413 @@
+package simulator
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
+)
+
+// EventKind identifies what an Event records
+type EventKind int
+
+// The kinds of events recorded in the trace of a network
+const (
+	// EventDeliver is a message handed to its recipient
+	EventDeliver EventKind = iota + 1
+	// EventDrop is a message lost by its link or dropped because its
+	// recipient is unreachable or not in sync
+	EventDrop
+	// EventRound is a node entering a new round
+	EventRound
+	// EventPenalize is a node penalizing a peer for a message
+	EventPenalize
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventDeliver:
+		return "deliver"
+	case EventDrop:
+		return "drop"
+	case EventRound:
+		return "round"
+	case EventPenalize:
+		return "penalize"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is an entry of the trace of a network. Node is the node the event
+// happened at: the recipient of a message, the node entering a round or the
+// node penalizing a peer. From is the sender of the message or the peer
+// penalized.
+type Event struct {
+	Time    time.Duration
+	Kind    EventKind
+	Node    int
+	From    int
+	Message MessageKind
+	Hash    []byte
+	Height  uint32
+	Round   uint32
+}
+
+func (e Event) String() string {
+	switch e.Kind {
+	case EventRound:
+		return fmt.Sprintf("%v %v node %d height %d round %d", e.Time, e.Kind, e.Node, e.Height, e.Round)
+	case EventPenalize:
+		return fmt.Sprintf("%v %v node %d peer %d", e.Time, e.Kind, e.Node, e.From)
+	default:
+		return fmt.Sprintf("%v %v %v %d->%d %x", e.Time, e.Kind, e.Message, e.From, e.Node, e.Hash)
+	}
+}
+
+// item is a message in flight
+type item struct {
+	at   int64
+	late bool
+	seq  uint64
+	msg  *Message
+}
+
+// queue orders the messages in flight by arrival time. Reordered messages
+// come after the others that arrive at the same time and ties are broken by
+// the order the messages were sent in.
+type queue []*item
+
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	if q[i].late != q[j].late {
+		return !q[i].late
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q queue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(*item)) }
+
+func (q *queue) Pop() interface{} {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
+
+// sent identifies a message a node has sent to a peer or learned from it
+type sent struct {
+	from int
+	to   int
+	tx   bool
+	hsh  string
+}
+
+// record appends an event to the trace. The caller must hold the message
+// lock.
+func (n *Network) record(ev Event) {
+	if !n.cfg.Trace {
+		return
+	}
+	ev.Time = n.Elapsed()
+	n.trace = append(n.trace, ev)
+}
+
+// recordMessage appends an event about m to the trace. The caller must hold
+// the message lock.
+func (n *Network) recordMessage(kind EventKind, m *Message) {
+	if !n.cfg.Trace {
+		return
+	}
+	n.record(Event{Kind: kind, Node: m.To, From: m.From, Message: m.Kind, Hash: crypto.Hasher(m.Data)})
+}
+
+// Trace returns the events recorded since the network was created. Nothing
+// is recorded unless Config.Trace is set.
+func (n *Network) Trace() []Event {
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	return append([]Event{}, n.trace...)
+}
+
+// reachable returns true if node a can reach node b. The caller must hold
+// the lock.
+func (n *Network) reachable(a, b int) bool {
+	return a != b && !n.nodes[b].crashed && n.groups[a] == n.groups[b]
+}
+
+// gossipPeers returns the peers node from sends the message hsh to and
+// marks them as having it
+func (n *Network) gossipPeers(from int, tx bool, hsh []byte) []int {
+	n.RLock()
+	defer n.RUnlock()
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	now := n.Now().UnixNano()
+	out := []int{}
+	for to := range n.nodes {
+		if !n.reachable(from, to) {
+			continue
+		}
+		key := sent{from: from, to: to, tx: tx, hsh: string(hsh)}
+		if until, ok := n.sent[key]; ok && until > now {
+			continue
+		}
+		n.sent[key] = now + int64(constants.MsgTimeout*5)
+		out = append(out, to)
+	}
+	return out
+}
+
+// prevent marks peer to as having the message hsh so node from does not
+// send it
+func (n *Network) prevent(from, to int, tx bool, hsh []byte) {
+	if to == observer {
+		return
+	}
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	now := n.Now().UnixNano()
+	key := sent{from: from, to: to, tx: tx, hsh: string(hsh)}
+	if until, ok := n.sent[key]; ok && until > now {
+		return
+	}
+	n.sent[key] = now + int64(constants.MsgTimeout*5)
+}
+
+// forget drops the messages marked as known between the nodes for which
+// fn is true, as closing a connection does. The caller must hold the lock.
+func (n *Network) forget(fn func(a, b int) bool) {
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	for key := range n.sent {
+		if fn(key.from, key.to) {
+			delete(n.sent, key)
+		}
+	}
+}
+
+// expire drops the messages marked as known for longer than the message
+// queues of a peer manager keep them
+func (n *Network) expire() {
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	now := n.Now().UnixNano()
+	for key, until := range n.sent {
+		if until <= now {
+			delete(n.sent, key)
+		}
+	}
+}
+
+// penalize records that node idx penalized peer
+func (n *Network) penalize(idx, peer int, offense types.PeerOffense) {
+	n.logger.Debugf("simulator: node %d penalized node %d for offense %v", idx, peer, offense)
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	n.record(Event{Kind: EventPenalize, Node: idx, From: peer})
+}
+
+// post queues m to be sent by the step that caused it
+func (n *Network) post(m *Message) {
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	nd := n.nodes[m.From]
+	nd.outbox = append(nd.outbox, m)
+}
+
+// requestPeers returns the servers of the peers a request of node from
+// to peer is asked of, in turn
+func (n *Network) requestPeers(from, to int) []interfaces.P2PServer {
+	n.RLock()
+	defer n.RUnlock()
+	out := []interfaces.P2PServer{}
+	for i := 1; i < len(n.nodes); i++ {
+		p := (from + i) % len(n.nodes)
+		if to != anyPeer && p != to {
+			continue
+		}
+		if !n.reachable(from, p) {
+			continue
+		}
+		out = append(out, n.nodes[p].server)
+	}
+	return out
+}
+
+// park holds back a request of node idx that no peer answered at the
+// current virtual time until the next step or until the node stops. It
+// returns nil at once for any other request.
+func (n *Network) park(idx int, key string, done <-chan struct{}) error {
+	n.msgs.Lock()
+	nd := n.nodes[idx]
+	if at, ok := nd.unanswered[key]; !ok || at != n.Now().UnixNano() {
+		n.msgs.Unlock()
+		return nil
+	}
+	release := nd.release
+	nd.parked++
+	n.msgs.Unlock()
+	select {
+	case <-release:
+		return errorz.ErrBadResponse
+	case <-done:
+		return errorz.ErrClosing
+	}
+}
+
+// unanswered records that no peer answered a request of node idx at the
+// current virtual time
+func (n *Network) unanswered(idx int, key string) {
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	n.nodes[idx].unanswered[key] = n.Now().UnixNano()
+}
+
+// release fails the requests of node nd held back by park
+func (n *Network) release(nd *Node) {
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	close(nd.release)
+	nd.release = make(chan struct{})
+	nd.parked = 0
+	nd.unanswered = make(map[string]int64)
+}
+
+// parked returns the number of requests of node nd held back by park
+func (n *Network) parked(nd *Node) int {
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	return nd.parked
+}
+
+// flush sends the messages queued by node nd. They are sorted so the
+// faults drawn for them do not depend on the order the services of the
+// node queued them in.
+func (n *Network) flush(nd *Node) {
+	n.RLock()
+	fn := n.filters[nd.idx]
+	link := n.cfg.Link
+	n.RUnlock()
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	out := nd.outbox
+	nd.outbox = nil
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Kind != out[j].Kind {
+			return out[i].Kind < out[j].Kind
+		}
+		if out[i].To != out[j].To {
+			return out[i].To < out[j].To
+		}
+		return bytes.Compare(out[i].Data, out[j].Data) < 0
+	})
+	for _, m := range out {
+		if fn == nil || m.Kind == KindTransaction {
+			n.send(m, link)
+			continue
+		}
+		for _, f := range fn(&Message{From: m.From, To: m.To, Kind: m.Kind, Data: append([]byte{}, m.Data...)}) {
+			if f == nil || f.To != m.To {
+				continue
+			}
+			n.send(&Message{From: m.From, To: m.To, Kind: f.Kind, Data: f.Data}, link)
+		}
+	}
+}
+
+// send draws the faults of link for m and puts it in flight. The caller
+// must hold the message lock.
+func (n *Network) send(m *Message, link LinkConfig) {
+	if link.DropRate > 0 && n.rng.Float64() < link.DropRate {
+		n.recordMessage(EventDrop, m)
+		return
+	}
+	delay := link.Latency
+	if link.Jitter > 0 {
+		delay += time.Duration(n.rng.Int63n(int64(link.Jitter) + 1))
+	}
+	if link.DelayRate > 0 && n.rng.Float64() < link.DelayRate {
+		delay += link.Delay
+	}
+	late := link.ReorderRate > 0 && n.rng.Float64() < link.ReorderRate
+	n.seq++
+	heap.Push(&n.inflight, &item{at: n.Now().Add(delay).UnixNano(), late: late, seq: n.seq, msg: m})
+}
+
+// next removes the next message that has arrived by the current virtual
+// time from flight
+func (n *Network) next() *Message {
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	if len(n.inflight) == 0 || n.inflight[0].at > n.Now().UnixNano() {
+		return nil
+	}
+	return heap.Pop(&n.inflight).(*item).msg
+}
+
+// deliver hands every message that has arrived to its recipient in order.
+// The messages the recipients send in turn are delivered as well once they
+// arrive within the current step.
+func (n *Network) deliver() error {
+	for {
+		m := n.next()
+		if m == nil {
+			return nil
+		}
+		nd := n.nodes[m.To]
+		n.RLock()
+		ok := n.reachable(m.From, m.To)
+		srv := nd.server
+		n.RUnlock()
+		// a node that is not in sync only takes in block headers
+		if !ok || (!nd.safe() && m.Kind != KindBlockHeader) {
+			n.msgs.Lock()
+			n.recordMessage(EventDrop, m)
+			n.msgs.Unlock()
+			continue
+		}
+		n.msgs.Lock()
+		n.recordMessage(EventDeliver, m)
+		n.msgs.Unlock()
+		if err := nd.receive(srv, n.nodes[m.From].addr(), m); err != nil {
+			return fmt.Errorf("simulator: node %d: %v", nd.idx, err)
+		}
+		if err := n.advance(nd); err != nil {
+			return err
+		}
+	}
+}
+
+// advance waits for node nd to settle, sends the messages it queued and
+// records the round it is in
+func (n *Network) advance(nd *Node) error {
+	if err := nd.settle(); err != nil {
+		return fmt.Errorf("simulator: node %d: %v", nd.idx, err)
+	}
+	n.flush(nd)
+	height, round, err := nd.round()
+	if err != nil {
+		return fmt.Errorf("simulator: node %d: %v", nd.idx, err)
+	}
+	n.msgs.Lock()
+	defer n.msgs.Unlock()
+	if height != nd.height || round != nd.rnd {
+		nd.height = height
+		nd.rnd = round
+		n.record(Event{Kind: EventRound, Node: nd.idx, From: nd.idx, Height: height, Round: round})
+	}
+	return nil
+}
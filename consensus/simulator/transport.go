@@ -0,0 +1,399 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/types"
+	"github.com/MadBase/MadNet/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// MessageKind identifies the object carried by a Message
+type MessageKind int
+
+// The kinds of messages gossiped between nodes
+const (
+	KindProposal MessageKind = iota + 1
+	KindPreVote
+	KindPreVoteNil
+	KindPreCommit
+	KindPreCommitNil
+	KindNextRound
+	KindNextHeight
+	KindBlockHeader
+	KindTransaction
+)
+
+func (k MessageKind) String() string {
+	switch k {
+	case KindProposal:
+		return "Proposal"
+	case KindPreVote:
+		return "PreVote"
+	case KindPreVoteNil:
+		return "PreVoteNil"
+	case KindPreCommit:
+		return "PreCommit"
+	case KindPreCommitNil:
+		return "PreCommitNil"
+	case KindNextRound:
+		return "NextRound"
+	case KindNextHeight:
+		return "NextHeight"
+	case KindBlockHeader:
+		return "BlockHeader"
+	case KindTransaction:
+		return "Transaction"
+	default:
+		return "Unknown"
+	}
+}
+
+// Message is a message gossiped from one node to another. Data is the
+// canonical encoding of the object, exactly as it travels over gossip.
+type Message struct {
+	From int
+	To   int
+	Kind MessageKind
+	Data []byte
+}
+
+// Filter intercepts every consensus message a node gossips before it is
+// handed to the link to the recipient. It returns the messages that are
+// actually sent: nil to withhold the message, the message itself or altered
+// copies. Messages addressed to any node other than the recipient are
+// dropped. Transactions are not filtered. Filters are called by the step
+// that sends the message and are used to model byzantine validators.
+type Filter func(m *Message) []*Message
+
+// LinkConfig describes the links between nodes. Every fault is drawn from
+// the seed of the network for each message in the order the messages are
+// sent, and all delays are virtual time.
+type LinkConfig struct {
+	// Latency is the time every message takes to arrive
+	Latency time.Duration
+	// Jitter is the upper bound of a uniform delay added to the latency of
+	// each message. Messages sent at the same time arrive in a random
+	// order.
+	Jitter time.Duration
+	// DropRate is the probability that a message is lost
+	DropRate float64
+	// ReorderRate is the probability that a message is handled after the
+	// other messages that arrive at the same time
+	ReorderRate float64
+	// DelayRate is the probability that a message is held back by Delay on
+	// top of its latency
+	DelayRate float64
+	Delay     time.Duration
+}
+
+// anyPeer is the recipient of requests that may be answered by any peer
+const anyPeer = -2
+
+var _ interfaces.PeerSubscription = (*subscription)(nil)
+
+// subscription is the peer subscription of the services of a node. Gossip
+// is queued on the node to be sent over the links of the network by the
+// step that caused it. Like the message queues of a peer manager, a message
+// is sent to each peer at most once within five message timeouts, or not at
+// all once the peer sent it to the node. Requests are served by the
+// reachable peers in turn.
+type subscription struct {
+	net  *Network
+	idx  int
+	done <-chan struct{}
+}
+
+func (s *subscription) CloseChan() <-chan struct{} {
+	return s.done
+}
+
+func (s *subscription) lease() (interfaces.PeerLease, error) {
+	select {
+	case <-s.done:
+		return nil, errorz.ErrClosing
+	default:
+		return &client{net: s.net, from: s.idx, to: anyPeer, done: s.done}, nil
+	}
+}
+
+func (s *subscription) PeerLease(ctx context.Context) (interfaces.PeerLease, error) {
+	return s.lease()
+}
+
+func (s *subscription) RequestLease(ctx context.Context, hsh []byte) (interfaces.PeerLease, error) {
+	return s.lease()
+}
+
+func (s *subscription) PreventGossipTx(addr interfaces.NodeAddr, hsh []byte) {
+	s.net.prevent(s.idx, s.net.index(addr), true, hsh)
+}
+
+func (s *subscription) PreventGossipConsensus(addr interfaces.NodeAddr, hsh []byte) {
+	s.net.prevent(s.idx, s.net.index(addr), false, hsh)
+}
+
+func (s *subscription) gossip(tx bool, hsh []byte, fn func(context.Context, interfaces.PeerLease) error) {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	for _, to := range s.net.gossipPeers(s.idx, tx, hsh) {
+		if err := fn(context.Background(), &client{net: s.net, from: s.idx, to: to, done: s.done}); err != nil {
+			utils.DebugTrace(s.net.logger, err)
+		}
+	}
+}
+
+func (s *subscription) GossipConsensus(hsh []byte, fn func(context.Context, interfaces.PeerLease) error) {
+	s.gossip(false, hsh, fn)
+}
+
+func (s *subscription) GossipTx(hsh []byte, fn func(context.Context, interfaces.PeerLease) error) {
+	s.gossip(true, hsh, fn)
+}
+
+func (s *subscription) Penalize(addr interfaces.NodeAddr, offense types.PeerOffense) {
+	s.net.penalize(s.idx, s.net.index(addr), offense)
+}
+
+var _ interfaces.PeerLease = (*client)(nil)
+var _ interfaces.P2PClient = (*client)(nil)
+
+// client is the lease of a node on a peer. Gossip is queued on the sending
+// node. The requests of a lease on anyPeer are answered by the first
+// reachable peer that has the data.
+type client struct {
+	net  *Network
+	from int
+	to   int
+	done <-chan struct{}
+}
+
+func (c *client) P2PClient() (interfaces.P2PClient, error) {
+	return c, nil
+}
+
+func (c *client) Do(fn func(interfaces.PeerLease) error) {
+	if err := fn(c); err != nil {
+		utils.DebugTrace(c.net.logger, err)
+	}
+}
+
+func (c *client) ProtoVersion() types.ProtoVersion {
+	return constants.ProtoVersionMax
+}
+
+func (c *client) Capabilities() types.Capabilities {
+	return types.CompressionCapability | types.NodeRecordCapability
+}
+
+func (c *client) Close() error {
+	return nil
+}
+
+func (c *client) NodeAddr() interfaces.NodeAddr {
+	if c.to < 0 {
+		return nil
+	}
+	return c.net.nodes[c.to].addr()
+}
+
+func (c *client) CloseChan() <-chan struct{} {
+	return c.done
+}
+
+// post queues gossip of kind for the recipient of the lease
+func (c *client) post(kind MessageKind, data []byte) error {
+	if c.to < 0 {
+		return fmt.Errorf("simulator: gossip without a recipient")
+	}
+	c.net.post(&Message{From: c.from, To: c.to, Kind: kind, Data: utils.CopySlice(data)})
+	return nil
+}
+
+// request has fn ask the peers of the lease in turn until one answers. A
+// request that no peer answered is not tried again before the virtual clock
+// advances: a retry waits for the next step and fails.
+func (c *client) request(key string, fn func(context.Context, interfaces.P2PServer) error) error {
+	if err := c.net.park(c.from, key, c.done); err != nil {
+		return err
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: c.net.nodes[c.from].addr()})
+	for _, srv := range c.net.requestPeers(c.from, c.to) {
+		if err := fn(ctx, srv); err == nil {
+			return nil
+		}
+	}
+	c.net.unanswered(c.from, key)
+	return errorz.ErrBadResponse
+}
+
+func (c *client) Status(ctx context.Context, in *pb.StatusRequest, opts ...grpc.CallOption) (*pb.StatusResponse, error) {
+	return &pb.StatusResponse{}, nil
+}
+
+func (c *client) GetBlockHeaders(ctx context.Context, in *pb.GetBlockHeadersRequest, opts ...grpc.CallOption) (*pb.GetBlockHeadersResponse, error) {
+	var out *pb.GetBlockHeadersResponse
+	err := c.request(fmt.Sprintf("headers/%v", in.BlockNumbers), func(ctx context.Context, srv interfaces.P2PServer) error {
+		resp, err := srv.GetBlockHeaders(ctx, in)
+		if err != nil {
+			return err
+		}
+		if len(resp.BlockHeaders) != len(in.BlockNumbers) {
+			return errorz.ErrBadResponse
+		}
+		out = resp
+		return nil
+	})
+	return out, err
+}
+
+func (c *client) GetMinedTxs(ctx context.Context, in *pb.GetMinedTxsRequest, opts ...grpc.CallOption) (*pb.GetMinedTxsResponse, error) {
+	var out *pb.GetMinedTxsResponse
+	err := c.request(fmt.Sprintf("mined/%x", in.TxHashes), func(ctx context.Context, srv interfaces.P2PServer) error {
+		resp, err := srv.GetMinedTxs(ctx, in)
+		if err != nil {
+			return err
+		}
+		if len(resp.Txs) != len(in.TxHashes) {
+			return errorz.ErrBadResponse
+		}
+		out = resp
+		return nil
+	})
+	return out, err
+}
+
+func (c *client) GetPendingTxs(ctx context.Context, in *pb.GetPendingTxsRequest, opts ...grpc.CallOption) (*pb.GetPendingTxsResponse, error) {
+	var out *pb.GetPendingTxsResponse
+	err := c.request(fmt.Sprintf("pending/%x", in.TxHashes), func(ctx context.Context, srv interfaces.P2PServer) error {
+		resp, err := srv.GetPendingTxs(ctx, in)
+		if err != nil {
+			return err
+		}
+		if len(resp.Txs) != len(in.TxHashes) {
+			return errorz.ErrBadResponse
+		}
+		out = resp
+		return nil
+	})
+	return out, err
+}
+
+func (c *client) GetSnapShotNode(ctx context.Context, in *pb.GetSnapShotNodeRequest, opts ...grpc.CallOption) (*pb.GetSnapShotNodeResponse, error) {
+	var out *pb.GetSnapShotNodeResponse
+	err := c.request(fmt.Sprintf("node/%d/%x", in.Height, in.NodeHash), func(ctx context.Context, srv interfaces.P2PServer) error {
+		resp, err := srv.GetSnapShotNode(ctx, in)
+		if err != nil {
+			return err
+		}
+		out = resp
+		return nil
+	})
+	return out, err
+}
+
+func (c *client) GetSnapShotStateData(ctx context.Context, in *pb.GetSnapShotStateDataRequest, opts ...grpc.CallOption) (*pb.GetSnapShotStateDataResponse, error) {
+	var out *pb.GetSnapShotStateDataResponse
+	err := c.request(fmt.Sprintf("state/%x", in.Key), func(ctx context.Context, srv interfaces.P2PServer) error {
+		resp, err := srv.GetSnapShotStateData(ctx, in)
+		if err != nil {
+			return err
+		}
+		out = resp
+		return nil
+	})
+	return out, err
+}
+
+func (c *client) GetSnapShotHdrNode(ctx context.Context, in *pb.GetSnapShotHdrNodeRequest, opts ...grpc.CallOption) (*pb.GetSnapShotHdrNodeResponse, error) {
+	var out *pb.GetSnapShotHdrNodeResponse
+	err := c.request(fmt.Sprintf("hdrnode/%x", in.NodeHash), func(ctx context.Context, srv interfaces.P2PServer) error {
+		resp, err := srv.GetSnapShotHdrNode(ctx, in)
+		if err != nil {
+			return err
+		}
+		out = resp
+		return nil
+	})
+	return out, err
+}
+
+func (c *client) GetPeers(ctx context.Context, in *pb.GetPeersRequest, opts ...grpc.CallOption) (*pb.GetPeersResponse, error) {
+	return &pb.GetPeersResponse{}, nil
+}
+
+func (c *client) GossipTransaction(ctx context.Context, in *pb.GossipTransactionMessage, opts ...grpc.CallOption) (*pb.GossipTransactionAck, error) {
+	return &pb.GossipTransactionAck{}, c.post(KindTransaction, in.Transaction)
+}
+
+func (c *client) GossipProposal(ctx context.Context, in *pb.GossipProposalMessage, opts ...grpc.CallOption) (*pb.GossipProposalAck, error) {
+	return &pb.GossipProposalAck{}, c.post(KindProposal, in.Proposal)
+}
+
+func (c *client) GossipPreVote(ctx context.Context, in *pb.GossipPreVoteMessage, opts ...grpc.CallOption) (*pb.GossipPreVoteAck, error) {
+	return &pb.GossipPreVoteAck{}, c.post(KindPreVote, in.PreVote)
+}
+
+func (c *client) GossipPreVoteNil(ctx context.Context, in *pb.GossipPreVoteNilMessage, opts ...grpc.CallOption) (*pb.GossipPreVoteNilAck, error) {
+	return &pb.GossipPreVoteNilAck{}, c.post(KindPreVoteNil, in.PreVoteNil)
+}
+
+func (c *client) GossipPreCommit(ctx context.Context, in *pb.GossipPreCommitMessage, opts ...grpc.CallOption) (*pb.GossipPreCommitAck, error) {
+	return &pb.GossipPreCommitAck{}, c.post(KindPreCommit, in.PreCommit)
+}
+
+func (c *client) GossipPreCommitNil(ctx context.Context, in *pb.GossipPreCommitNilMessage, opts ...grpc.CallOption) (*pb.GossipPreCommitNilAck, error) {
+	return &pb.GossipPreCommitNilAck{}, c.post(KindPreCommitNil, in.PreCommitNil)
+}
+
+func (c *client) GossipNextRound(ctx context.Context, in *pb.GossipNextRoundMessage, opts ...grpc.CallOption) (*pb.GossipNextRoundAck, error) {
+	return &pb.GossipNextRoundAck{}, c.post(KindNextRound, in.NextRound)
+}
+
+func (c *client) GossipNextHeight(ctx context.Context, in *pb.GossipNextHeightMessage, opts ...grpc.CallOption) (*pb.GossipNextHeightAck, error) {
+	return &pb.GossipNextHeightAck{}, c.post(KindNextHeight, in.NextHeight)
+}
+
+func (c *client) GossipBlockHeader(ctx context.Context, in *pb.GossipBlockHeaderMessage, opts ...grpc.CallOption) (*pb.GossipBlockHeaderAck, error) {
+	return &pb.GossipBlockHeaderAck{}, c.post(KindBlockHeader, in.BlockHeader)
+}
+
+// serve hands m to the P2P server srv of its recipient as if it arrived
+// from the sender at addr
+func serve(srv interfaces.P2PServer, addr interfaces.NodeAddr, m *Message) error {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	var err error
+	switch m.Kind {
+	case KindProposal:
+		_, err = srv.GossipProposal(ctx, &pb.GossipProposalMessage{Proposal: m.Data})
+	case KindPreVote:
+		_, err = srv.GossipPreVote(ctx, &pb.GossipPreVoteMessage{PreVote: m.Data})
+	case KindPreVoteNil:
+		_, err = srv.GossipPreVoteNil(ctx, &pb.GossipPreVoteNilMessage{PreVoteNil: m.Data})
+	case KindPreCommit:
+		_, err = srv.GossipPreCommit(ctx, &pb.GossipPreCommitMessage{PreCommit: m.Data})
+	case KindPreCommitNil:
+		_, err = srv.GossipPreCommitNil(ctx, &pb.GossipPreCommitNilMessage{PreCommitNil: m.Data})
+	case KindNextRound:
+		_, err = srv.GossipNextRound(ctx, &pb.GossipNextRoundMessage{NextRound: m.Data})
+	case KindNextHeight:
+		_, err = srv.GossipNextHeight(ctx, &pb.GossipNextHeightMessage{NextHeight: m.Data})
+	case KindBlockHeader:
+		_, err = srv.GossipBlockHeader(ctx, &pb.GossipBlockHeaderMessage{BlockHeader: m.Data})
+	case KindTransaction:
+		_, err = srv.GossipTransaction(ctx, &pb.GossipTransactionMessage{Transaction: m.Data})
+	default:
+		err = fmt.Errorf("simulator: unknown message kind %d", m.Kind)
+	}
+	return err
+}
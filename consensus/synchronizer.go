@@ -521,6 +521,20 @@ func (s *Synchronizer) setupLoops() {
 	s.wg.Add(1)
 	go s.loop(evidenceLoopConfig)
 
+	participationLoopConfig := newLoopConfig().
+		withName("ParticipationLoop").
+		withFn(s.evidenceHandler.UpdateParticipation).
+		withFreq(61 * time.Second).
+		withDelayOnConditionFailure(31 * time.Second).
+		withLockFreeCondition(s.isNotClosing).
+		withLockFreeCondition(s.initialized.isSet).
+		withLockFreeCondition(s.ethSyncDone.isSet).
+		withLockFreeCondition(s.madSyncDone.isSet).
+		withLock().
+		withLockedCondition(s.isNotClosing)
+	s.wg.Add(1)
+	go s.loop(participationLoopConfig)
+
 	cdbgcLoopConfig := newLoopConfig().
 		withName("CDB-GCLoop").
 		withFn(s.cdb.GarbageCollect).
@@ -17,6 +17,70 @@ const (
 	DownloadTO              = ProposalStepTO + PreVoteStepTO + PreCommitStepTO
 )
 
+// Health check params
+const (
+	// HealthMaxBlockAge is the time after which a synchronized node that has
+	// not committed a block is reported as unhealthy
+	HealthMaxBlockAge = 5 * DBRNRTO
+)
+
+// Ethereum endpoint params
+const (
+	// EthereumHealthCheckInterval is the time between checks of the head
+	// height and peer count of every configured Ethereum endpoint
+	EthereumHealthCheckInterval = 5 * time.Second
+)
+
+// Monitor params
+const (
+	// MonitorReorgDepth is the number of blocks below the finalized height
+	// the monitor keeps hashes of to detect chain reorganizations
+	MonitorReorgDepth = 64
+)
+
+// ETHDKG params
+const (
+	// ETHDKGMinParticipants is the fewest registered participants a round of
+	// ETHDKG can produce a usable group key with
+	ETHDKGMinParticipants = 4
+	// ETHDKGRestartBlocks is how many blocks the monitor waits after a failed
+	// round of ETHDKG before asking the contract for another one. The wait
+	// doubles with each consecutive failure.
+	ETHDKGRestartBlocks = 10
+	// ETHDKGRestartMaxBlocks caps the wait between restarts of ETHDKG
+	ETHDKGRestartMaxBlocks = 1000
+)
+
+// Ethereum transaction params
+const (
+	// TxnCheckInterval is the time between checks of the transactions sent
+	// but not yet mined
+	TxnCheckInterval = 5 * time.Second
+	// TxnReplacementBlocks is the number of blocks a transaction may wait
+	// to be mined before it is sent again with a higher gas price
+	TxnReplacementBlocks = 6
+	// TxnReplacementBump is the percentage the gas price of a replacement
+	// is raised by. Nodes require at least 10 percent.
+	TxnReplacementBump = 25
+)
+
+// Consensus trace params
+const (
+	// TraceRingSize is the number of state transition trace events retained
+	// by the consensus engine before the oldest are overwritten
+	TraceRingSize uint64 = 8192
+)
+
+// Light client params
+const (
+	// LightClientBatchSize is the number of block headers requested from a
+	// peer at once by the light client
+	LightClientBatchSize = 64
+	// LightClientSyncInterval is the time the light client waits for new
+	// block headers once it has caught up with its peers
+	LightClientSyncInterval = MsgTimeout
+)
+
 // AdminHandlerKid returns a constant byte slice to be used as Key ID
 func AdminHandlerKid() []byte {
 	return []byte("constant")
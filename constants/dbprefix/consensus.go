@@ -129,3 +129,23 @@ func PrefixRawStorageKey() []byte {
 func PrefixStorageNodeKey() []byte {
 	return []byte("a5")
 }
+
+func PrefixParticipation() []byte {
+	return []byte("a6")
+}
+
+func PrefixTraceEvent() []byte {
+	return []byte("a7")
+}
+
+func PrefixTraceHead() []byte {
+	return []byte("a8")
+}
+
+func PrefixSnapshotAlert() []byte {
+	return []byte("a9")
+}
+
+func PrefixPeerAddrBook() []byte {
+	return []byte("ba")
+}
@@ -64,17 +64,20 @@ const (
 
 // Logger names
 const (
-	LoggerConsensus = "consensus"
-	LoggerTransport = "transport"
-	LoggerApp       = "app"
-	LoggerDB        = "db"
-	LoggerGossipBus = "gossipbus"
-	LoggerBadger    = "badger"
-	LoggerPeerMan   = "peerMan"
-	LoggerLocalRPC  = "localRPC"
-	LoggerDMan      = "dman"
-	LoggerPeer      = "peer"
-	LoggerYamux     = "yamux"
+	LoggerConsensus   = "consensus"
+	LoggerTransport   = "transport"
+	LoggerApp         = "app"
+	LoggerDB          = "db"
+	LoggerGossipBus   = "gossipbus"
+	LoggerBadger      = "badger"
+	LoggerPeerMan     = "peerMan"
+	LoggerLocalRPC    = "localRPC"
+	LoggerDMan        = "dman"
+	LoggerPeer        = "peer"
+	LoggerYamux       = "yamux"
+	LoggerSimulator   = "simulator"
+	LoggerLightClient = "lightclient"
+	LoggerDynamics    = "dynamics"
 )
 
 // Badger VLog GC ratio
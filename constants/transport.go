@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 // GRPC Server Configuration Params
 // Setup to provide backpressure
 const (
@@ -12,4 +14,76 @@ const (
 	ReadBufferSize       = 0
 	P2PStreamWorkers     = 4
 	DiscoStreamWorkers   = 1
+	// MsgQYieldDelay is how often transaction gossip to a peer checks if
+	// consensus messages to the peer are still waiting
+	MsgQYieldDelay = 10 * time.Millisecond
+)
+
+// Peer reputation
+// Scores start at zero and decay back towards zero with a half life of
+// PeerScoreHalfLife. A peer whose score falls to PeerBanScore is
+// disconnected and banned for PeerBanDuration.
+const (
+	PeerPenaltyInvalidMsg   = 25
+	PeerPenaltyOversizedMsg = 50
+	PeerPenaltyUnresponsive = 5
+	PeerPenaltyHandshake    = 10
+	PeerRewardResponse      = 1
+	PeerScoreMax            = 50
+	PeerBanScore            = -100
+	PeerScoreHalfLife       = 10 * time.Minute
+	PeerBanDuration         = time.Hour
+)
+
+// Peer address book
+// Peers the node has connected to are remembered across restarts. A peer is
+// forgotten once it has not been seen for PeerAddrBookMaxAge or once its
+// failed dials outnumber its successful ones by PeerAddrBookMaxFailures.
+const (
+	PeerAddrBookMaxAge      = 7 * 24 * time.Hour
+	PeerAddrBookMaxFailures = 10
+	PeerAddrBookPruneFreq   = 10 * time.Minute
+)
+
+// Kademlia DHT
+// Each bucket of the routing table holds DHTBucketSize peers and lookups
+// query DHTAlpha peers at a time. A peer is dropped from the routing table
+// after DHTMaxFailures failed queries in a row.
+const (
+	DHTBucketSize   = 16
+	DHTAlpha        = 3
+	DHTMaxFailures  = 2
+	DHTQueryTimeout = 5 * time.Second
+	DHTLookupFreq   = 30 * time.Second
+)
+
+// Node records
+// The latest node record of up to NodeRecordCacheSize peers is kept to
+// reject stale records and to hand the records on to other peers. Records
+// signed more than NodeRecordMaxAge ago are rejected whether or not a newer
+// one was seen and a node signs its record again every NodeRecordRefresh.
+const (
+	NodeRecordCacheSize = 4096
+	NodeRecordMaxAge    = 24 * time.Hour
+	NodeRecordRefresh   = time.Hour
+)
+
+// Protocol versions
+// The node speaks the protocol versions from ProtoVersionMin to
+// ProtoVersionMax and connections use the highest version both peers
+// speak. A new wire version is rolled out by raising ProtoVersionMax and the
+// old one is retired by raising ProtoVersionMin once no peer needs it.
+const (
+	ProtoVersionMin = 1
+	ProtoVersionMax = 1
+)
+
+// P2P message compression
+// On connections where both peers support compression, messages of at least
+// CompressionThreshold bytes are snappy compressed. A compressed message may
+// not decode to more than CompressionMaxDecodedLen bytes, which is the
+// largest message gRPC accepts by default.
+const (
+	CompressionThreshold     = 1024
+	CompressionMaxDecodedLen = 4 * 1024 * 1024
 )
@@ -10,19 +10,33 @@ import (
 
 // Secp256k1Signer creates cryptographic signatures using the secp256k1 curve.
 type Secp256k1Signer struct {
-	privk *ecdsa.PrivateKey
-	pubk  []byte
+	privk    *ecdsa.PrivateKey
+	pubk     []byte
+	external func(digestHash []byte) ([]byte, error)
 }
 
 // Pubkey returns the marshalled public key of the Secp256k1Signer
 // (uncompressed format).
 func (secps *Secp256k1Signer) Pubkey() ([]byte, error) {
-	if secps.privk != nil {
+	if secps.privk != nil || secps.external != nil {
 		return utils.CopySlice(secps.pubk), nil
 	}
 	return nil, ErrPrivkNotSet
 }
 
+// SetExternal makes the Secp256k1Signer sign through an external signer
+// holding the private key for pubk; sign is given the *hash of the
+// message* and must return a signature in the format of eth.Sign.
+func (secps *Secp256k1Signer) SetExternal(pubk []byte, sign func(digestHash []byte) ([]byte, error)) error {
+	if _, err := eth.UnmarshalPubkey(pubk); err != nil {
+		return err
+	}
+	secps.privk = nil
+	secps.pubk = utils.CopySlice(pubk)
+	secps.external = sign
+	return nil
+}
+
 // SetPrivk sets the private key of the Secp256k1Signer;
 // privk is required to be 32 bytes!
 func (secps *Secp256k1Signer) SetPrivk(privk []byte) error {
@@ -31,6 +45,7 @@ func (secps *Secp256k1Signer) SetPrivk(privk []byte) error {
 		return err
 	}
 	secps.privk = ecprivk
+	secps.external = nil
 	pubk := eth.FromECDSAPub(&ecprivk.PublicKey)
 	secps.pubk = pubk
 	return nil
@@ -40,10 +55,13 @@ func (secps *Secp256k1Signer) SetPrivk(privk []byte) error {
 // Secp256k1Signer; eth.Sign *assumes* we are signing the
 // *hash of the message* (digestHash) and *not* the message itself.
 func (secps *Secp256k1Signer) Sign(msg []byte) ([]byte, error) {
+	digestHash := Hasher(msg)
+	if secps.external != nil {
+		return secps.external(digestHash)
+	}
 	if secps.privk == nil {
 		return nil, ErrPrivkNotSet
 	}
-	digestHash := Hasher(msg)
 	return eth.Sign(digestHash, secps.privk)
 }
 
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"testing"
+
+	eth "github.com/ethereum/go-ethereum/crypto"
 )
 
 func TestSecpPrivkPubkey(t *testing.T) {
@@ -153,3 +155,53 @@ func TestSecpValidate(t *testing.T) {
 		t.Fatal("Should not have correct public key for invalid signature!")
 	}
 }
+
+func TestSecpExternal(t *testing.T) {
+	privk := make([]byte, 32)
+	privk[0] = 1
+	privk[31] = 1
+	local := new(Secp256k1Signer)
+	err := local.SetPrivk(privk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkTrue, err := local.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecprivk, err := eth.ToECDSA(privk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := new(Secp256k1Signer)
+	err = s.SetExternal([]byte{4, 1, 2}, nil)
+	if err == nil {
+		t.Fatal("Error should be raised for invalid public key!")
+	}
+	err = s.SetExternal(pubkTrue, func(digestHash []byte) ([]byte, error) {
+		return eth.Sign(digestHash, ecprivk)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubk, err := s.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pubk, pubkTrue) {
+		t.Fatal("pubks do not match!")
+	}
+	msg := []byte("A message to sign")
+	sig, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := new(Secp256k1Validator)
+	pubk, err = v.Validate(msg, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pubk, pubkTrue) {
+		t.Fatal("pubks do not match!")
+	}
+}
@@ -3,6 +3,7 @@ package dynamics
 import (
 	"sync"
 
+	"github.com/dgraph-io/badger/v2"
 	"github.com/sirupsen/logrus"
 )
 
@@ -13,6 +14,12 @@ type Database struct {
 	logger *logrus.Logger
 }
 
+// Init initializes the Database on top of a badger database
+func (db *Database) Init(rawDB *badger.DB, logger *logrus.Logger) {
+	db.rawDB = &badgerRawDB{db: rawDB}
+	db.logger = logger
+}
+
 // SetNode stores Node in the database
 func (db *Database) SetNode(node *Node) error {
 	if !node.IsValid() {
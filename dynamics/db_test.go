@@ -2,8 +2,10 @@ package dynamics
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
+	"github.com/dgraph-io/badger/v2"
 	"github.com/sirupsen/logrus"
 )
 
@@ -150,3 +152,37 @@ func TestGetSetLinkedList(t *testing.T) {
 		t.Fatal("LinkedLists do not match")
 	}
 }
+
+func TestBadgerRawDB(t *testing.T) {
+	rawDB, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLogger(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rawDB.Close()
+
+	db := &Database{}
+	db.Init(rawDB, newLogger())
+
+	_, err = db.GetLinkedList()
+	if !errors.Is(err, ErrKeyNotPresent) {
+		t.Fatal("Should have raised ErrKeyNotPresent")
+	}
+
+	s := &Storage{}
+	err = s.Init(db, newLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Start()
+	err = s.UpdateStorage("maxBytes", "1234", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.LoadStorage(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetMaxBytes() != 1234 {
+		t.Fatal("maxBytes not updated")
+	}
+}
@@ -1,6 +1,42 @@
 package dynamics
 
+import (
+	"errors"
+
+	"github.com/dgraph-io/badger/v2"
+)
+
 type rawDataBase interface {
 	GetValue(key []byte) ([]byte, error)
 	SetValue(key []byte, value []byte) error
 }
+
+// badgerRawDB stores values in a badger database
+type badgerRawDB struct {
+	db *badger.DB
+}
+
+func (b *badgerRawDB) GetValue(key []byte) ([]byte, error) {
+	var value []byte
+	err := b.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, ErrKeyNotPresent
+	}
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (b *badgerRawDB) SetValue(key []byte, value []byte) error {
+	return b.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, value)
+	})
+}
@@ -34,3 +34,18 @@ func (e *ErrStale) Error() string {
 func (e ErrStale) New(msg string) *ErrStale {
 	return &ErrStale{msg}
 }
+
+// ErrLocalState is raised when an object can not be validated because of the
+// state of the local node, such as a missing or outdated validator set, rather
+// than a fault of the object itself
+type ErrLocalState struct {
+	msg string
+}
+
+func (e *ErrLocalState) Error() string {
+	return "the object could not be validated against local state:" + e.msg
+}
+
+func (e ErrLocalState) New(msg string) *ErrLocalState {
+	return &ErrLocalState{msg}
+}
@@ -0,0 +1,685 @@
+// Package integration runs complete validators against a simulated Ethereum
+// chain in a single process. The contracts are deployed with DeployContracts
+// and every validator runs the Ethereum monitor, the ETHDKG tasks, the
+// consensus services, the application and the local RPC server it runs in
+// production. The nodes of package simulator gossip with each other over an
+// in memory transport. Transactions are submitted and state is read through
+// the local RPC server of each validator, which listens on a loopback port.
+//
+// Blocks of the simulated chain are mined at a fixed interval of wall time
+// while consensus is stepped as fast as the nodes allow on the virtual clock
+// of the simulator.
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/application"
+	"github.com/MadBase/MadNet/application/deposit"
+	aobjs "github.com/MadBase/MadNet/application/objs"
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/blockchain"
+	"github.com/MadBase/MadNet/blockchain/monitor"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/simulator"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/localrpc"
+	"github.com/MadBase/MadNet/logging"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/rbus"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+)
+
+// ErrTimeout is returned when the network does not reach a state in time
+var ErrTimeout = errors.New("integration: timed out")
+
+// ethTimeout is the wall time a validator waits for a transaction to be
+// mined. Consensus competes with the simulated chain for the CPU, so it spans
+// many blocks.
+const ethTimeout = 10 * time.Second
+
+// Config is the configuration of a harness
+type Config struct {
+	// Keystore and Passcodes hold the keys of every account
+	Keystore  string
+	Passcodes string
+	// Owner deploys the contracts and holds the initial token supply
+	Owner string
+	// Validators are the accounts of the validators
+	Validators []string
+	// ChainID is the chain id of the validators
+	ChainID uint32
+	// BlockInterval is the wall time between blocks of the simulated chain
+	BlockInterval time.Duration
+	// Tick is the virtual time that passes in every step of consensus
+	Tick time.Duration
+}
+
+// Validator is a single validator of the harness
+type Validator struct {
+	Account  accounts.Account
+	Eth      blockchain.Ethereum
+	Node     *simulator.Node
+	App      *application.Application
+	Services *monitor.Services
+	// RPC is the client of the local RPC server of the validator
+	RPC *localrpc.Client
+
+	dph       *deposit.Handler
+	txnDB     *badger.DB
+	monDB     *badger.DB
+	bus       monitor.Bus
+	cancel    chan<- bool
+	rpc       *localrpc.Handlers
+	rpcServer *localrpc.Handler
+}
+
+// Harness is a network of validators on a simulated Ethereum chain
+type Harness struct {
+	cfg    Config
+	logger *logrus.Logger
+
+	eth   blockchain.Ethereum
+	owner accounts.Account
+
+	net        *simulator.Network
+	validators []*Validator
+
+	lock      sync.Mutex
+	stepErr   error
+	closeChan chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// New deploys the contracts onto a new simulated chain, has every validator
+// join the validator set and starts the validators. Call StartETHDKG to
+// give them their keys.
+func New(cfg Config) (*Harness, error) {
+	if len(cfg.Validators) < 1 {
+		return nil, fmt.Errorf("integration: invalid number of validators %d", len(cfg.Validators))
+	}
+	if cfg.BlockInterval <= 0 {
+		return nil, fmt.Errorf("integration: invalid block interval %v", cfg.BlockInterval)
+	}
+	if cfg.Tick <= 0 {
+		return nil, fmt.Errorf("integration: invalid tick %v", cfg.Tick)
+	}
+	eth, err := blockchain.NewEthereumSimulator(
+		cfg.Keystore,
+		cfg.Passcodes,
+		int(ethTimeout/cfg.BlockInterval),
+		cfg.BlockInterval,
+		0,
+		big.NewInt(9223372036854775807),
+		append([]string{cfg.Owner}, cfg.Validators...)...)
+	if err != nil {
+		return nil, err
+	}
+	h := &Harness{
+		cfg:       cfg,
+		logger:    logging.GetLogger(constants.LoggerSimulator),
+		eth:       eth,
+		owner:     eth.GetDefaultAccount(),
+		closeChan: make(chan struct{}),
+	}
+	h.wg.Add(1)
+	go h.mine()
+
+	if err := h.deploy(); err != nil {
+		h.Close()
+		return nil, err
+	}
+	if err := h.start(); err != nil {
+		h.Close()
+		return nil, err
+	}
+	return h, nil
+}
+
+// Close stops the validators and the simulated chain
+func (h *Harness) Close() {
+	h.closeOnce.Do(func() {
+		close(h.closeChan)
+		for _, v := range h.validators {
+			if v.cancel != nil {
+				v.cancel <- true
+			}
+			if v.bus != nil {
+				v.bus.StopLoop()
+			}
+			if v.RPC != nil {
+				v.RPC.Close()
+			}
+			if v.rpcServer != nil {
+				v.rpcServer.Close()
+			}
+			if v.rpc != nil {
+				v.rpc.Stop()
+			}
+		}
+		h.wg.Wait()
+		if h.net != nil {
+			h.net.Close()
+		}
+		for _, v := range h.validators {
+			if v.txnDB != nil {
+				v.txnDB.Close()
+			}
+			if v.monDB != nil {
+				v.monDB.Close()
+			}
+		}
+		h.eth.Close()
+	})
+}
+
+// Eth returns the simulated chain as seen by the owner of the contracts
+func (h *Harness) Eth() blockchain.Ethereum {
+	return h.eth
+}
+
+// Owner returns the account that deployed the contracts
+func (h *Harness) Owner() accounts.Account {
+	return h.owner
+}
+
+// Network returns the network the validators run consensus over
+func (h *Harness) Network() *simulator.Network {
+	return h.net
+}
+
+// Validator returns validator i
+func (h *Harness) Validator(i int) *Validator {
+	return h.validators[i]
+}
+
+// Validators returns every validator
+func (h *Harness) Validators() []*Validator {
+	return append([]*Validator{}, h.validators...)
+}
+
+// mine commits a block of the simulated chain every block interval
+func (h *Harness) mine() {
+	defer h.wg.Done()
+	for {
+		select {
+		case <-h.closeChan:
+			return
+		case <-time.After(h.cfg.BlockInterval):
+			h.eth.Commit()
+		}
+	}
+}
+
+// step runs consensus until the harness is closed or a step fails
+func (h *Harness) step() {
+	defer h.wg.Done()
+	for {
+		select {
+		case <-h.closeChan:
+			return
+		default:
+		}
+		if err := h.net.Step(); err != nil {
+			h.lock.Lock()
+			h.stepErr = err
+			h.lock.Unlock()
+			utils.DebugTrace(h.logger, err)
+			return
+		}
+		// leave time to the monitors and Ethereum
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// err returns the error that stopped consensus, if any
+func (h *Harness) err() error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.stepErr
+}
+
+// deploy deploys the contracts and has every validator stake and join the
+// validator set
+func (h *Harness) deploy() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := h.eth.UnlockAccount(h.owner); err != nil {
+		return err
+	}
+	if _, _, err := h.eth.Contracts().DeployContracts(ctx, h.owner); err != nil {
+		return err
+	}
+	for _, addr := range h.cfg.Validators {
+		acct, err := h.eth.GetAccount(common.HexToAddress(addr))
+		if err != nil {
+			return err
+		}
+		if err := h.eth.UnlockAccount(acct); err != nil {
+			return err
+		}
+		if err := h.join(ctx, acct); err != nil {
+			return fmt.Errorf("integration: %v could not join: %v", addr, err)
+		}
+	}
+	return nil
+}
+
+// join stakes tokens of the owner for acct and adds it to the validators
+func (h *Harness) join(ctx context.Context, acct accounts.Account) error {
+	c := h.eth.Contracts()
+	stake := big.NewInt(1000000)
+	ownerTxnOpts, err := h.eth.GetTransactionOpts(ctx, h.owner)
+	if err != nil {
+		return err
+	}
+	txnOpts, err := h.eth.GetTransactionOpts(ctx, acct)
+	if err != nil {
+		return err
+	}
+	txn, err := c.StakingToken.Transfer(ownerTxnOpts, acct.Address, stake)
+	if err := h.wait(ctx, txn, err); err != nil {
+		return err
+	}
+	txn, err = c.StakingToken.Approve(txnOpts, c.ValidatorsAddress, stake)
+	if err := h.wait(ctx, txn, err); err != nil {
+		return err
+	}
+	txn, err = c.Staking.LockStake(txnOpts, stake)
+	if err := h.wait(ctx, txn, err); err != nil {
+		return err
+	}
+	txn, err = c.Validators.AddValidator(txnOpts, acct.Address, [2]*big.Int{big.NewInt(1), big.NewInt(2)})
+	return h.wait(ctx, txn, err)
+}
+
+// wait waits for txn to be mined and checks it succeeded. err is the error
+// of sending txn.
+func (h *Harness) wait(ctx context.Context, txn *types.Transaction, err error) error {
+	if err != nil {
+		return err
+	}
+	rcpt, err := h.eth.WaitForReceipt(ctx, txn)
+	if err != nil {
+		return err
+	}
+	if rcpt == nil || rcpt.Status != 1 {
+		return fmt.Errorf("integration: transaction %v failed", txn.Hash().Hex())
+	}
+	return nil
+}
+
+// start builds the network of validators and starts the monitor, consensus
+// and local RPC server of each
+func (h *Harness) start() error {
+	keys := [][]byte{}
+	for _, addr := range h.cfg.Validators {
+		acct, err := h.eth.GetAccount(common.HexToAddress(addr))
+		if err != nil {
+			return err
+		}
+		key, err := h.eth.GetAccountKeys(acct.Address)
+		if err != nil {
+			return err
+		}
+		h.validators = append(h.validators, &Validator{
+			Account: acct,
+			Eth:     h.eth.Clone(acct),
+			dph:     &deposit.Handler{},
+		})
+		keys = append(keys, ethcrypto.FromECDSA(key.PrivateKey))
+	}
+
+	cfg := simulator.DefaultConfig()
+	cfg.Tick = h.cfg.Tick
+	cfg.Validators = len(h.validators)
+	cfg.ChainID = h.cfg.ChainID
+	cfg.Keys = keys
+	cfg.NoGenesis = true
+	cfg.Application = h.newApplication
+	net, err := simulator.New(cfg)
+	if err != nil {
+		return err
+	}
+	h.net = net
+
+	for i, v := range h.validators {
+		v.Node = net.Node(i)
+		monDB, err := openInMemory()
+		if err != nil {
+			return err
+		}
+		v.monDB = monDB
+		v.Services = monitor.NewServices(v.Eth, v.Node.Database(), v.dph, v.Node.AdminHandlers(), nil, 100, h.cfg.ChainID, false)
+		v.bus, err = monitor.NewBus(rbus.NewRBus(), v.Services)
+		if err != nil {
+			return err
+		}
+		if _, err := v.bus.StartLoop(); err != nil {
+			return err
+		}
+		mon, err := monitor.NewMonitor(monitor.NewDatabaseFromExisting(monDB), v.bus, h.cfg.BlockInterval, time.Minute)
+		if err != nil {
+			return err
+		}
+		v.cancel, err = mon.StartEventLoop()
+		if err != nil {
+			return err
+		}
+		if err := h.serve(v, keys[i]); err != nil {
+			return err
+		}
+	}
+
+	h.wg.Add(1)
+	go h.step()
+	return nil
+}
+
+// serve starts the local RPC server of validator v, whose Ethereum account
+// has the private key privk, and connects its client
+func (h *Harness) serve(v *Validator, privk []byte) error {
+	signer := &crypto.Secp256k1Signer{}
+	if err := signer.SetPrivk(privk); err != nil {
+		return err
+	}
+	pubk, err := signer.Pubkey()
+	if err != nil {
+		return err
+	}
+	v.rpc = &localrpc.Handlers{}
+	if err := v.rpc.Init(v.Node.Database(), v.App, v.Node.GossipHandlers(), pubk, v.Node.Safe, v.Services, v.Node.PeerManager()); err != nil {
+		return err
+	}
+	go v.rpc.Start()
+
+	dispatch := pb.NewLocalStateDispatch()
+	dispatch.RegisterLocalStateGetBlockNumber(v.rpc)
+	dispatch.RegisterLocalStateGetEpochNumber(v.rpc)
+	dispatch.RegisterLocalStateGetBlockHeader(v.rpc)
+	dispatch.RegisterLocalStateGetBlockHeaderProof(v.rpc)
+	dispatch.RegisterLocalStateGetChainID(v.rpc)
+	dispatch.RegisterLocalStateSendTransaction(v.rpc)
+	dispatch.RegisterLocalStateGetValueForOwner(v.rpc)
+	dispatch.RegisterLocalStateGetUTXO(v.rpc)
+	dispatch.RegisterLocalStateGetMinedTransaction(v.rpc)
+	dispatch.RegisterLocalStateGetPendingTransaction(v.rpc)
+	dispatch.RegisterLocalStateGetRoundStateForValidator(v.rpc)
+	dispatch.RegisterLocalStateGetValidatorSet(v.rpc)
+	dispatch.RegisterLocalStateIterateNameSpace(v.rpc)
+	dispatch.RegisterLocalStateGetData(v.rpc)
+	dispatch.RegisterLocalStateGetTxBlockNumber(v.rpc)
+	dispatch.RegisterLocalStateGetValidatorParticipation(v.rpc)
+	dispatch.RegisterLocalStateGetConsensusTrace(v.rpc)
+	dispatch.RegisterLocalStateGetEthDKGStatus(v.rpc)
+	dispatch.RegisterLocalStateGetSnapshotAlerts(v.rpc)
+	dispatch.RegisterLocalStateClearSnapshotAlert(v.rpc)
+	dispatch.RegisterLocalStateGetKnownPeers(v.rpc)
+	dispatch.RegisterLocalStateGetActivePeers(v.rpc)
+	dispatch.RegisterLocalStateDisconnectPeer(v.rpc)
+	dispatch.RegisterLocalStateDialPeer(v.rpc)
+	v.rpcServer, err = localrpc.NewStateServerHandler(
+		logging.GetLogger(constants.LoggerTransport),
+		"127.0.0.1:0",
+		pb.NewGeneratedLocalStateServer(dispatch),
+	)
+	if err != nil {
+		return err
+	}
+	go v.rpcServer.Serve()
+
+	// the ping of the client is refused until the node is in sync, but the
+	// connection is kept
+	client := &localrpc.Client{Address: v.rpcServer.Addr().String()}
+	err = client.Connect(context.Background())
+	if _, ok := status.FromError(err); !ok {
+		return err
+	}
+	v.RPC = client
+	return nil
+}
+
+// newApplication creates the application of validator idx over the
+// consensus database of its node
+func (h *Harness) newApplication(idx int, database *db.Database) (simulator.Application, error) {
+	v := h.validators[idx]
+	txnDB, err := openInMemory()
+	if err != nil {
+		return nil, err
+	}
+	v.txnDB = txnDB
+	if err := v.dph.Init(); err != nil {
+		return nil, err
+	}
+	v.App = &application.Application{}
+	if err := v.App.Init(database, txnDB, v.dph); err != nil {
+		return nil, err
+	}
+	return v.App, nil
+}
+
+// openInMemory opens an in memory database
+func openInMemory() (*badger.DB, error) {
+	opts := badger.DefaultOptions("").WithInMemory(true)
+	opts.Logger = nil
+	return badger.Open(opts)
+}
+
+// StartETHDKG has the owner open a round of ETHDKG. The monitors of the
+// validators take part and hand the resulting keys and validator set to
+// consensus.
+func (h *Harness) StartETHDKG() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	txnOpts, err := h.eth.GetTransactionOpts(ctx, h.owner)
+	if err != nil {
+		return err
+	}
+	txn, err := h.eth.Contracts().Ethdkg.InitializeState(txnOpts)
+	return h.wait(ctx, txn, err)
+}
+
+// WaitFor polls cond until it returns true, consensus fails or timeout
+// passes
+func (h *Harness) WaitFor(timeout time.Duration, cond func() (bool, error)) error {
+	end := time.Now().Add(timeout)
+	for {
+		if err := h.err(); err != nil {
+			return err
+		}
+		ok, err := cond()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(end) {
+			return ErrTimeout
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// WaitForHeight waits until the local RPC server of every validator serves
+// height. A server refuses requests while its node is not in sync.
+func (h *Harness) WaitForHeight(height uint32, timeout time.Duration) error {
+	return h.WaitFor(timeout, func() (bool, error) {
+		for _, v := range h.validators {
+			hh, err := v.RPC.GetBlockNumber(context.Background())
+			if err != nil || hh < height {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// Deposit has the owner deposit amount of utility tokens. Every validator
+// credits the deposit to the owner once its monitor sees the event.
+func (h *Harness) Deposit(amount *big.Int) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := h.eth.Contracts()
+	txnOpts, err := h.eth.GetTransactionOpts(ctx, h.owner)
+	if err != nil {
+		return err
+	}
+	txn, err := c.UtilityToken.Approve(txnOpts, c.DepositAddress, amount)
+	if err := h.wait(ctx, txn, err); err != nil {
+		return err
+	}
+	txn, err = c.Deposit.Deposit(txnOpts, amount)
+	return h.wait(ctx, txn, err)
+}
+
+// OwnerSigner returns a signer for the value the owner deposited
+func (h *Harness) OwnerSigner() (*crypto.Secp256k1Signer, error) {
+	key, err := h.eth.GetAccountKeys(h.owner.Address)
+	if err != nil {
+		return nil, err
+	}
+	signer := &crypto.Secp256k1Signer{}
+	if err := signer.SetPrivk(ethcrypto.FromECDSA(key.PrivateKey)); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+// Value returns the value validator i holds for account
+func (h *Harness) Value(i int, account []byte) (*uint256.Uint256, error) {
+	// deposits are only counted once the UTXOs fall short of the minimum,
+	// so ask for more than can be held
+	all, err := new(uint256.Uint256).FromUint64(math.MaxUint64)
+	if err != nil {
+		return nil, err
+	}
+	_, value, err := h.validators[i].RPC.GetValueForOwner(context.Background(), constants.CurveSecp256k1, account, all)
+	return value, err
+}
+
+// WaitForValue waits until every validator holds at least value for account
+func (h *Harness) WaitForValue(account []byte, value *uint256.Uint256, timeout time.Duration) error {
+	return h.WaitFor(timeout, func() (bool, error) {
+		for i := range h.validators {
+			held, err := h.Value(i, account)
+			if notReady(err) {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			if held.Lt(value) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// Transfer spends value held by the owner of from into a single value store
+// owned by the account to and sends the transaction to the local RPC server
+// of the first validator. The UTXOs spent must add up to exactly value. It
+// returns the hash of the transaction.
+func (h *Harness) Transfer(from *crypto.Secp256k1Signer, to []byte, value *uint256.Uint256) ([]byte, error) {
+	ctx := context.Background()
+	pubk, err := from.Pubkey()
+	if err != nil {
+		return nil, err
+	}
+	client := h.validators[0].RPC
+	utxoIDs, held, err := client.GetValueForOwner(ctx, constants.CurveSecp256k1, crypto.GetAccount(pubk), value)
+	if err != nil {
+		return nil, err
+	}
+	if !held.Eq(value) {
+		return nil, fmt.Errorf("integration: value %v held instead of %v", held, value)
+	}
+	utxos, err := client.GetUTXO(ctx, utxoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &aobjs.Tx{}
+	vss := []*aobjs.ValueStore{}
+	for _, utxo := range utxos {
+		vs, err := utxo.ValueStore()
+		if err != nil {
+			return nil, err
+		}
+		txIn, err := vs.MakeTxIn()
+		if err != nil {
+			return nil, err
+		}
+		tx.Vin = append(tx.Vin, txIn)
+		vss = append(vss, vs)
+	}
+	out := &aobjs.TXOut{}
+	err = out.NewValueStore(&aobjs.ValueStore{
+		VSPreImage: &aobjs.VSPreImage{
+			ChainID: h.cfg.ChainID,
+			Value:   value,
+			Owner:   &aobjs.ValueStoreOwner{SVA: aobjs.ValueStoreSVA, CurveSpec: constants.CurveSecp256k1, Account: to},
+		},
+		TxHash: make([]byte, constants.HashLen),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx.Vout = append(tx.Vout, out)
+	if err := tx.SetTxHash(); err != nil {
+		return nil, err
+	}
+	for i, vs := range vss {
+		if err := vs.Sign(tx.Vin[i], from); err != nil {
+			return nil, err
+		}
+	}
+	return client.SendTransaction(ctx, tx)
+}
+
+// WaitForTx waits until every validator has mined the transaction txHash
+// and returns the height it was mined at
+func (h *Harness) WaitForTx(txHash []byte, timeout time.Duration) (uint32, error) {
+	var height uint32
+	err := h.WaitFor(timeout, func() (bool, error) {
+		for _, v := range h.validators {
+			var err error
+			height, err = v.RPC.GetBlockHeightForTx(context.Background(), txHash)
+			if notReady(err) {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+	return height, err
+}
+
+// notReady reports whether a local RPC server refused a request only because
+// its node has not caught up yet: the node is not in sync or does not hold
+// the object asked for
+func notReady(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := status.Convert(err).Message()
+	return msg == badger.ErrKeyNotFound.Error() || msg == "not in sync - unsafe to serve requests at this time"
+}
@@ -0,0 +1,217 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// epochEnv opts in to the tests that run consensus to the end of the first
+// epoch. These take longer than the default timeout of go test, so they run
+// only when it is set, with a longer -timeout.
+const epochEnv = "MADNET_TEST_EPOCH"
+
+func newTestHarness(t *testing.T) *Harness {
+	if testing.Short() {
+		t.Skip("skipping the node harness in short mode")
+	}
+	for _, name := range []string{"ethsim", "consensus", "dman", "app", "db", "simulator", "badger"} {
+		logging.GetLogger(name).SetLevel(logrus.ErrorLevel)
+	}
+	h, err := New(Config{
+		Keystore:  "../assets/test/keys",
+		Passcodes: "../assets/test/passcodes.txt",
+		Owner:     "0x546F99F244b7B58B855330AE0E2BC1b30b41302F",
+		Validators: []string{
+			"0x9AC1c9afBAec85278679fF75Ef109217f26b1417",
+			"0x26D3D8Ab74D62C26f1ACc220dA1646411c9880Ac",
+			"0x615695C4a4D6a60830e5fca4901FbA099DF26271",
+			"0x63a6627b79813A7A43829490C4cE409254f64177",
+		},
+		ChainID:       42,
+		BlockInterval: 100 * time.Millisecond,
+		Tick:          4 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(h.Close)
+	return h
+}
+
+// startTestNetwork runs ETHDKG, which gives the validators their keys and
+// starts consensus
+func startTestNetwork(t *testing.T) *Harness {
+	h := newTestHarness(t)
+	if err := h.StartETHDKG(); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.WaitForHeight(3, 3*time.Minute); err != nil {
+		t.Fatalf("validators did not start: %v", err)
+	}
+	return h
+}
+
+func TestNetwork(t *testing.T) {
+	h := startTestNetwork(t)
+
+	// The deposit is credited to the owner by every validator
+	owner, err := h.OwnerSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownerPubk, err := owner.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(crypto.GetAccount(ownerPubk), h.Owner().Address.Bytes()) {
+		t.Fatal("owner signer does not match the owner account")
+	}
+	if err := h.Deposit(big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	amount, err := new(uint256.Uint256).FromUint64(1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.WaitForValue(h.Owner().Address.Bytes(), amount, time.Minute); err != nil {
+		t.Fatalf("deposit not seen: %v", err)
+	}
+
+	// The deposit moves on through two transfers
+	alice := &crypto.Secp256k1Signer{}
+	if err := alice.SetPrivk(crypto.Hasher([]byte("alice"))); err != nil {
+		t.Fatal(err)
+	}
+	alicePubk, err := alice.Pubkey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob := crypto.GetAccount(crypto.Hasher([]byte("bob")))
+
+	txHash, err := h.Transfer(owner, crypto.GetAccount(alicePubk), amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.WaitForTx(txHash, time.Minute); err != nil {
+		t.Fatalf("transfer to alice not mined: %v", err)
+	}
+	txHash, err = h.Transfer(alice, bob, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.WaitForTx(txHash, time.Minute); err != nil {
+		t.Fatalf("transfer to bob not mined: %v", err)
+	}
+	for i := range h.Validators() {
+		for account, want := range map[string]*uint256.Uint256{
+			string(h.Owner().Address.Bytes()):    uint256.Zero(),
+			string(crypto.GetAccount(alicePubk)): uint256.Zero(),
+			string(bob):                          amount,
+		} {
+			got, err := h.Value(i, []byte(account))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Eq(want) {
+				t.Fatalf("validator %d: value of %x is %v, want %v", i, account, got, want)
+			}
+		}
+	}
+}
+
+// TestNetworkSnapshot runs only when MADNET_TEST_EPOCH is set, such as with
+//
+//	MADNET_TEST_EPOCH=1 go test -timeout 30m -run TestNetworkSnapshot ./integration
+func TestNetworkSnapshot(t *testing.T) {
+	if os.Getenv(epochEnv) == "" {
+		t.Skipf("reaching the end of an epoch takes several minutes; set %s to run", epochEnv)
+	}
+	h := startTestNetwork(t)
+
+	// The validators take a snapshot of the last block of the first epoch
+	// and each verifies it against its own chain
+	height := constants.EpochLength
+	if err := h.WaitForHeight(height, 30*time.Minute); err != nil {
+		t.Fatalf("end of the epoch not reached: %v", err)
+	}
+	c := h.Eth().Contracts()
+	callOpts := h.Eth().GetCallOpts(context.Background(), h.Owner())
+	err := h.WaitFor(time.Minute, func() (bool, error) {
+		epoch, err := c.Validators.Epoch(callOpts)
+		if err != nil {
+			return false, err
+		}
+		if epoch.Cmp(big.NewInt(1)) < 0 {
+			return false, nil
+		}
+		snapshotHeight, err := c.Validators.GetMadHeightFromSnapshot(callOpts, new(big.Int).Sub(epoch, big.NewInt(1)))
+		if err != nil {
+			return false, err
+		}
+		return snapshotHeight == height, nil
+	})
+	if err != nil {
+		t.Fatalf("snapshot not taken: %v", err)
+	}
+	bh, err := h.Validator(0).RPC.GetBlockHeader(context.Background(), height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the transaction hashes are not part of a snapshot
+	want, err := bh.BClaims.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.WaitFor(time.Minute, func() (bool, error) {
+		for _, v := range h.Validators() {
+			var snapshot *objs.BlockHeader
+			var alerts []*objs.SnapshotAlert
+			err := v.Node.Database().View(func(txn *badger.Txn) error {
+				var err error
+				snapshot, err = v.Node.Database().GetSnapshotBlockHeader(txn, height)
+				if err != nil {
+					return err
+				}
+				alerts, err = v.Node.Database().GetSnapshotAlerts(txn)
+				return err
+			})
+			if err == badger.ErrKeyNotFound {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			if len(alerts) > 0 {
+				t.Fatalf("snapshot alert raised: %+v", alerts[0])
+			}
+			got, err := snapshot.BClaims.MarshalBinary()
+			if err != nil {
+				return false, err
+			}
+			if !bytes.Equal(got, want) || !bytes.Equal(snapshot.SigGroup, bh.SigGroup) {
+				t.Fatal("snapshot does not match the committed block header")
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("snapshot not seen: %v", err)
+	}
+
+	// Consensus carries on past the snapshot
+	if err := h.WaitForHeight(height+2, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+}
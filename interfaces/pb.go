@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/types"
 )
 
 // StateServer implements the State server service from the protobuf definition.
@@ -15,11 +16,15 @@ type P2PClientRaw interface {
 }
 
 // P2PClient implements the P2P client service from the protobuf definition
-// and extends it for connection management.
+// and extends it for connection management. ProtoVersion and Capabilities
+// are those negotiated with the peer so new message types are only sent to
+// peers that understand them.
 type P2PClient interface {
 	Close() error
 	NodeAddr() NodeAddr
 	CloseChan() <-chan struct{}
+	ProtoVersion() types.ProtoVersion
+	Capabilities() types.Capabilities
 	pb.P2PClient
 }
 
@@ -47,3 +52,9 @@ type DiscoveryServer interface {
 type BootNodeServer interface {
 	pb.BootNodeServer
 }
+
+// DHTServer implements the DHT server service from the protobuf
+// definition.
+type DHTServer interface {
+	pb.DHTServer
+}
@@ -1,6 +1,10 @@
 package interfaces
 
-import "context"
+import (
+	"context"
+
+	"github.com/MadBase/MadNet/types"
+)
 
 // Peer is an element of the peer tree.
 // This interface allows inspection of both the peer and
@@ -12,12 +16,16 @@ type Peer interface {
 	P2PClient() (P2PClient, error)
 	PreventGossipConsensus([]byte)
 	PreventGossipTx([]byte)
+	ProtoVersion() types.ProtoVersion
+	Capabilities() types.Capabilities
 }
 
 // PeerLease allows a service to obtain a Peer for sending data via closures
 type PeerLease interface {
 	P2PClient() (P2PClient, error)
 	Do(func(PeerLease) error)
+	ProtoVersion() types.ProtoVersion
+	Capabilities() types.Capabilities
 }
 
 // PeerSubscription allows a service to maintain an in sync copy of all active
@@ -30,4 +38,5 @@ type PeerSubscription interface {
 	PreventGossipConsensus(addr NodeAddr, hsh []byte)
 	GossipConsensus(hsh []byte, fn func(context.Context, PeerLease) error)
 	GossipTx(hsh []byte, fn func(context.Context, PeerLease) error)
+	Penalize(addr NodeAddr, offense types.PeerOffense)
 }
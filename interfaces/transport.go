@@ -19,20 +19,35 @@ type NodeAddr interface {
 	Port() int
 }
 
+// PeerAddr is the address of the remote peer of a connection served by a
+// grpc server along with the protocol version and capabilities negotiated
+// on the connection. It is the peer.Addr of the context of request and
+// gossip handlers so they can handle new message types from legacy peers.
+type PeerAddr interface {
+	NodeAddr
+	ProtoVersion() types.ProtoVersion
+	Capabilities() types.Capabilities
+}
+
 // P2PConn is the connection interface for the transport.
 // This interface implements the net.Conn interface and
 // extends the interface to allow introspection of the remote
 // node identity as well as if this connection is a locally
 // created connection through dial or if this connection is
 // a remote initiated connection where the remote peer dialed
-// the local node.
+// the local node. The protocol version and capabilities
+// negotiated with the remote node may be inspected and the
+// bytes read and written are counted.
 type P2PConn interface {
 	net.Conn
 	Initiator() types.P2PInitiator
 	NodeAddr() NodeAddr
 	Protocol() types.Protocol
 	ProtoVersion() types.ProtoVersion
+	Capabilities() types.Capabilities
 	CloseChan() <-chan struct{}
+	BytesIn() uint64
+	BytesOut() uint64
 }
 
 // P2PMuxConn is a multiplexed P2PConn as is returned by the P2PMuxTransport.
@@ -43,6 +58,8 @@ type P2PMuxConn interface {
 	NodeAddr() NodeAddr
 	CloseChan() <-chan struct{}
 	Close() error
+	BytesIn() uint64
+	BytesOut() uint64
 }
 
 // P2PTransport is the interface that defines what the peer to peer
@@ -52,9 +69,15 @@ type P2PMuxConn interface {
 // more information. The Accept() method will return new
 // incoming connections from remote peers that have completed
 // the initial handshake. The Dial() method allows remote peers
-// to be connected to, by the local node.
+// to be connected to, by the local node. The NodeRecord() method returns
+// the signed record of the local node that is handed out by discovery.
+// The WaitInbound() method applies the inbound bandwidth limit of the
+// transport to a message of the P2P protocol, giving priority to consensus
+// messages.
 type P2PTransport interface {
 	NodeAddr() NodeAddr
+	NodeRecord() []byte
+	WaitInbound(ctx context.Context, n int, consensus bool) error
 	Accept() (P2PConn, error)
 	Dial(NodeAddr, types.Protocol) (P2PConn, error)
 	Close() error
@@ -10,19 +10,23 @@ import (
 	"github.com/MadBase/MadNet/application"
 	"github.com/MadBase/MadNet/application/objs"
 	"github.com/MadBase/MadNet/application/objs/uint256"
+	"github.com/MadBase/MadNet/blockchain/monitor"
 	"github.com/MadBase/MadNet/consensus/db"
 	"github.com/MadBase/MadNet/consensus/gossip"
 	"github.com/MadBase/MadNet/consensus/lstate"
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto"
 	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/peering"
 	pb "github.com/MadBase/MadNet/proto"
 	"github.com/MadBase/MadNet/utils"
 	"github.com/dgraph-io/badger/v2"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/sirupsen/logrus"
 )
 
 var _ pb.LocalStateGetBlockHeaderHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetBlockHeaderProofHandler = (*Handlers)(nil)
 var _ pb.LocalStateGetPendingTransactionHandler = (*Handlers)(nil)
 var _ pb.LocalStateGetRoundStateForValidatorHandler = (*Handlers)(nil)
 var _ pb.LocalStateGetValidatorSetHandler = (*Handlers)(nil)
@@ -35,6 +39,32 @@ var _ pb.LocalStateGetMinedTransactionHandler = (*Handlers)(nil)
 var _ pb.LocalStateGetValueForOwnerHandler = (*Handlers)(nil)
 var _ pb.LocalStateIterateNameSpaceHandler = (*Handlers)(nil)
 var _ pb.LocalStateGetUTXOHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetValidatorParticipationHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetConsensusTraceHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetEthDKGStatusHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetSnapshotAlertsHandler = (*Handlers)(nil)
+var _ pb.LocalStateClearSnapshotAlertHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetKnownPeersHandler = (*Handlers)(nil)
+var _ pb.LocalStateGetActivePeersHandler = (*Handlers)(nil)
+var _ pb.LocalStateDisconnectPeerHandler = (*Handlers)(nil)
+var _ pb.LocalStateDialPeerHandler = (*Handlers)(nil)
+
+// EthDKGStatusSource reports on the ETHDKG round the node takes part in
+type EthDKGStatusSource interface {
+	EthDKGStatus(context.Context) (*monitor.EthDKGStatus, error)
+}
+
+var _ EthDKGStatusSource = (*monitor.Services)(nil)
+
+// PeerSource lists and manages the peers of the node
+type PeerSource interface {
+	KnownPeers() ([]*peering.KnownPeer, error)
+	ActivePeers() []*peering.PeerInfo
+	Disconnect(addr string) error
+	Dial(addr string) error
+}
+
+var _ PeerSource = (*peering.PeerManager)(nil)
 
 // Handlers is the server side of the local RPC system. Handlers dispatches
 // requests to other systems for processing.
@@ -56,10 +86,15 @@ type Handlers struct {
 
 	safeHandler func() bool
 	safecount   uint32
+
+	ethdkg EthDKGStatusSource
+
+	peers PeerSource
 }
 
-// Init will initialize the Consensus Engine and all sub modules
-func (srpc *Handlers) Init(database *db.Database, app *application.Application, gh *gossip.Handlers, pubk []byte, safe func() bool) error {
+// Init will initialize the Consensus Engine and all sub modules. The
+// ETHDKG status is only served when ethdkg is not nil.
+func (srpc *Handlers) Init(database *db.Database, app *application.Application, gh *gossip.Handlers, pubk []byte, safe func() bool, ethdkg EthDKGStatusSource, peers PeerSource) error {
 	background := context.Background()
 	ctx, cf := context.WithCancel(background)
 	srpc.cancelCtx = cf
@@ -79,6 +114,8 @@ func (srpc *Handlers) Init(database *db.Database, app *application.Application,
 		srpc.ethAcct = crypto.GetAccount(srpc.EthPubk)
 	}
 	srpc.safeHandler = safe
+	srpc.ethdkg = ethdkg
+	srpc.peers = peers
 	return nil
 }
 
@@ -342,6 +379,52 @@ func (srpc *Handlers) HandleLocalStateGetBlockHeader(ctx context.Context, req *p
 	return result, nil
 }
 
+// HandleLocalStateGetBlockHeaderProof returns a committed block header and
+// its proof of inclusion in the current header trie. The root of that trie
+// is the header root of the block after RootHeight.
+func (srpc *Handlers) HandleLocalStateGetBlockHeaderProof(ctx context.Context, req *pb.BlockHeaderProofRequest) (*pb.BlockHeaderProofResponse, error) {
+	if !srpc.safe() {
+		select {
+		case <-srpc.ctx.Done():
+			return nil, errors.New("closing")
+		case <-time.After(1 * time.Second):
+			return nil, errors.New("not in sync - unsafe to serve requests at this time")
+		}
+	}
+	srpc.logger.Debugf("HandleLocalStateGetBlockHeaderProof: %v", req)
+	if req.Height == 0 {
+		return nil, errors.New("height cannot be zero")
+	}
+	result := &pb.BlockHeaderProofResponse{}
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		os, err := srpc.database.GetOwnState(txn)
+		if err != nil {
+			return err
+		}
+		root, err := srpc.database.GetHeaderTrieRoot(txn, os.SyncToBH.BClaims.Height)
+		if err != nil {
+			return err
+		}
+		bhh, proof, err := srpc.database.GetCommittedBlockHeaderWithProof(txn, root, req.Height)
+		if err != nil {
+			return err
+		}
+		bh, err := ForwardTranslateBlockHeader(bhh)
+		if err != nil {
+			return err
+		}
+		result.BlockHeader = bh
+		result.Proof = hex.EncodeToString(proof)
+		result.HeaderRoot = hex.EncodeToString(root)
+		result.RootHeight = os.SyncToBH.BClaims.Height
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (srpc *Handlers) HandleLocalStateGetRoundStateForValidator(ctx context.Context, req *pb.RoundStateForValidatorRequest) (*pb.RoundStateForValidatorResponse, error) {
 	if !srpc.safe() {
 		select {
@@ -416,6 +499,181 @@ func (srpc *Handlers) HandleLocalStateGetEpochNumber(ctx context.Context, req *p
 	return result, nil
 }
 
+// HandleLocalStateGetValidatorParticipation returns the participation
+// aggregate of the requested epoch. An epoch of zero selects the current
+// epoch.
+func (srpc *Handlers) HandleLocalStateGetValidatorParticipation(ctx context.Context, req *pb.ValidatorParticipationRequest) (*pb.ValidatorParticipationResponse, error) {
+	if !srpc.safe() {
+		select {
+		case <-srpc.ctx.Done():
+			return nil, errors.New("closing")
+		case <-time.After(1 * time.Second):
+			return nil, errors.New("not in sync - unsafe to serve requests at this time")
+		}
+	}
+	srpc.logger.Debugf("HandleLocalStateGetValidatorParticipation: %v", req)
+	result := &pb.ValidatorParticipationResponse{}
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		epoch := req.Epoch
+		if epoch == 0 {
+			os, err := srpc.database.GetOwnState(txn)
+			if err != nil {
+				return err
+			}
+			epoch = utils.Epoch(os.SyncToBH.BClaims.Height)
+		}
+		p, err := srpc.database.GetParticipation(txn, epoch)
+		if err != nil {
+			return err
+		}
+		result.Epoch = p.Epoch
+		result.LastHeight = p.LastHeight
+		for i := 0; i < len(p.Validators); i++ {
+			vp := p.Validators[i]
+			result.Validators = append(result.Validators, &pb.ValidatorParticipationResponse_Validator{
+				VAddr:           hex.EncodeToString(vp.VAddr),
+				Rounds:          vp.Rounds,
+				ProposalsMade:   vp.ProposalsMade,
+				ProposalsMissed: vp.ProposalsMissed,
+				PreVotes:        vp.PreVotes,
+				PreCommits:      vp.PreCommits,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HandleLocalStateGetConsensusTrace returns the most recent state transitions
+// of the consensus engine. This is served even when the node is out of sync
+// since the trace is most useful while consensus is stalled.
+func (srpc *Handlers) HandleLocalStateGetConsensusTrace(ctx context.Context, req *pb.ConsensusTraceRequest) (*pb.ConsensusTraceResponse, error) {
+	srpc.logger.Debugf("HandleLocalStateGetConsensusTrace: %v", req)
+	count := int(req.Count)
+	if count <= 0 || uint64(count) > constants.TraceRingSize {
+		return nil, fmt.Errorf("invalid count (%v) must be between 1 and %v", req.Count, constants.TraceRingSize)
+	}
+	result := &pb.ConsensusTraceResponse{}
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		evs, err := srpc.database.GetTraceEvents(txn, count)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(evs); i++ {
+			ev := evs[i]
+			result.Events = append(result.Events, &pb.ConsensusTraceResponse_Event{
+				Seq:          ev.Seq,
+				Time:         ev.Time,
+				Height:       ev.Height,
+				Round:        ev.Round,
+				TargetHeight: ev.TargetHeight,
+				TargetRound:  ev.TargetRound,
+				Step:         ev.Step,
+				Trigger:      ev.Trigger,
+				Hash:         hex.EncodeToString(ev.Hash),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HandleLocalStateGetSnapshotAlerts returns every snapshot on Ethereum found
+// to differ from the committed block header at its height. This is served
+// even when the node is out of sync since a mismatch may be why.
+func (srpc *Handlers) HandleLocalStateGetSnapshotAlerts(ctx context.Context, req *pb.SnapshotAlertsRequest) (*pb.SnapshotAlertsResponse, error) {
+	srpc.logger.Debugf("HandleLocalStateGetSnapshotAlerts: %v", req)
+	result := &pb.SnapshotAlertsResponse{}
+	err := srpc.database.View(func(txn *badger.Txn) error {
+		sas, err := srpc.database.GetSnapshotAlerts(txn)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(sas); i++ {
+			sa := sas[i]
+			result.Alerts = append(result.Alerts, &pb.SnapshotAlertsResponse_Alert{
+				Height:          sa.Height,
+				Epoch:           sa.Epoch,
+				Validator:       common.BytesToAddress(sa.Validator).Hex(),
+				BClaimsMismatch: sa.BClaimsMismatch,
+				SigMismatch:     sa.SigMismatch,
+				LocalBlockHash:  hex.EncodeToString(sa.LocalBlockHash),
+				RemoteBlockHash: hex.EncodeToString(sa.RemoteBlockHash),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HandleLocalStateClearSnapshotAlert removes the snapshot alert at a height
+// once the operator has dealt with it. Clearing every alert resumes a node
+// that halted on a mismatch.
+func (srpc *Handlers) HandleLocalStateClearSnapshotAlert(ctx context.Context, req *pb.ClearSnapshotAlertRequest) (*pb.ClearSnapshotAlertResponse, error) {
+	srpc.logger.Debugf("HandleLocalStateClearSnapshotAlert: %v", req)
+	err := srpc.database.Update(func(txn *badger.Txn) error {
+		return srpc.database.DeleteSnapshotAlert(txn, req.Height)
+	})
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, fmt.Errorf("no snapshot alert at height %v", req.Height)
+		}
+		return nil, err
+	}
+	return &pb.ClearSnapshotAlertResponse{}, nil
+}
+
+// HandleLocalStateGetEthDKGStatus returns the ETHDKG phase and schedule
+// along with the progress of every participant. Like the consensus trace
+// this is served when the node is out of sync.
+func (srpc *Handlers) HandleLocalStateGetEthDKGStatus(ctx context.Context, req *pb.EthDKGStatusRequest) (*pb.EthDKGStatusResponse, error) {
+	srpc.logger.Debugf("HandleLocalStateGetEthDKGStatus: %v", req)
+	if srpc.ethdkg == nil {
+		return nil, errors.New("ETHDKG status is not available on this node")
+	}
+	status, err := srpc.ethdkg.EthDKGStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.EthDKGStatusResponse{
+		Phase:          status.Phase.String(),
+		Address:        status.Address.Hex(),
+		CurrentBlock:   status.CurrentBlock,
+		ProcessedBlock: status.ProcessedBlock,
+		Failures:       status.Failures,
+		RestartBlock:   status.RestartBlock,
+	}
+	for _, phase := range monitor.EthDKGPhases() {
+		start, end := status.Schedule.Window(phase)
+		result.Schedule = append(result.Schedule, &pb.EthDKGStatusResponse_Window{
+			Phase:      phase.String(),
+			StartBlock: start,
+			EndBlock:   end,
+			TaskStatus: status.Tasks[phase].String(),
+		})
+	}
+	for _, ps := range status.Participants {
+		result.Participants = append(result.Participants, &pb.EthDKGStatusResponse_Participant{
+			Address:           ps.Address.Hex(),
+			Index:             uint32(ps.Index),
+			SharesDistributed: ps.SharesDistributed,
+			KeyShareSubmitted: ps.KeyShareSubmitted,
+			GPKjSubmitted:     ps.GPKjSubmitted,
+			Malicious:         ps.Malicious,
+		})
+	}
+	return result, nil
+}
+
 // HandleLocalStateGetData ...
 func (srpc *Handlers) HandleLocalStateGetData(ctx context.Context, req *pb.GetDataRequest) (*pb.GetDataResponse, error) {
 	if !srpc.safe() {
@@ -661,3 +919,79 @@ func (srpc *Handlers) HandleLocalStateGetTxBlockNumber(ctx context.Context, req
 	result := &pb.TxBlockNumberResponse{BlockHeight: height}
 	return result, nil
 }
+
+// HandleLocalStateGetKnownPeers returns the peers in the address book of the
+// node. Like the consensus trace this is served when the node is out of
+// sync.
+func (srpc *Handlers) HandleLocalStateGetKnownPeers(ctx context.Context, req *pb.KnownPeersRequest) (*pb.KnownPeersResponse, error) {
+	srpc.logger.Debugf("HandleLocalStateGetKnownPeers: %v", req)
+	if srpc.peers == nil {
+		return nil, errors.New("known peers are not available on this node")
+	}
+	peers, err := srpc.peers.KnownPeers()
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.KnownPeersResponse{}
+	for i := 0; i < len(peers); i++ {
+		p := peers[i]
+		result.Peers = append(result.Peers, &pb.KnownPeersResponse_Peer{
+			Address:   p.Addr.P2PAddr(),
+			LastSeen:  p.LastSeen.Unix(),
+			Successes: p.Successes,
+			Failures:  p.Failures,
+			Active:    p.Active,
+		})
+	}
+	return result, nil
+}
+
+// HandleLocalStateGetActivePeers returns the peers the node is connected to
+func (srpc *Handlers) HandleLocalStateGetActivePeers(ctx context.Context, req *pb.ActivePeersRequest) (*pb.ActivePeersResponse, error) {
+	srpc.logger.Debugf("HandleLocalStateGetActivePeers: %v", req)
+	if srpc.peers == nil {
+		return nil, errors.New("active peers are not available on this node")
+	}
+	peers := srpc.peers.ActivePeers()
+	result := &pb.ActivePeersResponse{}
+	for i := 0; i < len(peers); i++ {
+		p := peers[i]
+		result.Peers = append(result.Peers, &pb.ActivePeersResponse_Peer{
+			Address:             p.Addr.P2PAddr(),
+			Initiator:           p.Initiator.String(),
+			ProtoVersion:        uint32(p.ProtoVersion),
+			ConnectedSince:      p.ConnectedSince.Unix(),
+			ConsensusQueueDepth: uint32(p.ConsensusQueueDepth),
+			TxQueueDepth:        uint32(p.TxQueueDepth),
+			BytesIn:             p.BytesIn,
+			BytesOut:            p.BytesOut,
+			Capabilities:        uint64(p.Capabilities),
+		})
+	}
+	return result, nil
+}
+
+// HandleLocalStateDisconnectPeer closes the connection to an active peer
+func (srpc *Handlers) HandleLocalStateDisconnectPeer(ctx context.Context, req *pb.DisconnectPeerRequest) (*pb.DisconnectPeerResponse, error) {
+	srpc.logger.Debugf("HandleLocalStateDisconnectPeer: %v", req)
+	if srpc.peers == nil {
+		return nil, errors.New("peer management is not available on this node")
+	}
+	if err := srpc.peers.Disconnect(req.Address); err != nil {
+		return nil, err
+	}
+	return &pb.DisconnectPeerResponse{}, nil
+}
+
+// HandleLocalStateDialPeer connects to a peer and returns once the peer is
+// active or the connection failed
+func (srpc *Handlers) HandleLocalStateDialPeer(ctx context.Context, req *pb.DialPeerRequest) (*pb.DialPeerResponse, error) {
+	srpc.logger.Debugf("HandleLocalStateDialPeer: %v", req)
+	if srpc.peers == nil {
+		return nil, errors.New("peer management is not available on this node")
+	}
+	if err := srpc.peers.Dial(req.Address); err != nil {
+		return nil, err
+	}
+	return &pb.DialPeerResponse{}, nil
+}
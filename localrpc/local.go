@@ -27,6 +27,7 @@ type Handler struct {
 	listener   net.Listener
 	grpcServer *grpc.Server
 	server     *http.Server
+	mux        *http.ServeMux
 	log        *logrus.Logger
 	closeOnce  sync.Once
 }
@@ -43,6 +44,17 @@ func (rpch *Handler) Close() error {
 	return nil
 }
 
+// Handle registers an additional http handler on the server mux. This
+// allows services such as health checks to share the local RPC listener.
+func (rpch *Handler) Handle(pattern string, handler http.Handler) {
+	rpch.mux.Handle(pattern, handler)
+}
+
+// Addr returns the address the server listens on
+func (rpch *Handler) Addr() net.Addr {
+	return rpch.listener.Addr()
+}
+
 func (rpch *Handler) Serve() {
 	defer rpch.Close()
 	if err := rpch.server.Serve(rpch.listener); err != nil {
@@ -109,6 +121,7 @@ func NewStateServerHandler(logger *logrus.Logger, addr string, service interface
 		cf:         cf,
 		listener:   lis,
 		server:     srv,
+		mux:        mux,
 		grpcServer: grpcServer,
 		log:        logger,
 	}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	gmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/metrics/prometheus"
+)
+
+var registry gmetrics.Registry
+var registryOnce sync.Once
+
+// Registry returns the registry shared by all MadNet metrics. The go-ethereum
+// metrics system is disabled by default and hands out no-op meters until it
+// is enabled, so the first call enables it.
+func Registry() gmetrics.Registry {
+	registryOnce.Do(func() {
+		gmetrics.Enabled = true
+		registry = gmetrics.NewRegistry()
+	})
+	return registry
+}
+
+// GetOrRegisterGauge returns the gauge registered under name, creating it if
+// it does not exist yet.
+func GetOrRegisterGauge(name string) gmetrics.Gauge {
+	return gmetrics.GetOrRegisterGauge(name, Registry())
+}
+
+// GetOrRegisterGaugeFloat64 returns the float gauge registered under name,
+// creating it if it does not exist yet.
+func GetOrRegisterGaugeFloat64(name string) gmetrics.GaugeFloat64 {
+	return gmetrics.GetOrRegisterGaugeFloat64(name, Registry())
+}
+
+// GetOrRegisterCounter returns the counter registered under name, creating it
+// if it does not exist yet.
+func GetOrRegisterCounter(name string) gmetrics.Counter {
+	return gmetrics.GetOrRegisterCounter(name, Registry())
+}
+
+// Handler returns an http handler which exposes all registered metrics in
+// the Prometheus text format.
+func Handler() http.Handler {
+	return prometheus.Handler(Registry())
+}
@@ -106,20 +106,20 @@ func (ps *activePeerStore) len() int {
 }
 
 // random returns a random active peer
-func (ps *activePeerStore) random() (string, bool) {
+func (ps *activePeerStore) random() (interfaces.NodeAddr, bool) {
 	ps.RLock()
 	defer ps.RUnlock()
 	if len(ps.store) == 0 {
-		return "", false
+		return nil, false
 	}
 	i := 0
 	index, err := randomElement(len(ps.store))
 	if err != nil {
-		return "", false
+		return nil, false
 	}
 	for _, v := range ps.store {
 		if i == index {
-			return v.NodeAddr().P2PAddr(), true
+			return v.NodeAddr(), true
 		}
 		i++
 	}
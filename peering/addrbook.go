@@ -0,0 +1,257 @@
+package peering
+
+import (
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/constants/dbprefix"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+)
+
+// knownPeerHeaderLen is the length of the fixed size fields of an encoded
+// KnownPeer: last seen, successes and failures.
+const knownPeerHeaderLen = 24
+
+// KnownPeer is an entry of the address book
+type KnownPeer struct {
+	Addr      interfaces.NodeAddr
+	LastSeen  time.Time
+	Successes uint64
+	Failures  uint64
+	// Active is set by the peer manager if the peer is connected
+	Active bool
+}
+
+// MarshalBinary encodes the entry for storage
+func (kp *KnownPeer) MarshalBinary() []byte {
+	out := make([]byte, 0, knownPeerHeaderLen+len(kp.Addr.P2PAddr()))
+	out = append(out, utils.MarshalInt64(kp.LastSeen.UnixNano())...)
+	out = append(out, utils.MarshalUint64(kp.Successes)...)
+	out = append(out, utils.MarshalUint64(kp.Failures)...)
+	out = append(out, []byte(kp.Addr.P2PAddr())...)
+	return out
+}
+
+// UnmarshalBinary decodes an entry written by MarshalBinary
+func (kp *KnownPeer) UnmarshalBinary(data []byte) error {
+	if len(data) <= knownPeerHeaderLen {
+		return errorz.ErrInvalid{}.New("KnownPeer.UnmarshalBinary: invalid byte length")
+	}
+	lastSeen, err := utils.UnmarshalInt64(data[0:8])
+	if err != nil {
+		return err
+	}
+	successes, err := utils.UnmarshalUint64(data[8:16])
+	if err != nil {
+		return err
+	}
+	failures, err := utils.UnmarshalUint64(data[16:24])
+	if err != nil {
+		return err
+	}
+	addr, err := (*transport.NodeAddr).Unmarshal(nil, string(data[knownPeerHeaderLen:]))
+	if err != nil {
+		return err
+	}
+	kp.Addr = addr
+	kp.LastSeen = time.Unix(0, lastSeen)
+	kp.Successes = successes
+	kp.Failures = failures
+	return nil
+}
+
+// stale returns true if the peer should be forgotten at now
+func (kp *KnownPeer) stale(now time.Time) bool {
+	if now.Sub(kp.LastSeen) > constants.PeerAddrBookMaxAge {
+		return true
+	}
+	return kp.Failures >= kp.Successes+constants.PeerAddrBookMaxFailures
+}
+
+// addrBook persists the peers the node has connected to so discovery can
+// resume from them after a restart instead of relying on the bootnodes.
+// A nil database disables persistence.
+type addrBook struct {
+	db    *badger.DB
+	clock utils.Clock
+}
+
+func newAddrBook(db *badger.DB) *addrBook {
+	return &addrBook{
+		db: db,
+	}
+}
+
+func (ab *addrBook) makeKey(addr interfaces.NodeAddr) []byte {
+	key := dbprefix.PrefixPeerAddrBook()
+	key = append(key, []byte(addr.Identity())...)
+	return key
+}
+
+// get returns the entry for addr or nil if the peer is not known
+func (ab *addrBook) get(txn *badger.Txn, addr interfaces.NodeAddr) (*KnownPeer, error) {
+	v, err := utils.GetValue(txn, ab.makeKey(addr))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	kp := &KnownPeer{}
+	if err := kp.UnmarshalBinary(v); err != nil {
+		return nil, err
+	}
+	return kp, nil
+}
+
+func (ab *addrBook) set(txn *badger.Txn, kp *KnownPeer) error {
+	return utils.SetValue(txn, ab.makeKey(kp.Addr), kp.MarshalBinary())
+}
+
+// success records a connection to addr, adding the peer if it is not known
+func (ab *addrBook) success(addr interfaces.NodeAddr) error {
+	if ab.db == nil {
+		return nil
+	}
+	return ab.db.Update(func(txn *badger.Txn) error {
+		kp, err := ab.get(txn, addr)
+		if err != nil {
+			return err
+		}
+		if kp == nil {
+			kp = &KnownPeer{}
+		}
+		kp.Addr = addr
+		kp.LastSeen = ab.clock.Now()
+		kp.Successes++
+		return ab.set(txn, kp)
+	})
+}
+
+// seen marks the known peers in addrs as seen now. It keeps connected peers
+// from being pruned however long their connection lasts.
+func (ab *addrBook) seen(addrs []interfaces.NodeAddr) error {
+	if ab.db == nil {
+		return nil
+	}
+	now := ab.clock.Now()
+	return ab.db.Update(func(txn *badger.Txn) error {
+		for i := 0; i < len(addrs); i++ {
+			kp, err := ab.get(txn, addrs[i])
+			if err != nil {
+				return err
+			}
+			if kp == nil {
+				continue
+			}
+			kp.LastSeen = now
+			if err := ab.set(txn, kp); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// failure records a failed dial to addr. Peers that are not known are not
+// added since they may never have been reachable.
+func (ab *addrBook) failure(addr interfaces.NodeAddr) error {
+	if ab.db == nil {
+		return nil
+	}
+	return ab.db.Update(func(txn *badger.Txn) error {
+		kp, err := ab.get(txn, addr)
+		if err != nil || kp == nil {
+			return err
+		}
+		kp.Failures++
+		if kp.stale(ab.clock.Now()) {
+			return utils.DeleteValue(txn, ab.makeKey(addr))
+		}
+		return ab.set(txn, kp)
+	})
+}
+
+// del forgets addr
+func (ab *addrBook) del(addr interfaces.NodeAddr) error {
+	if ab.db == nil {
+		return nil
+	}
+	return ab.db.Update(func(txn *badger.Txn) error {
+		return utils.DeleteValue(txn, ab.makeKey(addr))
+	})
+}
+
+// list returns every entry of the address book. Entries that fail to decode
+// are skipped.
+func (ab *addrBook) list() ([]*KnownPeer, error) {
+	peers := []*KnownPeer{}
+	if ab.db == nil {
+		return peers, nil
+	}
+	err := ab.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = dbprefix.PrefixPeerAddrBook()
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			v, err := iter.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			kp := &KnownPeer{}
+			if err := kp.UnmarshalBinary(v); err != nil {
+				continue
+			}
+			peers = append(peers, kp)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return peers, nil
+}
+
+// prune forgets the peers that have not been seen for too long or that keep
+// failing, along with any entry that fails to decode.
+func (ab *addrBook) prune() error {
+	if ab.db == nil {
+		return nil
+	}
+	now := ab.clock.Now()
+	return ab.db.Update(func(txn *badger.Txn) error {
+		stale := [][]byte{}
+		err := func() error {
+			opts := badger.DefaultIteratorOptions
+			opts.Prefix = dbprefix.PrefixPeerAddrBook()
+			iter := txn.NewIterator(opts)
+			defer iter.Close()
+			for iter.Rewind(); iter.Valid(); iter.Next() {
+				item := iter.Item()
+				v, err := item.ValueCopy(nil)
+				if err != nil {
+					return err
+				}
+				kp := &KnownPeer{}
+				if err := kp.UnmarshalBinary(v); err != nil || kp.stale(now) {
+					stale = append(stale, item.KeyCopy(nil))
+				}
+			}
+			return nil
+		}()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(stale); i++ {
+			if err := utils.DeleteValue(txn, stale[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,114 @@
+package peering
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/dgraph-io/badger/v2"
+)
+
+func testNodeAddr(t *testing.T, port int) interfaces.NodeAddr {
+	r, err := transport.RandomNodeAddr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := transport.NewNodeAddr(fmt.Sprintf("0000002a|%s@127.0.0.1:%d", r.Identity(), port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+func TestAddrBook(t *testing.T) {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLogger(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	now := time.Now()
+	ab := newAddrBook(db)
+	ab.clock = func() time.Time { return now }
+
+	good := testNodeAddr(t, 4242)
+	flaky := testNodeAddr(t, 4243)
+	unknown := testNodeAddr(t, 4244)
+	for _, addr := range []interfaces.NodeAddr{good, good, flaky} {
+		if err := ab.success(addr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ab.failure(unknown); err != nil {
+		t.Fatal(err)
+	}
+	peers, err := ab.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 2 {
+		t.Fatalf("%d known peers", len(peers))
+	}
+	for _, p := range peers {
+		if p.Addr.P2PAddr() == good.P2PAddr() && (p.Successes != 2 || p.LastSeen.UnixNano() != now.UnixNano()) {
+			t.Fatalf("bad entry %+v", p)
+		}
+	}
+
+	// a peer that keeps failing is forgotten
+	for i := 0; i <= constants.PeerAddrBookMaxFailures; i++ {
+		if err := ab.failure(flaky); err != nil {
+			t.Fatal(err)
+		}
+	}
+	peers, err = ab.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 1 || peers[0].Addr.P2PAddr() != good.P2PAddr() || peers[0].Failures != 0 {
+		t.Fatalf("failing peer not forgotten: %+v", peers)
+	}
+
+	// a peer that stays connected is seen and kept
+	now = now.Add(constants.PeerAddrBookMaxAge - time.Second)
+	if err := ab.seen([]interfaces.NodeAddr{good, unknown}); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Second)
+	if err := ab.prune(); err != nil {
+		t.Fatal(err)
+	}
+	peers, err = ab.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 1 || peers[0].Successes != 2 {
+		t.Fatalf("connected peer forgotten: %+v", peers)
+	}
+
+	// a peer that is not seen for too long is forgotten
+	now = now.Add(constants.PeerAddrBookMaxAge + time.Second)
+	if err := ab.prune(); err != nil {
+		t.Fatal(err)
+	}
+	peers, err = ab.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 0 {
+		t.Fatalf("old peer not forgotten: %+v", peers)
+	}
+}
+
+func TestAddrBookNoDB(t *testing.T) {
+	ab := newAddrBook(nil)
+	if err := ab.success(testNodeAddr(t, 4242)); err != nil {
+		t.Fatal(err)
+	}
+	peers, err := ab.list()
+	if err != nil || len(peers) != 0 {
+		t.Fatalf("peers %v: %v", peers, err)
+	}
+}
@@ -10,15 +10,27 @@ import (
 
 type bootNodeList struct{}
 
-func (bnl *bootNodeList) randomBootNode() (interfaces.NodeAddr, error) {
+// all returns the configured boot nodes
+func (bnl *bootNodeList) all() ([]interfaces.NodeAddr, error) {
 	lst := []interfaces.NodeAddr{}
 	for _, bn := range config.Configuration.Transport.BootNodes() {
+		if bn == "" {
+			continue
+		}
 		addr, err := transport.NewNodeAddr(bn)
 		if err != nil {
 			return nil, err
 		}
 		lst = append(lst, addr)
 	}
+	return lst, nil
+}
+
+func (bnl *bootNodeList) randomBootNode() (interfaces.NodeAddr, error) {
+	lst, err := bnl.all()
+	if err != nil {
+		return nil, err
+	}
 	if len(lst) == 0 {
 		return nil, errors.New("no boot nodes exist")
 	}
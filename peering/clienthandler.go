@@ -8,6 +8,7 @@ import (
 	"errors"
 
 	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
 	"google.golang.org/grpc"
 )
 
@@ -48,14 +49,22 @@ func (rpcch *clientHandler) HandleConnection(p2pconn interfaces.P2PConn) (*grpc.
 			return nil, errors.New("connection is nil")
 		}
 	}
-	conn, err := grpc.Dial(
-		p2pconn.RemoteAddr().String(), // THIS WILL NEVER BE DIALED
-		grpc.WithTimeout(time.Second*5),
+	opts := []grpc.DialOption{
+		grpc.WithTimeout(time.Second * 5),
 		grpc.WithContextDialer(contextDialer),
 		grpc.WithInsecure(),
 		grpc.WithBlock(),
 		grpc.WithDisableRetry(),
 		grpc.WithDisableHealthCheck(),
+	}
+	// requests are compressed if the peer supports it and the peer answers
+	// compressed requests with compressed responses
+	if p2pconn.Capabilities().Has(types.CompressionCapability) {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(compressorName)))
+	}
+	conn, err := grpc.Dial(
+		p2pconn.RemoteAddr().String(), // THIS WILL NEVER BE DIALED
+		opts...,
 	)
 	if err != nil {
 		if conn != nil {
@@ -0,0 +1,133 @@
+package peering
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync/atomic"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/golang/snappy"
+	"google.golang.org/grpc/encoding"
+)
+
+// compressorName is the gRPC content coding of compressed messages
+const compressorName = "madnet-snappy"
+
+// Every message written by the compressor starts with one of these flags.
+// Messages below the size threshold and messages snappy does not shrink are
+// sent as they are.
+const (
+	msgRaw byte = iota
+	msgSnappy
+)
+
+var errDecodedLen = errors.New("compressed message decodes beyond the size limit")
+
+func init() {
+	encoding.RegisterCompressor(&compressor{stats: compression})
+}
+
+// compressionStats counts the bytes of the messages the compressor handled
+// before and after compression
+type compressionStats struct {
+	in  uint64
+	out uint64
+	// export publishes the stats as metrics
+	export bool
+}
+
+// compression holds the stats of every connection of the node
+var compression = &compressionStats{export: true}
+
+func (cs *compressionStats) add(in, out int) {
+	atomic.AddUint64(&cs.in, uint64(in))
+	atomic.AddUint64(&cs.out, uint64(out))
+	if !cs.export {
+		return
+	}
+	metrics.GetOrRegisterCounter("compression/bytes_in").Inc(int64(in))
+	metrics.GetOrRegisterCounter("compression/bytes_out").Inc(int64(out))
+	metrics.GetOrRegisterGaugeFloat64("compression/ratio").Update(cs.ratio())
+}
+
+// ratio returns the bytes sent for every byte of message. It is 1 until a
+// message was sent.
+func (cs *compressionStats) ratio() float64 {
+	in := atomic.LoadUint64(&cs.in)
+	if in == 0 {
+		return 1
+	}
+	return float64(atomic.LoadUint64(&cs.out)) / float64(in)
+}
+
+// compressor is a gRPC compressor that snappy compresses the messages of at
+// least constants.CompressionThreshold bytes. It is only used on connections
+// where both peers negotiated types.CompressionCapability.
+type compressor struct {
+	stats *compressionStats
+}
+
+func (c *compressor) Name() string {
+	return compressorName
+}
+
+func (c *compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &compressWriter{w: w, stats: c.stats}, nil
+}
+
+func (c *compressor) Decompress(r io.Reader) (io.Reader, error) {
+	msg, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	switch msg[0] {
+	case msgRaw:
+		return bytes.NewReader(msg[1:]), nil
+	case msgSnappy:
+		n, err := snappy.DecodedLen(msg[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n > constants.CompressionMaxDecodedLen {
+			return nil, errDecodedLen
+		}
+		buf, err := snappy.Decode(nil, msg[1:])
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(buf), nil
+	default:
+		return nil, snappy.ErrCorrupt
+	}
+}
+
+// compressWriter buffers a message and writes it compressed on Close
+type compressWriter struct {
+	w     io.Writer
+	buf   bytes.Buffer
+	stats *compressionStats
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	return cw.buf.Write(p)
+}
+
+func (cw *compressWriter) Close() error {
+	msg := cw.buf.Bytes()
+	out := append([]byte{msgRaw}, msg...)
+	if len(msg) >= constants.CompressionThreshold {
+		enc := snappy.Encode(nil, msg)
+		if len(enc) < len(msg) {
+			out = append([]byte{msgSnappy}, enc...)
+		}
+	}
+	cw.stats.add(len(msg), len(out))
+	_, err := cw.w.Write(out)
+	return err
+}
@@ -0,0 +1,95 @@
+package peering
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/metrics"
+	"github.com/golang/snappy"
+)
+
+func compressMsg(t *testing.T, c *compressor, msg []byte) []byte {
+	buf := &bytes.Buffer{}
+	w, err := c.Compress(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestCompressor(t *testing.T) {
+	random := make([]byte, 4*constants.CompressionThreshold)
+	if _, err := rand.Read(random); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		msg  []byte
+		flag byte
+	}{
+		{"small", bytes.Repeat([]byte{1}, constants.CompressionThreshold-1), msgRaw},
+		{"large", bytes.Repeat([]byte{1}, 4*constants.CompressionThreshold), msgSnappy},
+		{"incompressible", random, msgRaw},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &compressor{stats: &compressionStats{}}
+			wire := compressMsg(t, c, tt.msg)
+			if wire[0] != tt.flag {
+				t.Fatalf("flag %d", wire[0])
+			}
+			r, err := c.Decompress(bytes.NewReader(wire))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tt.msg) {
+				t.Fatal("message does not round trip")
+			}
+			if tt.flag == msgSnappy && c.stats.ratio() >= 0.5 {
+				t.Fatalf("ratio %f", c.stats.ratio())
+			}
+			if tt.flag == msgRaw && c.stats.ratio() <= 1 {
+				t.Fatalf("ratio %f", c.stats.ratio())
+			}
+		})
+	}
+}
+
+func TestCompressorDecodedLen(t *testing.T) {
+	c := &compressor{stats: &compressionStats{}}
+	bomb := append([]byte{msgSnappy}, snappy.Encode(nil, make([]byte, constants.CompressionMaxDecodedLen+1))...)
+	if _, err := c.Decompress(bytes.NewReader(bomb)); err != errDecodedLen {
+		t.Fatalf("oversized message decoded: %v", err)
+	}
+	if _, err := c.Decompress(bytes.NewReader([]byte{7, 1, 2})); err == nil {
+		t.Fatal("unknown flag decoded")
+	}
+	if _, err := c.Decompress(bytes.NewReader(nil)); err == nil {
+		t.Fatal("empty message decoded")
+	}
+}
+
+func TestCompressionMetrics(t *testing.T) {
+	cs := &compressionStats{export: true}
+	in := metrics.GetOrRegisterCounter("compression/bytes_in").Count()
+	cs.add(100, 25)
+	if r := metrics.GetOrRegisterGaugeFloat64("compression/ratio").Value(); r != 0.25 {
+		t.Fatalf("ratio metric %f", r)
+	}
+	if n := metrics.GetOrRegisterCounter("compression/bytes_in").Count(); n != in+100 {
+		t.Fatalf("bytes in metric %d", n)
+	}
+}
@@ -0,0 +1,272 @@
+package peering
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/types"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/peer"
+)
+
+var _ interfaces.DHTServer = (*DHT)(nil)
+
+// DHT is a Kademlia style distributed hash table used for peer discovery.
+// Every node keeps a routing table of peers bucketed by their distance from
+// the node and answers FindNode requests from it. A lookup repeatedly asks
+// the closest known peers for peers closer to a target until no closer
+// peers are found. Bootnodes are ordinary DHT nodes that seed the table.
+// Peers are exchanged as signed node records which are verified before a
+// peer is added to the table or returned by a lookup.
+type DHT struct {
+	logger        *logrus.Logger
+	transport     interfaces.P2PTransport
+	table         *routingTable
+	records       *nodeRecords
+	clientHandler *clientHandler
+	serverHandler *ServerHandler
+}
+
+// NewDHT returns a DHT that serves and dials lookups over p2pTransport
+func NewDHT(logger *logrus.Logger, p2pTransport interfaces.P2PTransport) (*DHT, error) {
+	table, err := newRoutingTable(p2pTransport.NodeAddr())
+	if err != nil {
+		utils.DebugTrace(logger, err)
+		return nil, err
+	}
+	d := &DHT{
+		logger:        logger,
+		transport:     p2pTransport,
+		table:         table,
+		records:       newNodeRecords(p2pTransport.NodeAddr().ChainID()),
+		clientHandler: newClientHandler(),
+	}
+	d.serverHandler = NewDHTServerHandler(logger, p2pTransport.NodeAddr(), d)
+	return d, nil
+}
+
+// Close stops serving lookups
+func (d *DHT) Close() error {
+	return d.serverHandler.Close()
+}
+
+// HandleConnection serves the FindNode requests of a remote peer on conn.
+// The connection is closed once the remote peer is done or after a timeout,
+// whichever comes first.
+func (d *DHT) HandleConnection(conn interfaces.P2PConn) {
+	if err := d.serverHandler.HandleConnection(conn); err != nil {
+		return
+	}
+	select {
+	case <-conn.CloseChan():
+	case <-time.After(2 * constants.DHTQueryTimeout):
+		if err := conn.Close(); err != nil {
+			utils.DebugTrace(d.logger, err)
+		}
+	}
+}
+
+// Add adds peers the node trusts, such as the bootnodes and connected
+// peers, to the routing table. Peers on another chain are ignored.
+func (d *DHT) Add(addrs ...interfaces.NodeAddr) {
+	for _, addr := range addrs {
+		if addr.ChainID() != d.transport.NodeAddr().ChainID() {
+			continue
+		}
+		d.table.add(addr, nil)
+	}
+}
+
+// Len returns the number of peers in the routing table
+func (d *DHT) Len() int {
+	return d.table.len()
+}
+
+// FindNode returns the records of the peers of the routing table closest to
+// the target of the request. The caller is added to the routing table if its
+// record is valid.
+func (d *DHT) FindNode(ctx context.Context, req *pb.FindNodeRequest) (*pb.FindNodeResponse, error) {
+	target, err := hex.DecodeString(req.Target)
+	if err != nil || len(target) != dhtKeyLen {
+		return nil, errorz.ErrInvalid{}.New("invalid DHT target")
+	}
+	var key dhtKey
+	copy(key[:], target)
+	exclude := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		if caller, ok := p.Addr.(interfaces.NodeAddr); ok {
+			exclude = caller.Identity()
+			addr, record, err := d.records.verify(req.Record, caller)
+			if err != nil {
+				utils.DebugTrace(d.logger, err)
+			} else if addr.Identity() == caller.Identity() {
+				d.table.add(addr, record)
+			}
+		}
+	}
+	resp := &pb.FindNodeResponse{}
+	for _, e := range d.table.closest(key, constants.DHTBucketSize, exclude) {
+		if e.record != nil {
+			resp.Records = append(resp.Records, e.record)
+		}
+	}
+	return resp, nil
+}
+
+// findNode asks the peer at addr for the peers it knows closest to target
+func (d *DHT) findNode(ctx context.Context, addr interfaces.NodeAddr, target dhtKey) ([]*dhtEntry, error) {
+	conn, err := d.transport.Dial(addr, types.DHTProtocol)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	gconn, err := d.clientHandler.HandleConnection(conn)
+	if err != nil {
+		return nil, err
+	}
+	defer gconn.Close()
+	subCtx, cf := context.WithTimeout(ctx, constants.DHTQueryTimeout)
+	defer cf()
+	req := &pb.FindNodeRequest{
+		Target: hex.EncodeToString(target[:]),
+		Record: d.transport.NodeRecord(),
+	}
+	resp, err := pb.NewDHTClient(gconn).FindNode(subCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	peers := []*dhtEntry{}
+	for i := 0; i < len(resp.Records) && i < constants.DHTBucketSize; i++ {
+		p, record, err := d.records.verify(resp.Records[i], addr)
+		if err != nil {
+			utils.DebugTrace(d.logger, err)
+			continue
+		}
+		key, err := dhtKeyOf(p.Identity())
+		if err != nil {
+			continue
+		}
+		peers = append(peers, &dhtEntry{addr: p, key: key, record: record})
+	}
+	return peers, nil
+}
+
+// lookupEntry is a candidate peer of a lookup
+type lookupEntry struct {
+	*dhtEntry
+	queried bool
+}
+
+type findNodeResult struct {
+	entry *lookupEntry
+	peers []*dhtEntry
+	err   error
+}
+
+// Lookup runs an iterative lookup for target and returns the responsive
+// peers found closest to it.
+func (d *DHT) Lookup(ctx context.Context, target dhtKey) []interfaces.NodeAddr {
+	self := d.transport.NodeAddr().Identity()
+	seen := map[string]bool{self: true}
+	shortlist := []*lookupEntry{}
+	for _, e := range d.table.closest(target, constants.DHTBucketSize, self) {
+		seen[e.addr.Identity()] = true
+		shortlist = append(shortlist, &lookupEntry{dhtEntry: e})
+	}
+	for {
+		// query the closest peers that have not been queried yet
+		pending := []*lookupEntry{}
+		for _, e := range shortlist {
+			if !e.queried && len(pending) < constants.DHTAlpha {
+				e.queried = true
+				pending = append(pending, e)
+			}
+		}
+		if len(pending) == 0 {
+			break
+		}
+		results := make(chan *findNodeResult, len(pending))
+		wg := sync.WaitGroup{}
+		for _, e := range pending {
+			wg.Add(1)
+			go func(e *lookupEntry) {
+				defer wg.Done()
+				peers, err := d.findNode(ctx, e.addr, target)
+				results <- &findNodeResult{entry: e, peers: peers, err: err}
+			}(e)
+		}
+		wg.Wait()
+		close(results)
+		failed := map[*lookupEntry]bool{}
+		for r := range results {
+			if r.err != nil {
+				utils.DebugTrace(d.logger, r.err)
+				d.table.fail(r.entry.addr)
+				failed[r.entry] = true
+				continue
+			}
+			d.table.add(r.entry.addr, r.entry.record)
+			for _, p := range r.peers {
+				if seen[p.addr.Identity()] {
+					continue
+				}
+				seen[p.addr.Identity()] = true
+				shortlist = append(shortlist, &lookupEntry{dhtEntry: p})
+			}
+		}
+		// keep the closest peers that have not failed
+		next := []*lookupEntry{}
+		for _, e := range shortlist {
+			if !failed[e] {
+				next = append(next, e)
+			}
+		}
+		sort.Slice(next, func(i, j int) bool {
+			return target.closer(next[i].key, next[j].key)
+		})
+		if len(next) > constants.DHTBucketSize {
+			next = next[:constants.DHTBucketSize]
+		}
+		shortlist = next
+		select {
+		case <-ctx.Done():
+			return d.found(shortlist)
+		default:
+		}
+	}
+	return d.found(shortlist)
+}
+
+// found returns the peers of the shortlist that answered the lookup
+func (d *DHT) found(shortlist []*lookupEntry) []interfaces.NodeAddr {
+	peers := []interfaces.NodeAddr{}
+	for _, e := range shortlist {
+		if e.queried {
+			peers = append(peers, e.addr)
+		}
+	}
+	return peers
+}
+
+// LookupRandom runs a lookup for a random target. This both discovers new
+// peers and keeps the routing table fresh.
+func (d *DHT) LookupRandom(ctx context.Context) ([]interfaces.NodeAddr, error) {
+	addr, err := transport.RandomNodeAddr()
+	if err != nil {
+		return nil, err
+	}
+	target, err := dhtKeyOf(addr.Identity())
+	if err != nil {
+		return nil, err
+	}
+	return d.Lookup(ctx, target), nil
+}
@@ -0,0 +1,178 @@
+package peering
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto/secp256k1"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/types"
+	"github.com/sirupsen/logrus"
+)
+
+// pipeConn is a P2PConn over an in memory pipe
+type pipeConn struct {
+	net.Conn
+	nodeAddr  interfaces.NodeAddr
+	initiator types.P2PInitiator
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func newPipeConn(conn net.Conn, nodeAddr interfaces.NodeAddr, initiator types.P2PInitiator) *pipeConn {
+	return &pipeConn{Conn: conn, nodeAddr: nodeAddr, initiator: initiator, closeChan: make(chan struct{})}
+}
+
+func (pc *pipeConn) Close() error {
+	pc.closeOnce.Do(func() { close(pc.closeChan) })
+	return pc.Conn.Close()
+}
+
+func (pc *pipeConn) RemoteAddr() net.Addr             { return pc.nodeAddr }
+func (pc *pipeConn) Initiator() types.P2PInitiator    { return pc.initiator }
+func (pc *pipeConn) NodeAddr() interfaces.NodeAddr    { return pc.nodeAddr }
+func (pc *pipeConn) Protocol() types.Protocol         { return types.DHTProtocol }
+func (pc *pipeConn) ProtoVersion() types.ProtoVersion { return 1 }
+func (pc *pipeConn) Capabilities() types.Capabilities { return types.CompressionCapability }
+func (pc *pipeConn) CloseChan() <-chan struct{}       { return pc.closeChan }
+func (pc *pipeConn) BytesIn() uint64                  { return 0 }
+func (pc *pipeConn) BytesOut() uint64                 { return 0 }
+
+// pipeNetwork connects the DHTs of its nodes with pipes
+type pipeNetwork struct {
+	sync.Mutex
+	nodes map[string]*DHT
+}
+
+type pipeTransport struct {
+	interfaces.P2PTransport
+	network  *pipeNetwork
+	nodeAddr interfaces.NodeAddr
+	record   []byte
+}
+
+func (pt *pipeTransport) NodeAddr() interfaces.NodeAddr {
+	return pt.nodeAddr
+}
+
+func (pt *pipeTransport) NodeRecord() []byte {
+	return pt.record
+}
+
+func (pt *pipeTransport) Dial(addr interfaces.NodeAddr, protocol types.Protocol) (interfaces.P2PConn, error) {
+	pt.network.Lock()
+	remote, ok := pt.network.nodes[addr.Identity()]
+	pt.network.Unlock()
+	if !ok {
+		return nil, errTestDial
+	}
+	local, other := net.Pipe()
+	go remote.HandleConnection(newPipeConn(other, pt.nodeAddr, types.PeerInitiatedConnection))
+	return newPipeConn(local, addr, types.SelfInitiatedConnection), nil
+}
+
+// testRecordTime is the time the sequence numbers of test records count from
+var testRecordTime = uint64(time.Now().UnixNano())
+
+// testSignedNodeAddr returns a node address with its signed node record
+func testSignedNodeAddr(t *testing.T, port int, seq uint64) (interfaces.NodeAddr, []byte) {
+	privk, err := transport.NewTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testSignedNodeAddrWithKey(t, privk, port, seq)
+}
+
+func testSignedNodeAddrWithKey(t *testing.T, privk string, port int, seq uint64) (interfaces.NodeAddr, []byte) {
+	privkBytes, err := hex.DecodeString(privk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pubk := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privkBytes)
+	addr, err := transport.NewNodeAddr(fmt.Sprintf("0000002a|%x@127.0.0.1:%d", pubk.SerializeCompressed(), port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, err := transport.SignNodeRecord(privk, addr, testRecordTime+seq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr, record
+}
+
+func (pn *pipeNetwork) newNode(t *testing.T, port int) *DHT {
+	addr, record := testSignedNodeAddr(t, port, 1)
+	pt := &pipeTransport{network: pn, nodeAddr: addr, record: record}
+	d, err := NewDHT(logrus.New(), pt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pn.Lock()
+	defer pn.Unlock()
+	pn.nodes[pt.nodeAddr.Identity()] = d
+	return d
+}
+
+func TestDHTLookup(t *testing.T) {
+	pn := &pipeNetwork{nodes: make(map[string]*DHT)}
+	seed := pn.newNode(t, 5000)
+	defer seed.Close()
+	// a full bucket of the seed never drops a node
+	nodes := []*DHT{}
+	for i := 0; i < constants.DHTBucketSize; i++ {
+		d := pn.newNode(t, 5001+i)
+		defer d.Close()
+		d.Add(seed.transport.NodeAddr())
+		nodes = append(nodes, d)
+	}
+	ctx, cf := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cf()
+	for _, d := range nodes {
+		if _, err := d.LookupRandom(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if seed.Len() != len(nodes) {
+		t.Fatalf("seed knows %d of %d nodes", seed.Len(), len(nodes))
+	}
+
+	joiner := pn.newNode(t, 6000)
+	defer joiner.Close()
+	joiner.Add(seed.transport.NodeAddr())
+	want := nodes[len(nodes)-1].transport.NodeAddr()
+	target, err := dhtKeyOf(want.Identity())
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := joiner.Lookup(ctx, target)
+	if len(found) == 0 || found[0].Identity() != want.Identity() {
+		t.Fatalf("lookup did not find the target: %v", found)
+	}
+	if joiner.Len() < 2 {
+		t.Fatalf("joiner learned of %d nodes", joiner.Len())
+	}
+
+	// unreachable peers are dropped from the routing table
+	pn.Lock()
+	delete(pn.nodes, seed.transport.NodeAddr().Identity())
+	pn.Unlock()
+	seedKey, err := dhtKeyOf(seed.transport.NodeAddr().Identity())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < constants.DHTMaxFailures; i++ {
+		joiner.Lookup(ctx, seedKey)
+	}
+	for _, e := range joiner.table.closest(seedKey, constants.DHTBucketSize, "") {
+		if e.key == seedKey {
+			t.Fatal("unreachable seed not dropped")
+		}
+	}
+}
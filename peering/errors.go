@@ -0,0 +1,28 @@
+package peering
+
+import "errors"
+
+var (
+
+	// ErrPeerNotActive occurs in Disconnect when the node is not connected
+	// to the peer.
+	ErrPeerNotActive = errors.New("peer is not active")
+
+	// ErrPeerBanned occurs in Dial when the peer is banned for its
+	// reputation.
+	ErrPeerBanned = errors.New("peer is banned")
+
+	// ErrDialSelf occurs in Dial when the address is the local node.
+	ErrDialSelf = errors.New("cannot dial the local node")
+
+	// ErrWrongChain occurs in Dial when the address is for another chain.
+	ErrWrongChain = errors.New("peer is on a different chain")
+
+	// ErrHandshakeFailed occurs in Dial when the connection to the peer
+	// was made but the peer did not become active.
+	ErrHandshakeFailed = errors.New("peer handshake failed")
+
+	// ErrStaleNodeRecord occurs when a node record is older than the
+	// latest record seen for the same node or than the maximum record age.
+	ErrStaleNodeRecord = errors.New("stale node record")
+)
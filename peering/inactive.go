@@ -93,20 +93,20 @@ func (ps *inactivePeerStore) randomPop() (interfaces.NodeAddr, bool) {
 
 // get a random peer. intended to provide random peers when a remote
 // peer performs a discovery dial against the local node
-func (ps *inactivePeerStore) random() (string, bool) {
+func (ps *inactivePeerStore) random() (interfaces.NodeAddr, bool) {
 	ps.RLock()
 	defer ps.RUnlock()
 	if len(ps.store) == 0 {
-		return "", false
+		return nil, false
 	}
 	index, err := randomElement(len(ps.store))
 	if err != nil {
-		return "", false
+		return nil, false
 	}
 	i := 0
 	for _, v := range ps.store {
 		if i == index {
-			return v.P2PAddr(), true
+			return v, true
 		}
 		i++
 	}
@@ -0,0 +1,181 @@
+package peering
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/bits"
+	"sort"
+	"sync"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/interfaces"
+)
+
+// dhtKeyLen is the length of the keys of the DHT in bytes
+const dhtKeyLen = constants.HashLen
+
+// dhtKey is the position of a peer in the DHT. It is the hash of the
+// identity of the peer so peers are spread evenly over the key space.
+type dhtKey [dhtKeyLen]byte
+
+// dhtKeyOf returns the key of the peer with the hex encoded identity ident
+func dhtKeyOf(ident string) (dhtKey, error) {
+	var key dhtKey
+	pubk, err := hex.DecodeString(ident)
+	if err != nil {
+		return key, err
+	}
+	copy(key[:], crypto.Hasher(pubk))
+	return key, nil
+}
+
+// distance returns the XOR distance between the keys
+func (k dhtKey) distance(other dhtKey) dhtKey {
+	var d dhtKey
+	for i := 0; i < dhtKeyLen; i++ {
+		d[i] = k[i] ^ other[i]
+	}
+	return d
+}
+
+// closer returns true if a is closer to k than b
+func (k dhtKey) closer(a, b dhtKey) bool {
+	da := k.distance(a)
+	db := k.distance(b)
+	return bytes.Compare(da[:], db[:]) < 0
+}
+
+// bucket returns the index of the bucket other falls in relative to k,
+// which is the length of the prefix the keys share. It returns -1 if the
+// keys are equal.
+func (k dhtKey) bucket(other dhtKey) int {
+	d := k.distance(other)
+	for i := 0; i < dhtKeyLen; i++ {
+		if d[i] != 0 {
+			return i*8 + bits.LeadingZeros8(d[i])
+		}
+	}
+	return -1
+}
+
+// dhtEntry is a peer in the routing table. The record is the signed node
+// record of the peer if one was received and is what FindNode hands out.
+type dhtEntry struct {
+	addr     interfaces.NodeAddr
+	key      dhtKey
+	record   []byte
+	failures int
+}
+
+// routingTable holds the k-buckets of the DHT. Each bucket is ordered from
+// the least to the most recently seen peer. Long lived peers are preferred
+// so a full bucket only takes a new peer in place of one that is failing.
+type routingTable struct {
+	sync.Mutex
+	self    dhtKey
+	buckets [dhtKeyLen * 8][]*dhtEntry
+}
+
+func newRoutingTable(self interfaces.NodeAddr) (*routingTable, error) {
+	key, err := dhtKeyOf(self.Identity())
+	if err != nil {
+		return nil, err
+	}
+	return &routingTable{self: key}, nil
+}
+
+// add records that addr was seen and returns true if it is in the table.
+// A nil record keeps the record already known for the peer.
+func (rt *routingTable) add(addr interfaces.NodeAddr, record []byte) bool {
+	key, err := dhtKeyOf(addr.Identity())
+	if err != nil {
+		return false
+	}
+	idx := rt.self.bucket(key)
+	if idx < 0 {
+		return false
+	}
+	rt.Lock()
+	defer rt.Unlock()
+	b := rt.buckets[idx]
+	for i := 0; i < len(b); i++ {
+		if b[i].key == key {
+			e := b[i]
+			e.addr = addr
+			e.failures = 0
+			if record != nil {
+				e.record = record
+			}
+			rt.buckets[idx] = append(append(b[:i:i], b[i+1:]...), e)
+			return true
+		}
+	}
+	e := &dhtEntry{addr: addr, key: key, record: record}
+	if len(b) < constants.DHTBucketSize {
+		rt.buckets[idx] = append(b, e)
+		return true
+	}
+	if b[0].failures > 0 {
+		rt.buckets[idx] = append(b[1:len(b):len(b)], e)
+		return true
+	}
+	return false
+}
+
+// fail records a failed query to addr and drops the peer from the table
+// once it fails too often.
+func (rt *routingTable) fail(addr interfaces.NodeAddr) {
+	key, err := dhtKeyOf(addr.Identity())
+	if err != nil {
+		return
+	}
+	idx := rt.self.bucket(key)
+	if idx < 0 {
+		return
+	}
+	rt.Lock()
+	defer rt.Unlock()
+	b := rt.buckets[idx]
+	for i := 0; i < len(b); i++ {
+		if b[i].key == key {
+			b[i].failures++
+			if b[i].failures >= constants.DHTMaxFailures {
+				rt.buckets[idx] = append(b[:i:i], b[i+1:]...)
+			}
+			return
+		}
+	}
+}
+
+// closest returns up to n peers of the table closest to target, skipping
+// the peer with identity exclude.
+func (rt *routingTable) closest(target dhtKey, n int, exclude string) []*dhtEntry {
+	rt.Lock()
+	all := []*dhtEntry{}
+	for i := 0; i < len(rt.buckets); i++ {
+		for _, e := range rt.buckets[i] {
+			if e.addr.Identity() != exclude {
+				all = append(all, &dhtEntry{addr: e.addr, key: e.key, record: e.record})
+			}
+		}
+	}
+	rt.Unlock()
+	sort.Slice(all, func(i, j int) bool {
+		return target.closer(all[i].key, all[j].key)
+	})
+	if len(all) > n {
+		all = all[:n]
+	}
+	return all
+}
+
+func (rt *routingTable) len() int {
+	rt.Lock()
+	defer rt.Unlock()
+	count := 0
+	for i := 0; i < len(rt.buckets); i++ {
+		count += len(rt.buckets[i])
+	}
+	return count
+}
@@ -0,0 +1,74 @@
+package peering
+
+import (
+	"testing"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/interfaces"
+)
+
+func TestRoutingTable(t *testing.T) {
+	self := testNodeAddr(t, 5000)
+	rt, err := newRoutingTable(self)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rt.add(self, nil) {
+		t.Fatal("added self")
+	}
+	// fill one bucket past its size
+	var full []interfaces.NodeAddr
+	var rejected interfaces.NodeAddr
+	for port := 5001; rejected == nil; port++ {
+		addr := testNodeAddr(t, port)
+		key, err := dhtKeyOf(addr.Identity())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rt.self.bucket(key) != 0 {
+			continue
+		}
+		if len(full) < constants.DHTBucketSize {
+			if !rt.add(addr, nil) {
+				t.Fatal("bucket not filled")
+			}
+			full = append(full, addr)
+			continue
+		}
+		if rt.add(addr, nil) {
+			t.Fatal("full bucket took a new peer")
+		}
+		rejected = addr
+	}
+	// a failing peer makes room once it is the least recently seen
+	rt.fail(full[1])
+	if rt.add(rejected, nil) {
+		t.Fatal("replaced a peer that was not the oldest")
+	}
+	rt.fail(full[0])
+	if !rt.add(rejected, nil) {
+		t.Fatal("failing peer not replaced")
+	}
+	rt.fail(full[1])
+	if rt.len() != constants.DHTBucketSize-1 {
+		t.Fatalf("failed peer not dropped: %d", rt.len())
+	}
+
+	target, err := dhtKeyOf(full[5].Identity())
+	if err != nil {
+		t.Fatal(err)
+	}
+	closest := rt.closest(target, 3, "")
+	if len(closest) != 3 || closest[0].addr.Identity() != full[5].Identity() {
+		t.Fatal("closest does not start at the target")
+	}
+	for i := 1; i < len(closest); i++ {
+		if target.closer(closest[i].key, closest[i-1].key) {
+			t.Fatal("closest not sorted")
+		}
+	}
+	closest = rt.closest(target, 3, full[5].Identity())
+	if closest[0].addr.Identity() == full[5].Identity() {
+		t.Fatal("excluded peer returned")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
 	"github.com/sirupsen/logrus"
 )
 
@@ -61,7 +62,7 @@ func (rpcl *Listener) Accept() (net.Conn, error) {
 	for {
 		select {
 		case p2pconn := <-rpcl.listenConn:
-			return p2pconn, nil
+			return &serverConn{p2pconn}, nil
 		case <-rpcl.quit:
 			return nil, errors.New("rpc server listener closed")
 		}
@@ -78,3 +79,35 @@ func NewListener(logger *logrus.Logger, addr net.Addr) interfaces.RPCListener {
 		log:        logger,
 	}
 }
+
+// serverConn is a connection handed to a grpc server. Its remote address is
+// an interfaces.PeerAddr so handlers find the protocol negotiated with the
+// peer in the peer of their context.
+type serverConn struct {
+	interfaces.P2PConn
+}
+
+// RemoteAddr See docs for net.Conn
+func (c *serverConn) RemoteAddr() net.Addr {
+	return &peerAddr{
+		NodeAddr:     c.NodeAddr(),
+		protoVersion: c.ProtoVersion(),
+		capabilities: c.Capabilities(),
+	}
+}
+
+var _ interfaces.PeerAddr = (*peerAddr)(nil)
+
+type peerAddr struct {
+	interfaces.NodeAddr
+	protoVersion types.ProtoVersion
+	capabilities types.Capabilities
+}
+
+func (a *peerAddr) ProtoVersion() types.ProtoVersion {
+	return a.protoVersion
+}
+
+func (a *peerAddr) Capabilities() types.Capabilities {
+	return a.capabilities
+}
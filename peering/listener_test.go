@@ -0,0 +1,42 @@
+package peering
+
+import (
+	"testing"
+
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
+	"github.com/sirupsen/logrus"
+)
+
+type addrConn struct {
+	legacyConn
+	addr interfaces.NodeAddr
+}
+
+func (ac *addrConn) NodeAddr() interfaces.NodeAddr {
+	return ac.addr
+}
+
+func TestListenerPeerAddr(t *testing.T) {
+	addr := testNodeAddr(t, 4001)
+	l := NewListener(logrus.New(), addr)
+	defer l.Close()
+	go func() {
+		if err := l.NewConnection(&addrConn{addr: addr}); err != nil {
+			panic(err)
+		}
+	}()
+	conn, err := l.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// handlers of a grpc server find the peer address in their context
+	remote := conn.RemoteAddr()
+	pa, ok := remote.(interfaces.PeerAddr)
+	if !ok {
+		t.Fatalf("remote address %T is not a PeerAddr", remote)
+	}
+	if pa.Identity() != addr.Identity() || pa.ProtoVersion() != 1 || pa.Capabilities().Has(types.CompressionCapability) {
+		t.Fatalf("bad peer address %v %d %d", pa, pa.ProtoVersion(), pa.Capabilities())
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	interfaces "github.com/MadBase/MadNet/interfaces"
 	proto "github.com/MadBase/MadNet/proto"
+	types "github.com/MadBase/MadNet/types"
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
 )
@@ -37,6 +38,20 @@ func (m *MockP2PClient) EXPECT() *MockP2PClientMockRecorder {
 	return m.recorder
 }
 
+// Capabilities mocks base method
+func (m *MockP2PClient) Capabilities() types.Capabilities {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capabilities")
+	ret0, _ := ret[0].(types.Capabilities)
+	return ret0
+}
+
+// Capabilities indicates an expected call of Capabilities
+func (mr *MockP2PClientMockRecorder) Capabilities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capabilities", reflect.TypeOf((*MockP2PClient)(nil).Capabilities))
+}
+
 // Close mocks base method
 func (m *MockP2PClient) Close() error {
 	m.ctrl.T.Helper()
@@ -379,6 +394,20 @@ func (mr *MockP2PClientMockRecorder) NodeAddr() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeAddr", reflect.TypeOf((*MockP2PClient)(nil).NodeAddr))
 }
 
+// ProtoVersion mocks base method
+func (m *MockP2PClient) ProtoVersion() types.ProtoVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProtoVersion")
+	ret0, _ := ret[0].(types.ProtoVersion)
+	return ret0
+}
+
+// ProtoVersion indicates an expected call of ProtoVersion
+func (mr *MockP2PClientMockRecorder) ProtoVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProtoVersion", reflect.TypeOf((*MockP2PClient)(nil).ProtoVersion))
+}
+
 // Status mocks base method
 func (m *MockP2PClient) Status(arg0 context.Context, arg1 *proto.StatusRequest, arg2 ...grpc.CallOption) (*proto.StatusResponse, error) {
 	m.ctrl.T.Helper()
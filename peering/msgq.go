@@ -188,6 +188,8 @@ type msgQueue struct {
 	key       []byte
 	draining  bool
 	logger    *logrus.Logger
+	// priority is a queue whose messages are sent before those of this one
+	priority *msgQueue
 }
 
 func newMsgQueue(max int, wc int, peer interfaces.Peer) (*msgQueue, error) {
@@ -288,6 +290,13 @@ func (mq *msgQueue) Add(msg []byte, fn func(ctx context.Context, peer interfaces
 	}
 }
 
+// depth returns the number of messages waiting to be sent
+func (mq *msgQueue) depth() int {
+	mq.RLock()
+	defer mq.RUnlock()
+	return len(mq.tasks)
+}
+
 func (mq *msgQueue) cleanupWorker() {
 	for {
 		select {
@@ -369,6 +378,7 @@ func (mq *msgQueue) worker() {
 			}
 		}
 		if currentTask != nil {
+			mq.yield(currentTask)
 			mq.sendWithRetry(currentTask)
 			mq.Lock()
 			_, ok := mq.tasks[currentTask.name]
@@ -380,6 +390,23 @@ func (mq *msgQueue) worker() {
 	}
 }
 
+// yield waits until the priority queue is empty so its messages get the
+// bandwidth to the peer first
+func (mq *msgQueue) yield(t *task) {
+	if mq.priority == nil {
+		return
+	}
+	for mq.priority.depth() > 0 {
+		select {
+		case <-mq.peer.CloseChan():
+			return
+		case <-t.ctx.Done():
+			return
+		case <-time.After(constants.MsgQYieldDelay):
+		}
+	}
+}
+
 func (mq *msgQueue) sendWithRetry(t *task) {
 	defer t.cancel()
 	backoffCount := 0
@@ -0,0 +1,60 @@
+package peering
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/interfaces"
+)
+
+type testPeer struct {
+	interfaces.Peer
+	closeChan chan struct{}
+}
+
+func (tp *testPeer) CloseChan() <-chan struct{} {
+	return tp.closeChan
+}
+
+func TestMsgQueuePriority(t *testing.T) {
+	peer := &testPeer{closeChan: make(chan struct{})}
+	defer close(peer.closeChan)
+	consensusQueue, err := newMsgQueue(16, 1, peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txQueue, err := newMsgQueue(16, 1, peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txQueue.priority = consensusQueue
+
+	sent := make(chan string, 2)
+	release := make(chan struct{})
+	consensusQueue.Add([]byte("consensus"), func(context.Context, interfaces.PeerLease) error {
+		<-release
+		sent <- "consensus"
+		return nil
+	})
+	txQueue.Add([]byte("tx"), func(context.Context, interfaces.PeerLease) error {
+		sent <- "tx"
+		return nil
+	})
+	select {
+	case msg := <-sent:
+		t.Fatalf("%s sent while consensus message is waiting", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	for _, want := range []string{"consensus", "tx"} {
+		select {
+		case msg := <-sent:
+			if msg != want {
+				t.Fatalf("sent %s before %s", msg, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%s not sent", want)
+		}
+	}
+}
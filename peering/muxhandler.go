@@ -1,8 +1,8 @@
 package peering
 
 import (
-	"net"
 	"sync"
+	"time"
 
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/interfaces"
@@ -73,6 +73,7 @@ func (rpcm *MuxHandler) gRPCserverHandler(conn interfaces.P2PMuxConn) (interface
 		P2PClientRaw: client,
 		nodeAddr:     conn.NodeAddr(),
 		conn:         conn,
+		connectedAt:  time.Now(),
 	}
 	c.consensusQueue, err = newMsgQueue(constants.ConsensusMsgQSize, constants.ConsensusMsgQWorkers, c)
 	if err != nil {
@@ -82,6 +83,7 @@ func (rpcm *MuxHandler) gRPCserverHandler(conn interfaces.P2PMuxConn) (interface
 	if err != nil {
 		return nil, err
 	}
+	c.txQueue.priority = c.consensusQueue
 	return c, nil
 }
 
@@ -104,6 +106,7 @@ func (rpcm *MuxHandler) gRPCclientHandler(conn interfaces.P2PMuxConn) (interface
 		P2PClientRaw: client,
 		nodeAddr:     conn.NodeAddr(),
 		conn:         conn,
+		connectedAt:  time.Now(),
 	}
 	c.consensusQueue, err = newMsgQueue(constants.ConsensusMsgQSize, constants.ConsensusMsgQWorkers, c)
 	if err != nil {
@@ -113,13 +116,14 @@ func (rpcm *MuxHandler) gRPCclientHandler(conn interfaces.P2PMuxConn) (interface
 	if err != nil {
 		return nil, err
 	}
+	c.txQueue.priority = c.consensusQueue
 	return c, nil
 }
 
 // NewMuxServerHandler creates a new multiplexed grpc tunneling system for
 // P2PMuxConn objects.
-func NewMuxServerHandler(logger *logrus.Logger, addr net.Addr, service interfaces.P2PServer) *MuxHandler {
-	sh := newP2PServerHandler(logger, addr, service)
+func NewMuxServerHandler(logger *logrus.Logger, p2pTransport interfaces.P2PTransport, service interfaces.P2PServer) *MuxHandler {
+	sh := newP2PServerHandler(logger, p2pTransport, service)
 	ch := newClientHandler()
 	return &MuxHandler{
 		ch:     ch,
@@ -0,0 +1,83 @@
+package peering
+
+import (
+	"sync"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/types"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// nodeRecords holds the latest verified node record of each peer. Peers
+// learned through discovery are only added once their signed record checks
+// out. Records older than the latest one seen for a node or signed more than
+// constants.NodeRecordMaxAge ago are rejected as stale, so a peer can neither
+// forge the address of another node nor replay an old one, even after a
+// restart or once the node has been evicted from the cache.
+type nodeRecords struct {
+	sync.Mutex
+	chainID types.ChainIdentifier
+	// latest sequence number seen for each identity
+	seqs map[string]uint64
+	// records with a public host that may be handed on to other peers
+	records map[string][]byte
+	clock   utils.Clock
+}
+
+func newNodeRecords(chainID types.ChainIdentifier) *nodeRecords {
+	return &nodeRecords{
+		chainID: chainID,
+		seqs:    make(map[string]uint64),
+		records: make(map[string][]byte),
+	}
+}
+
+// verify checks a record received from the peer at from and stores it. It
+// returns the address to dial and the record if it may be handed on.
+func (nr *nodeRecords) verify(data []byte, from interfaces.NodeAddr) (interfaces.NodeAddr, []byte, error) {
+	rec, err := transport.UnmarshalNodeRecord(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rec.NodeAddr().ChainID() != nr.chainID {
+		return nil, nil, ErrWrongChain
+	}
+	addr, err := rec.Resolve(from)
+	if err != nil {
+		return nil, nil, err
+	}
+	if nr.clock.Now().Sub(rec.SignedAt()) > constants.NodeRecordMaxAge {
+		return nil, nil, ErrStaleNodeRecord
+	}
+	ident := addr.Identity()
+	nr.Lock()
+	defer nr.Unlock()
+	if seq, ok := nr.seqs[ident]; ok && rec.Seq() < seq {
+		return nil, nil, ErrStaleNodeRecord
+	}
+	if _, ok := nr.seqs[ident]; !ok && len(nr.seqs) >= constants.NodeRecordCacheSize {
+		for k := range nr.seqs {
+			delete(nr.seqs, k)
+			delete(nr.records, k)
+			break
+		}
+	}
+	nr.seqs[ident] = rec.Seq()
+	if !rec.Public() {
+		delete(nr.records, ident)
+		return addr, nil, nil
+	}
+	raw := rec.Marshal()
+	nr.records[ident] = raw
+	return addr, raw, nil
+}
+
+// get returns the record of the peer with identity ident
+func (nr *nodeRecords) get(ident string) ([]byte, bool) {
+	nr.Lock()
+	defer nr.Unlock()
+	raw, ok := nr.records[ident]
+	return raw, ok
+}
@@ -0,0 +1,62 @@
+package peering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/transport"
+)
+
+func TestNodeRecords(t *testing.T) {
+	nr := newNodeRecords(42)
+	privk, err := transport.NewTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := testNodeAddr(t, 5000)
+
+	addr, record := testSignedNodeAddrWithKey(t, privk, 6000, 2)
+	got, fwd, err := nr.verify(record, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.P2PAddr() != addr.P2PAddr() || fwd == nil {
+		t.Fatal("valid record not accepted")
+	}
+	if stored, ok := nr.get(addr.Identity()); !ok || string(stored) != string(record) {
+		t.Fatal("record not stored")
+	}
+
+	// an older record of the same node is stale
+	_, old := testSignedNodeAddrWithKey(t, privk, 6001, 1)
+	if _, _, err := nr.verify(old, from); err != ErrStaleNodeRecord {
+		t.Fatalf("stale record accepted: %v", err)
+	}
+	moved, newer := testSignedNodeAddrWithKey(t, privk, 6002, 3)
+	got, _, err = nr.verify(newer, from)
+	if err != nil || got.Port() != moved.Port() {
+		t.Fatal("newer record not accepted")
+	}
+
+	// a record signed too long ago is stale even if the node is unknown
+	expired := newNodeRecords(42)
+	expired.clock = func() time.Time { return time.Now().Add(constants.NodeRecordMaxAge + time.Minute) }
+	_, unknown := testSignedNodeAddr(t, 6003, 1)
+	if _, _, err := expired.verify(unknown, from); err != ErrStaleNodeRecord {
+		t.Fatalf("expired record accepted: %v", err)
+	}
+
+	// records of another chain are rejected
+	other := newNodeRecords(7)
+	if _, _, err := other.verify(record, from); err != ErrWrongChain {
+		t.Fatalf("record of another chain accepted: %v", err)
+	}
+
+	// a forged record does not verify
+	forged := append([]byte{}, record...)
+	forged[len(forged)-1] ^= 1
+	if _, _, err := nr.verify(forged, from); err == nil {
+		t.Fatal("forged record accepted")
+	}
+}
@@ -2,12 +2,37 @@ package peering
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/MadBase/MadNet/errorz"
 	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
 	"github.com/MadBase/MadNet/utils"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// reporter records the behavior of peers for their reputation
+type reporter interface {
+	Penalize(addr interfaces.NodeAddr, offense types.PeerOffense)
+	Reward(addr interfaces.NodeAddr)
+}
+
+// PeerInfo describes a connection to an active peer
+type PeerInfo struct {
+	Addr                interfaces.NodeAddr
+	Initiator           types.P2PInitiator
+	ProtoVersion        types.ProtoVersion
+	Capabilities        types.Capabilities
+	ConnectedSince      time.Time
+	ConsensusQueueDepth int
+	TxQueueDepth        int
+	BytesIn             uint64
+	BytesOut            uint64
+}
+
 type p2PClient struct {
 	logger *logrus.Logger
 	interfaces.P2PClientRaw
@@ -15,6 +40,8 @@ type p2PClient struct {
 	conn           interfaces.P2PMuxConn
 	consensusQueue *msgQueue
 	txQueue        *msgQueue
+	reporter       reporter
+	connectedAt    time.Time
 }
 
 func (c *p2PClient) Close() error {
@@ -29,11 +56,49 @@ func (c *p2PClient) NodeAddr() interfaces.NodeAddr {
 	return c.nodeAddr
 }
 
+// ProtoVersion returns the protocol version negotiated with the peer
+func (c *p2PClient) ProtoVersion() types.ProtoVersion {
+	return c.conn.ClientConn().ProtoVersion()
+}
+
+// Capabilities returns the optional protocol features the local node and
+// the peer both support
+func (c *p2PClient) Capabilities() types.Capabilities {
+	return c.conn.ClientConn().Capabilities()
+}
+
+// info describes the connection to the peer
+func (c *p2PClient) info() *PeerInfo {
+	return &PeerInfo{
+		Addr:                c.nodeAddr,
+		Initiator:           c.conn.Initiator(),
+		ProtoVersion:        c.ProtoVersion(),
+		Capabilities:        c.Capabilities(),
+		ConnectedSince:      c.connectedAt,
+		ConsensusQueueDepth: c.consensusQueue.depth(),
+		TxQueueDepth:        c.txQueue.depth(),
+		BytesIn:             c.conn.BytesIn(),
+		BytesOut:            c.conn.BytesOut(),
+	}
+}
+
 func (c *p2PClient) Do(fn func(interfaces.PeerLease) error) {
 	err := fn(c)
 	if err != nil {
 		utils.DebugTrace(c.logger, err)
 	}
+	if c.reporter == nil {
+		return
+	}
+	etestInvalid := &errorz.ErrInvalid{}
+	switch {
+	case err == nil:
+		c.reporter.Reward(c.nodeAddr)
+	case errors.As(err, &etestInvalid):
+		c.reporter.Penalize(c.nodeAddr, types.InvalidMessage)
+	case errors.Is(err, context.DeadlineExceeded), status.Code(err) == codes.DeadlineExceeded, status.Code(err) == codes.Unavailable:
+		c.reporter.Penalize(c.nodeAddr, types.UnresponsivePeer)
+	}
 }
 
 func (c *p2PClient) Contains(msg []byte) bool {
@@ -15,7 +15,9 @@ import (
 	"github.com/MadBase/MadNet/transport"
 	"github.com/MadBase/MadNet/types"
 	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/peer"
 )
 
 // PeerManager is a self contained system for management of peering.
@@ -35,9 +37,13 @@ type PeerManager struct {
 	p2pServerHandler         *MuxHandler
 	clientHandler            *clientHandler
 	bootNodes                *bootNodeList
+	dht                      *DHT
+	records                  *nodeRecords
 	transport                interfaces.P2PTransport
 	inactive                 *inactivePeerStore
 	active                   *activePeerStore
+	reputation               *reputationStore
+	addrBook                 *addrBook
 	subscribers              map[int]*PeerSubscription
 	subscriberCount          int
 	peeringCompleteThreshold int
@@ -48,29 +54,42 @@ type PeerManager struct {
 }
 
 // NewPeerManager creates a new peer manager based on the Configuration
-// values passed to the process.
-func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int, pLimMax int, fwMode bool, fwHost, listenAddr, tprivk string) (*PeerManager, error) {
+// values passed to the process. The peers the node connects to are kept in
+// db so discovery can resume from them after a restart. A nil db disables
+// the address book.
+func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int, pLimMax int, fwMode bool, fwHost, listenAddr, tprivk string, db *badger.DB) (*PeerManager, error) {
 	logger := logging.GetLogger(constants.LoggerPeerMan)
-	ctx := context.Background()
-	subCtx, cf := context.WithCancel(ctx)
+	// make sure bootnodes parse
+	if _, err := (&bootNodeList{}).randomBootNode(); err != nil {
+		utils.DebugTrace(logger, err)
+		return nil, err
+	}
 	host, portstr, err := net.SplitHostPort(listenAddr) // config.Configuration.Transport.P2PListeningAddress
 	if err != nil {
 		utils.DebugTrace(logger, err)
-		cf()
 		return nil, err
 	}
 	port, err := strconv.Atoi(portstr)
 	if err != nil {
 		utils.DebugTrace(logger, err)
-		cf()
 		return nil, err
 	}
 	p2ptransport, err := transport.NewP2PTransport(logging.GetLogger(constants.LoggerTransport), types.ChainIdentifier(chainID), tprivk, port, host) // config.Configuration.Chain.ID, config.Configuration.Transport.PrivateKey
 	if err != nil {
 		utils.DebugTrace(logger, err)
-		cf()
 		return nil, err
 	}
+	return NewPeerManagerWithTransport(p2pServer, p2ptransport, pLimMin, pLimMax, fwMode, fwHost, db)
+}
+
+// NewPeerManagerWithTransport creates a new peer manager that accepts and
+// dials peers through p2ptransport. This allows tests to run many peer
+// managers over a transport.MemoryNetwork. Boot nodes are optional: the
+// peers of a node without any may be dialed with Dial.
+func NewPeerManagerWithTransport(p2pServer interfaces.P2PServer, p2ptransport interfaces.P2PTransport, pLimMin int, pLimMax int, fwMode bool, fwHost string, db *badger.DB) (*PeerManager, error) {
+	logger := logging.GetLogger(constants.LoggerPeerMan)
+	ctx := context.Background()
+	subCtx, cf := context.WithCancel(ctx)
 	// create the actual peer manager
 	pm := &PeerManager{
 		ctx:                      subCtx,
@@ -81,8 +100,8 @@ func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int,
 		peeringCompleteThreshold: pLimMin, // config.Configuration.Transport.PeerLimitMin
 		peeringMaxThreshold:      pLimMax, // config.Configuration.Transport.PeerLimitMax
 		bootNodes:                &bootNodeList{},
-		subscribers:              make(map[int]*PeerSubscription),
 		clientHandler:            newClientHandler(),
+		subscribers:              make(map[int]*PeerSubscription),
 		active: &activePeerStore{
 			canClose:  true,
 			store:     make(map[string]interfaces.P2PClient),
@@ -96,11 +115,20 @@ func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int,
 			closeChan: make(chan struct{}),
 			closeOnce: sync.Once{},
 		},
+		reputation:       newReputationStore(),
+		addrBook:         newAddrBook(db),
 		mux:              &transport.P2PMux{},
 		transport:        p2ptransport,
-		p2pServerHandler: NewMuxServerHandler(logger, p2ptransport.NodeAddr(), p2pServer),
+		p2pServerHandler: NewMuxServerHandler(logger, p2ptransport, p2pServer),
 	}
 	pm.discServerHandler = NewDiscoveryServerHandler(logger, p2ptransport.NodeAddr(), pm)
+	dht, err := NewDHT(logger, p2ptransport)
+	if err != nil {
+		cf()
+		return nil, err
+	}
+	pm.dht = dht
+	pm.records = dht.records
 	if fwMode { // config.Configuration.Transport.FirewallMode
 		pm.logger.Info("RUNNING IN FIREWALL MODE")
 		pm.fireWallMode = true
@@ -111,7 +139,7 @@ func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int,
 		pm.fireWallHost = naddr
 	}
 	// make sure bootnodes parse
-	if _, err := pm.bootNodes.randomBootNode(); err != nil {
+	if _, err := pm.bootNodes.all(); err != nil {
 		utils.DebugTrace(pm.logger, err)
 		return nil, err
 	}
@@ -120,6 +148,7 @@ func NewPeerManager(p2pServer interfaces.P2PServer, chainID uint32, pLimMin int,
 
 // Start launches the background loops of the peer manager
 func (ps *PeerManager) Start() {
+	ps.seedFromAddrBook()
 	ps.wg.Add(2)
 	go ps.runDiscoveryLoops()
 	go ps.acceptLoop()
@@ -155,6 +184,11 @@ func (ps *PeerManager) Close() error {
 		if err != nil {
 			utils.DebugTrace(ps.logger, err)
 		}
+		ps.logger.Warning("PeerManager stopping DHT")
+		err = ps.dht.Close()
+		if err != nil {
+			utils.DebugTrace(ps.logger, err)
+		}
 		ps.logger.Warning("PeerManager stopping muxServerHandler")
 		err = ps.p2pServerHandler.Close()
 		if err != nil {
@@ -187,6 +221,8 @@ func (ps *PeerManager) acceptLoop() {
 			go ps.handleP2P(conn)
 		case types.DiscProtocol:
 			go ps.handleDisc(conn)
+		case types.DHTProtocol:
+			go ps.dht.HandleConnection(conn)
 		default:
 			err := conn.Close()
 			if err != nil {
@@ -219,6 +255,13 @@ func (ps *PeerManager) handleDisc(conn interfaces.P2PConn) {
 // in local stores and notifying subscribers
 func (ps *PeerManager) handleP2P(conn interfaces.P2PConn) {
 	ps.logger.Debugf("New connection in peerManager from %s", conn.NodeAddr().P2PAddr())
+	if ps.reputation.isBanned(conn.NodeAddr().Identity()) {
+		err := conn.Close()
+		if err != nil {
+			utils.DebugTrace(ps.logger, err)
+		}
+		return
+	}
 	ctx, cf := context.WithDeadline(ps.ctx, time.Now().Add(time.Second*5))
 	defer cf()
 	muxconn, err := ps.mux.HandleConnection(ctx, conn)
@@ -228,6 +271,7 @@ func (ps *PeerManager) handleP2P(conn interfaces.P2PConn) {
 		if err2 != nil {
 			utils.DebugTrace(ps.logger, err2)
 		}
+		ps.Penalize(conn.NodeAddr(), types.HandshakeFailure)
 		return
 	}
 	client, err := ps.p2pServerHandler.HandleConnection(muxconn)
@@ -237,8 +281,12 @@ func (ps *PeerManager) handleP2P(conn interfaces.P2PConn) {
 		if err2 != nil {
 			utils.DebugTrace(ps.logger, err2)
 		}
+		ps.Penalize(conn.NodeAddr(), types.HandshakeFailure)
 		return
 	}
+	if c, ok := client.(*p2PClient); ok {
+		c.reporter = ps
+	}
 	// must be done synchronously to protect data races
 	func() {
 		ps.Lock()
@@ -247,6 +295,10 @@ func (ps *PeerManager) handleP2P(conn interfaces.P2PConn) {
 		ps.inactive.del(client.NodeAddr())
 		ps.notify(client)
 	}()
+	if err := ps.addrBook.success(client.NodeAddr()); err != nil {
+		utils.DebugTrace(ps.logger, err)
+	}
+	ps.dht.Add(client.NodeAddr())
 }
 
 func (ps *PeerManager) notify(c interfaces.P2PClient) {
@@ -266,6 +318,8 @@ func (ps *PeerManager) Subscribe() interfaces.PeerSubscription {
 	defer ps.Unlock()
 	ps.subscriberCount++
 	sub := &PeerSubscription{
+		reporter:   ps,
+		reputation: ps.reputation,
 		clientChan: make(chan interfaces.P2PClient, 16),
 		closeChan:  make(chan struct{}),
 		log:        logging.GetLogger(constants.LoggerPeer),
@@ -283,9 +337,15 @@ func (ps *PeerManager) Subscribe() interfaces.PeerSubscription {
 
 // dialp2p dials remote peers
 func (ps *PeerManager) dialP2P(addr interfaces.NodeAddr) {
+	if ps.reputation.isBanned(addr.Identity()) {
+		return
+	}
 	conn, err := ps.transport.Dial(addr, types.P2PProtocol)
 	if err != nil {
 		utils.DebugTrace(ps.logger, err)
+		if err := ps.addrBook.failure(addr); err != nil {
+			utils.DebugTrace(ps.logger, err)
+		}
 		return
 	}
 	go ps.handleP2P(conn)
@@ -296,6 +356,111 @@ func (ps *PeerManager) Counts() (int, int) {
 	return ps.active.len(), ps.inactive.len()
 }
 
+// Penalize lowers the reputation of the peer for offense. A peer whose
+// reputation falls too low is disconnected and banned for a while.
+func (ps *PeerManager) Penalize(addr interfaces.NodeAddr, offense types.PeerOffense) {
+	if !ps.reputation.penalize(addr.Identity(), offense) {
+		return
+	}
+	ps.logger.Warnf("Banning peer %s", addr.P2PAddr())
+	if err := ps.addrBook.del(addr); err != nil {
+		utils.DebugTrace(ps.logger, err)
+	}
+	ps.Lock()
+	defer ps.Unlock()
+	ps.active.del(addr)
+	ps.inactive.del(addr)
+}
+
+// KnownPeers returns the peers in the address book and marks the ones that
+// are connected as active.
+func (ps *PeerManager) KnownPeers() ([]*KnownPeer, error) {
+	peers, err := ps.addrBook.list()
+	if err != nil {
+		utils.DebugTrace(ps.logger, err)
+		return nil, err
+	}
+	for i := 0; i < len(peers); i++ {
+		peers[i].Active = ps.active.contains(peers[i].Addr)
+	}
+	return peers, nil
+}
+
+// Reward raises the reputation of the peer for a good response.
+func (ps *PeerManager) Reward(addr interfaces.NodeAddr) {
+	ps.reputation.reward(addr.Identity())
+}
+
+// ActivePeers describes the connections to the active peers
+func (ps *PeerManager) ActivePeers() []*PeerInfo {
+	peers, _ := ps.active.getPeers()
+	result := []*PeerInfo{}
+	for i := 0; i < len(peers); i++ {
+		c, ok := peers[i].(*p2PClient)
+		if !ok {
+			continue
+		}
+		result = append(result, c.info())
+	}
+	return result
+}
+
+// Disconnect closes the connection to the active peer at the p2p address
+// addr. The peer may be dialed again later by discovery or may dial the node
+// itself; use the transport blacklist to keep a peer out.
+func (ps *PeerManager) Disconnect(addr string) error {
+	naddr, err := transport.NewNodeAddr(addr)
+	if err != nil {
+		return err
+	}
+	ps.Lock()
+	defer ps.Unlock()
+	if !ps.active.contains(naddr) {
+		return ErrPeerNotActive
+	}
+	ps.active.del(naddr)
+	return nil
+}
+
+// Dial connects to the peer at the p2p address addr and returns once the
+// peer is active or the connection failed.
+func (ps *PeerManager) Dial(addr string) error {
+	naddr, err := transport.NewNodeAddr(addr)
+	if err != nil {
+		return err
+	}
+	if naddr.ChainID() != ps.transport.NodeAddr().ChainID() {
+		return ErrWrongChain
+	}
+	if ps.isMe(naddr) {
+		return ErrDialSelf
+	}
+	if ps.reputation.isBanned(naddr.Identity()) {
+		return ErrPeerBanned
+	}
+	if ps.active.contains(naddr) {
+		return nil
+	}
+	conn, err := ps.transport.Dial(naddr, types.P2PProtocol)
+	if err != nil {
+		if err := ps.addrBook.failure(naddr); err != nil {
+			utils.DebugTrace(ps.logger, err)
+		}
+		return err
+	}
+	ps.handleP2P(conn)
+	if !ps.active.contains(naddr) {
+		return ErrHandshakeFailed
+	}
+	return nil
+}
+
+// PeerLimitMin returns the number of active peers required for peering to
+// be considered complete
+func (ps *PeerManager) PeerLimitMin() int {
+	return ps.peeringCompleteThreshold
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 //P2P SERVER HANDLERS///////////////////////////////////////////////////////////
@@ -306,25 +471,66 @@ func (ps *PeerManager) HandleP2PGetPeers(ctx context.Context, req *pb.GetPeersRe
 	return ps.GetPeers(ctx, req)
 }
 
-// GetPeers is the handler for the get peers request.
+// GetPeers is the handler for the get peers request. The response holds the
+// signed record of the local node and the records of a random active and a
+// random inactive peer if the records of those peers are known. Peers that
+// do not support node records get the p2p addresses of the two peers.
 func (ps *PeerManager) GetPeers(ctx context.Context, req *pb.GetPeersRequest) (*pb.GetPeersResponse, error) {
+	if !callerCapabilities(ctx).Has(types.NodeRecordCapability) {
+		return ps.getPeersLegacy(), nil
+	}
 	resp := &pb.GetPeersResponse{
-		Peers: []string{},
+		Records: [][]byte{},
 	}
 	if ps.fireWallMode {
 		return resp, nil
 	}
-	active, ok := ps.active.random()
-	if ok {
-		resp.Peers = append(resp.Peers, active)
+	resp.Records = append(resp.Records, ps.transport.NodeRecord())
+	if active, ok := ps.active.random(); ok {
+		if record, ok := ps.records.get(active.Identity()); ok {
+			resp.Records = append(resp.Records, record)
+		}
 	}
-	inactive, ok := ps.inactive.random()
-	if ok {
-		resp.Peers = append(resp.Peers, inactive)
+	if inactive, ok := ps.inactive.random(); ok {
+		if record, ok := ps.records.get(inactive.Identity()); ok {
+			resp.Records = append(resp.Records, record)
+		}
 	}
 	return resp, nil
 }
 
+// getPeersLegacy is the response to a get peers request of a peer that does
+// not support node records
+func (ps *PeerManager) getPeersLegacy() *pb.GetPeersResponse {
+	resp := &pb.GetPeersResponse{
+		Peers: []string{},
+	}
+	if ps.fireWallMode {
+		return resp
+	}
+	if active, ok := ps.active.random(); ok {
+		resp.Peers = append(resp.Peers, active.P2PAddr())
+	}
+	if inactive, ok := ps.inactive.random(); ok {
+		resp.Peers = append(resp.Peers, inactive.P2PAddr())
+	}
+	return resp
+}
+
+// callerCapabilities returns the capabilities negotiated with the peer that
+// sent the request handled in ctx
+func callerCapabilities(ctx context.Context) types.Capabilities {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return 0
+	}
+	addr, ok := p.Addr.(interfaces.PeerAddr)
+	if !ok {
+		return 0
+	}
+	return addr.Capabilities()
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 //P2P SERVER STATUS LOGGER /////////////////////////////////////////////////////
@@ -334,6 +540,7 @@ func (ps *PeerManager) GetPeers(ctx context.Context, req *pb.GetPeersRequest) (*
 func (ps *PeerManager) Status(smap map[string]interface{}) (map[string]interface{}, error) {
 	active, inactive := ps.Counts()
 	smap["Peers"] = fmt.Sprintf("%d/%d/%d/%d", ps.peeringMaxThreshold, active, ps.peeringCompleteThreshold, inactive)
+	smap["Compression"] = fmt.Sprintf("%.2f", compression.ratio())
 	return smap, nil
 }
 
@@ -353,12 +560,14 @@ func (ps *PeerManager) runDiscoveryLoops() {
 	defer ps.Close()
 	defer ps.wg.Done()
 	defer func() { ps.logger.Warning("Discovery loop exit") }()
-	ps.wg.Add(5)
-	go ps.doLoop("bootnode", ps.discoDialBootnode, time.Second*31)
+	ps.wg.Add(7)
+	go ps.doLoop("dht", ps.discoDHT, constants.DHTLookupFreq)
 	go ps.doLoop("inactive", ps.dialInactive, time.Second*13)
 	go ps.doLoop("active", ps.getPeersActive, time.Second*17)
 	go ps.doLoop("firewall", ps.dialFirewall, time.Second*10)
 	go ps.doLoop("peerStatus", ps.peerStatus, time.Second*3)
+	go ps.doLoop("reputation", ps.reputation.cleanup, time.Minute)
+	go ps.doLoop("addrBook", ps.pruneAddrBook, constants.PeerAddrBookPruneFreq)
 	<-ps.CloseChan()
 }
 
@@ -408,12 +617,31 @@ func (ps *PeerManager) getPeersActive() {
 			utils.DebugTrace(ps.logger, err)
 			return
 		}
-		for i := 0; i < len(resp.Peers); i++ {
-			p, err := (*transport.NodeAddr).Unmarshal(nil, resp.Peers[i])
+		peers := []interfaces.NodeAddr{}
+		for i := 0; i < len(resp.Records); i++ {
+			p, _, err := ps.records.verify(resp.Records[i], peer.NodeAddr())
 			if err != nil {
+				utils.DebugTrace(ps.logger, err)
 				continue
 			}
-			if ps.isMe(p) {
+			peers = append(peers, p)
+		}
+		// bare addresses are only taken from peers that can not send records
+		if !peer.Capabilities().Has(types.NodeRecordCapability) {
+			for i := 0; i < len(resp.Peers); i++ {
+				p, err := transport.NewNodeAddr(resp.Peers[i])
+				if err != nil {
+					utils.DebugTrace(ps.logger, err)
+					continue
+				}
+				if p.ChainID() != ps.transport.NodeAddr().ChainID() {
+					continue
+				}
+				peers = append(peers, p)
+			}
+		}
+		for _, p := range peers {
+			if ps.isMe(p) || ps.reputation.isBanned(p.Identity()) {
 				continue
 			}
 			func() {
@@ -427,40 +655,94 @@ func (ps *PeerManager) getPeersActive() {
 	}
 }
 
-func (ps *PeerManager) discoDialBootnode() {
+// seedFromAddrBook adds the peers remembered from earlier runs to the
+// inactive store so they are dialed before falling back to the bootnodes.
+func (ps *PeerManager) seedFromAddrBook() {
+	ps.pruneAddrBook()
+	peers, err := ps.addrBook.list()
+	if err != nil {
+		utils.DebugTrace(ps.logger, err)
+		return
+	}
+	count := 0
+	for i := 0; i < len(peers); i++ {
+		p := peers[i].Addr
+		if ps.isMe(p) || p.ChainID() != ps.transport.NodeAddr().ChainID() {
+			continue
+		}
+		func() {
+			ps.Lock()
+			defer ps.Unlock()
+			ps.inactive.add(p)
+		}()
+		ps.dht.Add(p)
+		count++
+	}
+	ps.logger.Infof("Seeded %d peers from the address book", count)
+}
+
+func (ps *PeerManager) pruneAddrBook() {
+	peers, _ := ps.active.getPeers()
+	active := []interfaces.NodeAddr{}
+	for i := 0; i < len(peers); i++ {
+		active = append(active, peers[i].NodeAddr())
+	}
+	if err := ps.addrBook.seen(active); err != nil {
+		utils.DebugTrace(ps.logger, err)
+	}
+	if err := ps.addrBook.prune(); err != nil {
+		utils.DebugTrace(ps.logger, err)
+	}
+}
+
+// discoDHT looks up a random target in the DHT and adds the peers found as
+// inactive peers. The bootnodes seed the DHT when it has no peers. If no
+// peer answers the lookup, as when the DHT holds only bootnodes that do not
+// serve it, a bootnode is asked for its known nodes through the bootnode
+// protocol instead.
+func (ps *PeerManager) discoDHT() {
 	smap := make(map[string]interface{})
 	_, err := ps.Status(smap)
 	if err != nil {
 		utils.DebugTrace(ps.logger, err)
 	}
-	ps.logger.WithFields(smap).Debug("Running dial bootnode")
-	// get counts
-	active, inactive := ps.Counts()
-	// if we have no known peers, call a boot node
-	if active < ps.peeringMaxThreshold && inactive == 0 {
-		bn, err := ps.bootNodes.randomBootNode()
+	ps.logger.WithFields(smap).Debug("Running DHT lookup")
+	active, _ := ps.Counts()
+	if active >= ps.peeringMaxThreshold {
+		return
+	}
+	if ps.dht.Len() == 0 {
+		bootNodes, err := ps.bootNodes.all()
 		if err != nil {
 			utils.DebugTrace(ps.logger, err)
 			return
 		}
-		peers, err := ps.bootNodeProtocol(bn)
-		if err != nil {
-			utils.DebugTrace(ps.logger, err)
+		ps.dht.Add(bootNodes...)
+	}
+	ctx, cf := context.WithTimeout(ps.ctx, constants.DHTLookupFreq)
+	defer cf()
+	peers, err := ps.dht.LookupRandom(ctx)
+	if err != nil {
+		utils.DebugTrace(ps.logger, err)
+		return
+	}
+	if len(peers) == 0 {
+		// bootnodes that predate the DHT only answer the bootnode protocol
+		ps.discoDialBootnode()
+		return
+	}
+	for i := 0; i < len(peers); i++ {
+		p := peers[i]
+		if ps.isMe(p) || ps.reputation.isBanned(p.Identity()) {
+			continue
 		}
-		// add all peers as inactive
-		for i := 0; i < len(peers); i++ {
-			p := peers[i]
-			if ps.isMe(p) {
-				continue
+		func() {
+			ps.Lock()
+			defer ps.Unlock()
+			if !ps.active.contains(p) {
+				ps.inactive.add(p)
 			}
-			func() {
-				ps.Lock()
-				defer ps.Unlock()
-				if !ps.active.contains(p) {
-					ps.inactive.add(p)
-				}
-			}()
-		}
+		}()
 	}
 }
 
@@ -492,6 +774,34 @@ func (ps *PeerManager) dialFirewall() {
 	}
 }
 
+// discoDialBootnode asks a random bootnode for its known nodes and adds them
+// as inactive peers
+func (ps *PeerManager) discoDialBootnode() {
+	bn, err := ps.bootNodes.randomBootNode()
+	if err != nil {
+		utils.DebugTrace(ps.logger, err)
+		return
+	}
+	peers, err := ps.bootNodeProtocol(bn)
+	if err != nil {
+		utils.DebugTrace(ps.logger, err)
+		return
+	}
+	for i := 0; i < len(peers); i++ {
+		p := peers[i]
+		if ps.isMe(p) || ps.reputation.isBanned(p.Identity()) {
+			continue
+		}
+		func() {
+			ps.Lock()
+			defer ps.Unlock()
+			if !ps.active.contains(p) {
+				ps.inactive.add(p)
+			}
+		}()
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 //BOOTNODE DIALER //////////////////////////////////////////////////////////////
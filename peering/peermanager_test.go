@@ -0,0 +1,482 @@
+package peering
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/interfaces"
+	pb "github.com/MadBase/MadNet/proto"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/types"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+var errTestDial = errors.New("dial refused")
+
+type testTransport struct {
+	interfaces.P2PTransport
+	nodeAddr interfaces.NodeAddr
+	dials    int
+}
+
+func (tt *testTransport) NodeAddr() interfaces.NodeAddr {
+	return tt.nodeAddr
+}
+
+func (tt *testTransport) NodeRecord() []byte {
+	return []byte("record")
+}
+
+func (tt *testTransport) Dial(interfaces.NodeAddr, types.Protocol) (interfaces.P2PConn, error) {
+	tt.dials++
+	return nil, errTestDial
+}
+
+type testConn struct {
+	interfaces.P2PConn
+}
+
+func (tc *testConn) ProtoVersion() types.ProtoVersion {
+	return 1
+}
+
+func (tc *testConn) Capabilities() types.Capabilities {
+	return 1
+}
+
+type testMuxConn struct {
+	interfaces.P2PMuxConn
+	closeChan chan struct{}
+}
+
+func (tc *testMuxConn) Initiator() types.P2PInitiator {
+	return types.PeerInitiatedConnection
+}
+
+func (tc *testMuxConn) ClientConn() interfaces.P2PConn {
+	return &testConn{}
+}
+
+func (tc *testMuxConn) CloseChan() <-chan struct{} {
+	return tc.closeChan
+}
+
+func (tc *testMuxConn) BytesIn() uint64 {
+	return 10
+}
+
+func (tc *testMuxConn) BytesOut() uint64 {
+	return 20
+}
+
+func (tc *testMuxConn) Close() error {
+	close(tc.closeChan)
+	return nil
+}
+
+func newTestPeerManager(t *testing.T) (*PeerManager, *testTransport) {
+	tt := &testTransport{nodeAddr: testNodeAddr(t, 4000)}
+	pm := &PeerManager{
+		logger: logrus.New(),
+		active: &activePeerStore{
+			canClose:  true,
+			store:     make(map[string]interfaces.P2PClient),
+			pid:       make(map[string]uint64),
+			closeChan: make(chan struct{}),
+		},
+		inactive: &inactivePeerStore{
+			store:     make(map[string]interfaces.NodeAddr),
+			cooldown:  make(map[string]uint64),
+			closeChan: make(chan struct{}),
+		},
+		reputation:          newReputationStore(),
+		records:             newNodeRecords(tt.nodeAddr.ChainID()),
+		addrBook:            newAddrBook(nil),
+		transport:           tt,
+		ctx:                 context.Background(),
+		peeringMaxThreshold: 8,
+	}
+	return pm, tt
+}
+
+func TestPeerManagerActivePeers(t *testing.T) {
+	pm, _ := newTestPeerManager(t)
+	addr := testNodeAddr(t, 4001)
+	since := time.Now()
+	c := &p2PClient{
+		nodeAddr:       addr,
+		conn:           &testMuxConn{closeChan: make(chan struct{})},
+		connectedAt:    since,
+		consensusQueue: &msgQueue{tasks: map[string]*task{"a": {}, "b": {}}},
+		txQueue:        &msgQueue{tasks: map[string]*task{"c": {}}},
+	}
+	pm.active.add(c)
+
+	peers := pm.ActivePeers()
+	if len(peers) != 1 {
+		t.Fatalf("%d active peers", len(peers))
+	}
+	p := peers[0]
+	if p.Addr.P2PAddr() != addr.P2PAddr() || p.Initiator != types.PeerInitiatedConnection || p.ProtoVersion != 1 || !p.ConnectedSince.Equal(since) {
+		t.Fatalf("bad peer info %+v", p)
+	}
+	if p.ConsensusQueueDepth != 2 || p.TxQueueDepth != 1 {
+		t.Fatalf("queue depths %d %d", p.ConsensusQueueDepth, p.TxQueueDepth)
+	}
+	if p.BytesIn != 10 || p.BytesOut != 20 {
+		t.Fatalf("byte counts %d %d", p.BytesIn, p.BytesOut)
+	}
+	if p.Capabilities != 1 {
+		t.Fatalf("capabilities %v", p.Capabilities)
+	}
+
+	if err := pm.Disconnect(testNodeAddr(t, 4002).P2PAddr()); err != ErrPeerNotActive {
+		t.Fatalf("disconnected an inactive peer: %v", err)
+	}
+	if err := pm.Disconnect(addr.P2PAddr()); err != nil {
+		t.Fatal(err)
+	}
+	if pm.active.contains(addr) {
+		t.Fatal("peer still active")
+	}
+	select {
+	case <-c.CloseChan():
+	default:
+		t.Fatal("connection not closed")
+	}
+}
+
+func TestPeerManagerDial(t *testing.T) {
+	pm, tt := newTestPeerManager(t)
+	banned := testNodeAddr(t, 4001)
+	for !pm.reputation.penalize(banned.Identity(), types.OversizedMessage) {
+	}
+	r, err := transport.RandomNodeAddr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		addr string
+		err  error
+	}{
+		{tt.nodeAddr.P2PAddr(), ErrDialSelf},
+		{fmt.Sprintf("00000001|%s@127.0.0.1:4001", r.Identity()), ErrWrongChain},
+		{banned.P2PAddr(), ErrPeerBanned},
+		{testNodeAddr(t, 4002).P2PAddr(), errTestDial},
+	} {
+		if err := pm.Dial(test.addr); err != test.err {
+			t.Fatalf("dial %s: got %v want %v", test.addr, err, test.err)
+		}
+	}
+	if tt.dials != 1 {
+		t.Fatalf("%d dials", tt.dials)
+	}
+	if err := pm.Dial("not an address"); err == nil {
+		t.Fatal("dialed an invalid address")
+	}
+}
+
+// testGetPeersClient answers get peers requests with resp
+type testGetPeersClient struct {
+	interfaces.P2PClientRaw
+	resp *pb.GetPeersResponse
+}
+
+func (c *testGetPeersClient) GetPeers(ctx context.Context, in *pb.GetPeersRequest, opts ...grpc.CallOption) (*pb.GetPeersResponse, error) {
+	return c.resp, nil
+}
+
+// recordsMuxConn is a connection to a peer that supports node records
+type recordsMuxConn struct {
+	testMuxConn
+}
+
+type recordsConn struct {
+	testConn
+}
+
+func (rc *recordsConn) Capabilities() types.Capabilities {
+	return types.CompressionCapability | types.NodeRecordCapability
+}
+
+func (rc *recordsMuxConn) ClientConn() interfaces.P2PConn {
+	return &recordsConn{}
+}
+
+func TestPeerManagerGetPeersLegacy(t *testing.T) {
+	pm, _ := newTestPeerManager(t)
+	active := testNodeAddr(t, 4001)
+	pm.active.add(&p2PClient{nodeAddr: active, conn: &testMuxConn{closeChan: make(chan struct{})}})
+	callerCtx := func(caps types.Capabilities) context.Context {
+		addr := &peerAddr{NodeAddr: testNodeAddr(t, 4002), protoVersion: 1, capabilities: caps}
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	}
+
+	// a peer without node records gets bare addresses
+	resp, err := pm.GetPeers(callerCtx(0), &pb.GetPeersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Peers) != 1 || resp.Peers[0] != active.P2PAddr() || len(resp.Records) != 0 {
+		t.Fatalf("legacy response %v %v", resp.Peers, resp.Records)
+	}
+	resp, err = pm.GetPeers(callerCtx(types.NodeRecordCapability), &pb.GetPeersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Peers) != 0 || len(resp.Records) != 1 || string(resp.Records[0]) != "record" {
+		t.Fatalf("response %v %v", resp.Peers, resp.Records)
+	}
+
+	// bare addresses are only taken from a peer without node records
+	for _, tc := range []struct {
+		name  string
+		conn  interfaces.P2PMuxConn
+		added bool
+	}{
+		{"legacy peer", &legacyMuxConn{testMuxConn{closeChan: make(chan struct{})}}, true},
+		{"current peer", &recordsMuxConn{testMuxConn{closeChan: make(chan struct{})}}, false},
+	} {
+		pm, _ := newTestPeerManager(t)
+		found := testNodeAddr(t, 4003)
+		pm.active.add(&p2PClient{
+			nodeAddr:     testNodeAddr(t, 4001),
+			conn:         tc.conn,
+			P2PClientRaw: &testGetPeersClient{resp: &pb.GetPeersResponse{Peers: []string{found.P2PAddr()}}},
+		})
+		pm.getPeersActive()
+		if _, inactive := pm.Counts(); (inactive == 1) != tc.added {
+			t.Fatalf("%s: %d inactive peers", tc.name, inactive)
+		}
+	}
+}
+
+// testP2PServer answers the discovery requests of the peers of pm
+type testP2PServer struct {
+	pb.UnimplementedP2PServer
+	pm *PeerManager
+}
+
+func (ts *testP2PServer) GetPeers(ctx context.Context, req *pb.GetPeersRequest) (*pb.GetPeersResponse, error) {
+	return ts.pm.GetPeers(ctx, req)
+}
+
+// newMemoryPeerManagers returns n peer managers accepting connections over
+// a memory network. The first peer manager is the boot node of the others.
+func newMemoryPeerManagers(t *testing.T, mn *transport.MemoryNetwork, n int) []*PeerManager {
+	t.Helper()
+	bootNodes := config.Configuration.Transport.BootNodeAddresses
+	t.Cleanup(func() { config.Configuration.Transport.BootNodeAddresses = bootNodes })
+	pms := []*PeerManager{}
+	for i := 0; i < n; i++ {
+		mt, err := mn.NewTransport(logrus.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			config.Configuration.Transport.BootNodeAddresses = mt.NodeAddr().P2PAddr()
+		}
+		srv := &testP2PServer{}
+		pm, err := NewPeerManagerWithTransport(srv, mt, 1, 8, false, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		srv.pm = pm
+		pm.wg.Add(1)
+		go pm.acceptLoop()
+		t.Cleanup(func() { pm.Close() })
+		pms = append(pms, pm)
+	}
+	return pms
+}
+
+// waitFor polls cond until it holds or fails the test after a second
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	for i := 0; !cond(); i++ {
+		if i == 100 {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPeerManagerMemoryNetwork(t *testing.T) {
+	mn := transport.NewMemoryNetwork(types.ChainIdentifier(42), 1)
+	pms := newMemoryPeerManagers(t, mn, 4)
+	boot := pms[0].transport.NodeAddr()
+
+	// every node announces its record to the boot node
+	for _, pm := range pms[1:] {
+		pm.discoDHT()
+	}
+	// the nodes find and dial each other through the boot node
+	for _, pm := range pms[1:] {
+		pm.discoDHT()
+		for _, inactive := pm.Counts(); inactive > 0; _, inactive = pm.Counts() {
+			pm.dialInactive()
+		}
+	}
+	for i, pm := range pms {
+		pm := pm
+		waitFor(t, fmt.Sprintf("peers of node %d", i), func() bool {
+			active, _ := pm.Counts()
+			return active == len(pms)-1
+		})
+	}
+	for _, p := range pms[1].ActivePeers() {
+		if !p.Capabilities.Has(types.CompressionCapability) {
+			t.Fatalf("peer %s without compression", p.Addr.P2PAddr())
+		}
+	}
+
+	// a partition drops the peer and stops it from being dialed again
+	mn.Partition(pms[1].transport.NodeAddr(), boot)
+	waitFor(t, "partitioned peer to drop", func() bool {
+		return !pms[1].active.contains(boot)
+	})
+	if err := pms[1].Dial(boot.P2PAddr()); err != transport.ErrNodeUnreachable {
+		t.Fatalf("dialed across partition: %v", err)
+	}
+	if !pms[1].active.contains(pms[2].transport.NodeAddr()) {
+		t.Fatal("peer outside the partition dropped")
+	}
+	mn.HealAll()
+	if err := pms[1].Dial(boot.P2PAddr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPeerManagerWithoutBootNodes(t *testing.T) {
+	bootNodes := config.Configuration.Transport.BootNodeAddresses
+	t.Cleanup(func() { config.Configuration.Transport.BootNodeAddresses = bootNodes })
+	config.Configuration.Transport.BootNodeAddresses = ""
+	if _, err := NewPeerManager(&testP2PServer{}, 42, 1, 8, false, "", "127.0.0.1:0", "", nil); err == nil {
+		t.Fatal("peer manager created without boot nodes")
+	}
+
+	mn := transport.NewMemoryNetwork(types.ChainIdentifier(42), 1)
+	pms := []*PeerManager{}
+	for i := 0; i < 2; i++ {
+		mt, err := mn.NewTransport(logrus.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		srv := &testP2PServer{}
+		pm, err := NewPeerManagerWithTransport(srv, mt, 1, 8, false, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		srv.pm = pm
+		pm.wg.Add(1)
+		go pm.acceptLoop()
+		t.Cleanup(func() { pm.Close() })
+		pms = append(pms, pm)
+	}
+	if err := pms[0].Dial(pms[1].transport.NodeAddr().P2PAddr()); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "dialed peer to be active", func() bool {
+		active, _ := pms[1].Counts()
+		return active == 1
+	})
+}
+
+// testBootNode serves only the bootnode protocol like the bootnodes that
+// predate the DHT. It returns the callers it has seen before.
+type testBootNode struct {
+	sync.Mutex
+	pb.UnimplementedBootNodeServer
+	known map[string]string
+}
+
+func (bn *testBootNode) KnownNodes(ctx context.Context, r *pb.BootNodeRequest) (*pb.BootNodeResponse, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("no caller")
+	}
+	caller := p.Addr.(interfaces.NodeAddr)
+	bn.Lock()
+	defer bn.Unlock()
+	resp := &pb.BootNodeResponse{}
+	for ident, addr := range bn.known {
+		if ident != caller.Identity() {
+			resp.Peers = append(resp.Peers, addr)
+		}
+	}
+	bn.known[caller.Identity()] = caller.P2PAddr()
+	return resp, nil
+}
+
+// newLegacyBootNode starts a bootnode on mn that does not serve the DHT
+func newLegacyBootNode(t *testing.T, mn *transport.MemoryNetwork) interfaces.NodeAddr {
+	t.Helper()
+	mt, err := mn.NewTransport(logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { mt.Close() })
+	handler := NewBootNodeServerHandler(logrus.New(), mt.NodeAddr(), &testBootNode{known: make(map[string]string)})
+	t.Cleanup(func() { handler.Close() })
+	go func() {
+		for {
+			conn, err := mt.Accept()
+			if err != nil {
+				return
+			}
+			if conn.Protocol() != types.Bootnode {
+				conn.Close()
+				continue
+			}
+			go handler.HandleConnection(conn)
+		}
+	}()
+	return mt.NodeAddr()
+}
+
+func TestPeerManagerLegacyBootNode(t *testing.T) {
+	mn := transport.NewMemoryNetwork(types.ChainIdentifier(42), 1)
+	bootNodes := config.Configuration.Transport.BootNodeAddresses
+	t.Cleanup(func() { config.Configuration.Transport.BootNodeAddresses = bootNodes })
+	config.Configuration.Transport.BootNodeAddresses = newLegacyBootNode(t, mn).P2PAddr()
+	pms := []*PeerManager{}
+	for i := 0; i < 3; i++ {
+		mt, err := mn.NewTransport(logrus.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		srv := &testP2PServer{}
+		pm, err := NewPeerManagerWithTransport(srv, mt, 1, 8, false, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		srv.pm = pm
+		pm.wg.Add(1)
+		go pm.acceptLoop()
+		t.Cleanup(func() { pm.Close() })
+		pms = append(pms, pm)
+	}
+
+	// the DHT lookups through the boot node fail and every node falls back
+	// to the nodes the boot node has seen before it
+	for _, pm := range pms {
+		pm.discoDHT()
+		for _, inactive := pm.Counts(); inactive > 0; _, inactive = pm.Counts() {
+			pm.dialInactive()
+		}
+	}
+	for i, pm := range pms {
+		pm := pm
+		waitFor(t, fmt.Sprintf("peers of node %d", i), func() bool {
+			active, _ := pm.Counts()
+			return active == len(pms)-1
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,6 +21,8 @@ type PeerSubscription struct {
 	closeChan  chan struct{}
 	actives    *activePeerStore
 	closeOnce  sync.Once
+	reporter   reporter
+	reputation *reputationStore
 }
 
 // CloseChan returns a channel that will be closed when the subscription
@@ -76,7 +79,8 @@ func (p *PeerSubscription) RequestLease(ctx context.Context, msg []byte) (interf
 	return p.PeerLease(ctx)
 }
 
-// PeerLease returns a random active peer
+// PeerLease returns a random active peer. Of two random peers the one with
+// the better reputation is returned.
 func (p *PeerSubscription) PeerLease(ctx context.Context) (interfaces.PeerLease, error) {
 	p.RLock()
 	defer p.RUnlock()
@@ -87,6 +91,14 @@ func (p *PeerSubscription) PeerLease(ctx context.Context) (interfaces.PeerLease,
 			p.log.Debugf("Error in PeerSubscription.PeerLease at randomElement: %v", err)
 			return nil, err
 		}
+		other, err := randomElement(len(peers))
+		if err != nil {
+			p.log.Debugf("Error in PeerSubscription.PeerLease at randomElement: %v", err)
+			return nil, err
+		}
+		if p.reputation != nil && p.reputation.score(peers[other].NodeAddr().Identity()) > p.reputation.score(peers[index].NodeAddr().Identity()) {
+			index = other
+		}
 		client, ok := peers[index].(*p2PClient)
 		if ok {
 			return client, nil
@@ -168,6 +180,13 @@ func (p *PeerSubscription) PreventGossipTx(addr interfaces.NodeAddr, hsh []byte)
 	}
 }
 
+// Penalize lowers the reputation of the peer for offense
+func (p *PeerSubscription) Penalize(addr interfaces.NodeAddr, offense types.PeerOffense) {
+	if p.reporter != nil {
+		p.reporter.Penalize(addr, offense)
+	}
+}
+
 type peerFail struct{}
 
 func (p *peerFail) P2PClient() (interfaces.P2PClient, error) {
@@ -177,3 +196,11 @@ func (p *peerFail) P2PClient() (interfaces.P2PClient, error) {
 func (p *peerFail) Do(fn func(interfaces.PeerLease) error) {
 	fn(p)
 }
+
+func (p *peerFail) ProtoVersion() types.ProtoVersion {
+	return 0
+}
+
+func (p *peerFail) Capabilities() types.Capabilities {
+	return 0
+}
@@ -0,0 +1,96 @@
+package peering
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
+	"github.com/sirupsen/logrus"
+)
+
+// legacyConn is a connection to a peer that negotiated no capabilities
+type legacyConn struct {
+	interfaces.P2PConn
+}
+
+func (lc *legacyConn) ProtoVersion() types.ProtoVersion {
+	return 1
+}
+
+func (lc *legacyConn) Capabilities() types.Capabilities {
+	return 0
+}
+
+type legacyMuxConn struct {
+	testMuxConn
+}
+
+func (lc *legacyMuxConn) ClientConn() interfaces.P2PConn {
+	return &legacyConn{}
+}
+
+func newTestClient(t *testing.T, addr interfaces.NodeAddr, conn interfaces.P2PMuxConn) *p2PClient {
+	c := &p2PClient{
+		logger:   logrus.New(),
+		nodeAddr: addr,
+		conn:     conn,
+	}
+	var err error
+	c.consensusQueue, err = newMsgQueue(16, 1, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.txQueue, err = newMsgQueue(16, 1, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestPeerSubscriptionGossipLegacyPeer(t *testing.T) {
+	legacy := newTestClient(t, testNodeAddr(t, 4001), &legacyMuxConn{testMuxConn{closeChan: make(chan struct{})}})
+	defer legacy.Close()
+	current := newTestClient(t, testNodeAddr(t, 4002), &testMuxConn{closeChan: make(chan struct{})})
+	defer current.Close()
+	ps := &PeerSubscription{
+		log: logrus.New(),
+		actives: &activePeerStore{
+			store:     make(map[string]interfaces.P2PClient),
+			pid:       make(map[string]uint64),
+			closeChan: make(chan struct{}),
+		},
+		closeChan: make(chan struct{}),
+	}
+	ps.actives.add(legacy)
+	ps.actives.add(current)
+
+	// a message only peers with the capability understand is skipped for
+	// the legacy peer
+	sent := make(chan string, 2)
+	ps.GossipConsensus([]byte("msg"), func(ctx context.Context, lease interfaces.PeerLease) error {
+		client, err := lease.P2PClient()
+		if err != nil {
+			return err
+		}
+		if !lease.Capabilities().Has(types.CompressionCapability) {
+			sent <- ""
+			return nil
+		}
+		sent <- client.NodeAddr().Identity()
+		return nil
+	})
+	got := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case ident := <-sent:
+			got[ident] = true
+		case <-time.After(5 * time.Second):
+			t.Fatal("gossip not handled")
+		}
+	}
+	if !got[current.NodeAddr().Identity()] || got[legacy.NodeAddr().Identity()] || !got[""] {
+		t.Fatalf("sent to %v", got)
+	}
+}
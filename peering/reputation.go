@@ -0,0 +1,112 @@
+package peering
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/types"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// penalties maps each offense to the score it costs a peer
+var penalties = map[types.PeerOffense]float64{
+	types.InvalidMessage:   constants.PeerPenaltyInvalidMsg,
+	types.OversizedMessage: constants.PeerPenaltyOversizedMsg,
+	types.UnresponsivePeer: constants.PeerPenaltyUnresponsive,
+	types.HandshakeFailure: constants.PeerPenaltyHandshake,
+}
+
+// reputation is the score of a single peer
+type reputation struct {
+	score       float64
+	updated     time.Time
+	bannedUntil time.Time
+}
+
+// reputationStore tracks the scores of peers by identity. Scores decay back
+// towards zero over time so old offenses are forgiven.
+type reputationStore struct {
+	sync.Mutex
+	store map[string]*reputation
+	clock utils.Clock
+}
+
+func newReputationStore() *reputationStore {
+	return &reputationStore{
+		store: make(map[string]*reputation),
+	}
+}
+
+// get returns the reputation of the peer with its score decayed to now.
+// The caller must hold the lock.
+func (rs *reputationStore) get(ident string) *reputation {
+	now := rs.clock.Now()
+	r, ok := rs.store[ident]
+	if !ok {
+		r = &reputation{updated: now}
+		rs.store[ident] = r
+		return r
+	}
+	elapsed := now.Sub(r.updated)
+	if elapsed > 0 {
+		r.score *= math.Pow(0.5, float64(elapsed)/float64(constants.PeerScoreHalfLife))
+		r.updated = now
+	}
+	// round scores that have decayed away to zero
+	if math.Abs(r.score) < 0.01 {
+		r.score = 0
+	}
+	return r
+}
+
+// penalize lowers the score of the peer for offense and returns true if the
+// peer is banned as a result.
+func (rs *reputationStore) penalize(ident string, offense types.PeerOffense) bool {
+	rs.Lock()
+	defer rs.Unlock()
+	r := rs.get(ident)
+	r.score -= penalties[offense]
+	if r.score > constants.PeerBanScore {
+		return false
+	}
+	r.score = 0
+	r.bannedUntil = rs.clock.Now().Add(constants.PeerBanDuration)
+	return true
+}
+
+// reward raises the score of the peer for a good response
+func (rs *reputationStore) reward(ident string) {
+	rs.Lock()
+	defer rs.Unlock()
+	r := rs.get(ident)
+	r.score = math.Min(r.score+constants.PeerRewardResponse, constants.PeerScoreMax)
+}
+
+// score returns the current score of the peer
+func (rs *reputationStore) score(ident string) float64 {
+	rs.Lock()
+	defer rs.Unlock()
+	return rs.get(ident).score
+}
+
+// isBanned returns true if the peer may not connect
+func (rs *reputationStore) isBanned(ident string) bool {
+	rs.Lock()
+	defer rs.Unlock()
+	return rs.get(ident).bannedUntil.After(rs.clock.Now())
+}
+
+// cleanup forgets peers with a neutral score that are not banned
+func (rs *reputationStore) cleanup() {
+	rs.Lock()
+	defer rs.Unlock()
+	now := rs.clock.Now()
+	for ident := range rs.store {
+		r := rs.get(ident)
+		if r.score == 0 && !r.bannedUntil.After(now) {
+			delete(rs.store, ident)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package peering
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/errorz"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/transport"
+	"github.com/MadBase/MadNet/types"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReputationBan(t *testing.T) {
+	now := time.Now()
+	rs := newReputationStore()
+	rs.clock = func() time.Time { return now }
+
+	for i := 1; ; i++ {
+		banned := rs.penalize("peer", types.InvalidMessage)
+		if float64(i*constants.PeerPenaltyInvalidMsg) < -constants.PeerBanScore {
+			if banned {
+				t.Fatalf("banned after %d offenses", i)
+			}
+			continue
+		}
+		if !banned {
+			t.Fatalf("not banned after %d offenses", i)
+		}
+		break
+	}
+	if !rs.isBanned("peer") || rs.isBanned("other") {
+		t.Fatal("wrong peer banned")
+	}
+	now = now.Add(constants.PeerBanDuration + time.Second)
+	if rs.isBanned("peer") {
+		t.Fatal("ban did not expire")
+	}
+}
+
+func TestReputationDecay(t *testing.T) {
+	now := time.Now()
+	rs := newReputationStore()
+	rs.clock = func() time.Time { return now }
+
+	rs.penalize("peer", types.OversizedMessage)
+	if rs.score("peer") != -constants.PeerPenaltyOversizedMsg {
+		t.Fatalf("score %v", rs.score("peer"))
+	}
+	now = now.Add(constants.PeerScoreHalfLife)
+	if rs.score("peer") != -constants.PeerPenaltyOversizedMsg/2 {
+		t.Fatalf("score %v after a half life", rs.score("peer"))
+	}
+	// offenses spread over time never add up to a ban
+	for i := 0; i < 100; i++ {
+		now = now.Add(2 * constants.PeerScoreHalfLife)
+		if rs.penalize("peer", types.OversizedMessage) {
+			t.Fatal("banned for spread out offenses")
+		}
+	}
+
+	now = now.Add(100 * constants.PeerScoreHalfLife)
+	rs.cleanup()
+	if len(rs.store) != 0 {
+		t.Fatal("forgiven peer not cleaned up")
+	}
+	for i := 0; i < 2*constants.PeerScoreMax; i++ {
+		rs.reward("peer")
+	}
+	if rs.score("peer") != constants.PeerScoreMax {
+		t.Fatalf("score %v above the maximum", rs.score("peer"))
+	}
+}
+
+type testReporter struct {
+	offenses []types.PeerOffense
+	rewards  int
+}
+
+func (tr *testReporter) Penalize(addr interfaces.NodeAddr, offense types.PeerOffense) {
+	tr.offenses = append(tr.offenses, offense)
+}
+
+func (tr *testReporter) Reward(addr interfaces.NodeAddr) {
+	tr.rewards++
+}
+
+func TestP2PClientReports(t *testing.T) {
+	nodeAddr, err := transport.RandomNodeAddr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := &testReporter{}
+	c := &p2PClient{logger: logrus.New(), nodeAddr: nodeAddr, reporter: tr}
+	for _, err := range []error{
+		nil,
+		errorz.ErrInvalid{}.New("bad signatures"),
+		context.DeadlineExceeded,
+		status.Error(codes.Unavailable, "gone"),
+		errors.New("not found"),
+	} {
+		c.Do(func(interfaces.PeerLease) error { return err })
+	}
+	if tr.rewards != 1 {
+		t.Fatalf("%d rewards", tr.rewards)
+	}
+	want := []types.PeerOffense{types.InvalidMessage, types.UnresponsivePeer, types.UnresponsivePeer}
+	if len(tr.offenses) != len(want) {
+		t.Fatalf("offenses %v", tr.offenses)
+	}
+	for i := range want {
+		if tr.offenses[i] != want[i] {
+			t.Fatalf("offenses %v", tr.offenses)
+		}
+	}
+}
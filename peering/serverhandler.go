@@ -1,6 +1,7 @@
 package peering
 
 import (
+	"context"
 	"net"
 	"sync"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/MadBase/MadNet/interfaces"
 	pb "github.com/MadBase/MadNet/proto"
 	"github.com/MadBase/MadNet/utils"
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
@@ -76,8 +78,9 @@ func NewDiscoveryServerHandler(logger *logrus.Logger, addr net.Addr, service int
 }
 
 // NewP2PServerHandler returns a RPC ServerHandler for the Pz2P Service.
-func newP2PServerHandler(logger *logrus.Logger, addr net.Addr, service interfaces.P2PServer) *ServerHandler {
-	srvr := grpc.NewServer(grpc.ConnectionTimeout(constants.SrvrMsgTimeout), grpc.MaxConcurrentStreams(constants.MaxConcurrentStreams), grpc.NumStreamWorkers(constants.P2PStreamWorkers), grpc.ReadBufferSize(constants.ReadBufferSize))
+func newP2PServerHandler(logger *logrus.Logger, p2pTransport interfaces.P2PTransport, service interfaces.P2PServer) *ServerHandler {
+	addr := p2pTransport.NodeAddr()
+	srvr := grpc.NewServer(grpc.ConnectionTimeout(constants.SrvrMsgTimeout), grpc.MaxConcurrentStreams(constants.MaxConcurrentStreams), grpc.NumStreamWorkers(constants.P2PStreamWorkers), grpc.ReadBufferSize(constants.ReadBufferSize), grpc.UnaryInterceptor(inboundLimit(p2pTransport)))
 	pb.RegisterP2PServer(srvr, service)
 	handler := &ServerHandler{
 		listener: NewListener(logger, addr),
@@ -103,3 +106,44 @@ func NewBootNodeServerHandler(logger *logrus.Logger, addr net.Addr, service inte
 	go handler.serve()
 	return handler
 }
+
+// NewDHTServerHandler returns a RPC ServerHandler for the DHT Service.
+func NewDHTServerHandler(logger *logrus.Logger, addr net.Addr, service interfaces.DHTServer) *ServerHandler {
+	srvr := grpc.NewServer(grpc.ConnectionTimeout(constants.SrvrMsgTimeout), grpc.MaxConcurrentStreams(constants.MaxConcurrentStreams), grpc.NumStreamWorkers(constants.DiscoStreamWorkers), grpc.ReadBufferSize(constants.ReadBufferSize))
+	pb.RegisterDHTServer(srvr, service)
+	handler := &ServerHandler{
+		listener: NewDiscoveryListener(logger, addr),
+		server:   srvr,
+		logger:   logger,
+		name:     "DHTServerHandler",
+	}
+	go handler.serve()
+	return handler
+}
+
+// consensusMethods are the methods of the P2P service that carry consensus
+// messages
+var consensusMethods = map[string]bool{
+	"/proto.P2P/GossipProposal":     true,
+	"/proto.P2P/GossipPreVote":      true,
+	"/proto.P2P/GossipPreVoteNil":   true,
+	"/proto.P2P/GossipPreCommit":    true,
+	"/proto.P2P/GossipPreCommitNil": true,
+	"/proto.P2P/GossipNextRound":    true,
+	"/proto.P2P/GossipNextHeight":   true,
+	"/proto.P2P/GossipBlockHeader":  true,
+}
+
+// inboundLimit applies the inbound bandwidth limit of the transport to the
+// requests of the P2P service before they are handled. Consensus messages
+// are given priority so that a flood of transactions does not hold them up.
+func inboundLimit(p2pTransport interfaces.P2PTransport) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := p2pTransport.WaitInbound(ctx, proto.Size(msg), consensusMethods[info.FullMethod]); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
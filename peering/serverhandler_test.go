@@ -0,0 +1,69 @@
+package peering
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MadBase/MadNet/interfaces"
+	pb "github.com/MadBase/MadNet/proto"
+	"google.golang.org/grpc"
+)
+
+// inboundTransport records the inbound messages it is asked to limit
+type inboundTransport struct {
+	interfaces.P2PTransport
+	sizes     []int
+	consensus []bool
+}
+
+func (it *inboundTransport) WaitInbound(ctx context.Context, n int, consensus bool) error {
+	it.sizes = append(it.sizes, n)
+	it.consensus = append(it.consensus, consensus)
+	return ctx.Err()
+}
+
+func TestInboundLimit(t *testing.T) {
+	it := &inboundTransport{}
+	intercept := inboundLimit(it)
+	handled := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled++
+		return nil, nil
+	}
+	calls := []struct {
+		method string
+		req    interface{}
+	}{
+		{"/proto.P2P/GossipTransaction", &pb.GossipTransactionMessage{Transaction: make([]byte, 100)}},
+		{"/proto.P2P/GossipProposal", &pb.GossipProposalMessage{Proposal: make([]byte, 50)}},
+		{"/proto.P2P/GossipPreVote", &pb.GossipPreVoteMessage{PreVote: make([]byte, 20)}},
+		{"/proto.P2P/GetPendingTxs", &pb.GetPendingTxsRequest{}},
+	}
+	for _, c := range calls {
+		if _, err := intercept(context.Background(), c.req, &grpc.UnaryServerInfo{FullMethod: c.method}, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if handled != len(calls) {
+		t.Fatalf("%d requests handled", handled)
+	}
+	want := []bool{false, true, true, false}
+	for i := range want {
+		if it.consensus[i] != want[i] {
+			t.Fatalf("%s given priority %v", calls[i].method, it.consensus[i])
+		}
+	}
+	if it.sizes[0] <= 100 || it.sizes[1] <= 50 {
+		t.Fatalf("limited %v bytes", it.sizes)
+	}
+
+	// a request is not handled once the caller is gone
+	ctx, cf := context.WithCancel(context.Background())
+	cf()
+	if _, err := intercept(ctx, calls[0].req, &grpc.UnaryServerInfo{FullMethod: calls[0].method}, handler); err == nil {
+		t.Fatal("request handled after the caller left")
+	}
+	if handled != len(calls) {
+		t.Fatalf("%d requests handled", handled)
+	}
+}
@@ -36,8 +36,8 @@ var file_localstate_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x8b,
-	0x0c, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a,
+	0x61, 0x74, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x91,
+	0x15, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a,
 	0x07, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
@@ -71,70 +71,142 @@ var file_localstate_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
 	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2d,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x55, 0x54, 0x58, 0x4f, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x54, 0x58,
-	0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x75,
-	0x74, 0x78, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f,
-	0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46,
-	0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26,
-	0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2d,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x2d, 0x66, 0x6f, 0x72, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f,
-	0x67, 0x65, 0x74, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x73, 0x65,
-	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x58,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x15, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2d, 0x69, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x1f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6e, 0x64,
-	0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12,
-	0x68, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x2d,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
-	0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x74, 0x78, 0x2d, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x65, 0x74, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2d, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2d,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x3a, 0x01, 0x2a, 0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55,
+	0x54, 0x58, 0x4f, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x54, 0x58, 0x4f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x75, 0x74,
+	0x78, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f,
+	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22,
+	0x21, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2d, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x2d, 0x66, 0x6f, 0x72, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x65, 0x74, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x73, 0x65, 0x74,
+	0x3a, 0x01, 0x2a, 0x12, 0x94, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a,
+	0x12, 0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x74, 0x68, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x74, 0x68, 0x44,
+	0x4b, 0x47, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x74, 0x68, 0x44, 0x4b, 0x47, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x65, 0x74,
+	0x68, 0x64, 0x6b, 0x67, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x74,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65,
+	0x74, 0x2d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2d, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x64, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x2d, 0x70, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x74, 0x2d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2d, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6d, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2d, 0x70, 0x65, 0x65, 0x72,
+	0x3a, 0x01, 0x2a, 0x12, 0x55, 0x0a, 0x08, 0x44, 0x69, 0x61, 0x6c, 0x50, 0x65, 0x65, 0x72, 0x12,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x6c, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x69, 0x61, 0x6c, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69,
+	0x61, 0x6c, 0x2d, 0x70, 0x65, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x12, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x2d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2d, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x74, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x65, 0x74, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2d, 0x69, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x65,
+	0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12,
+	0x71, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d,
+	0x74, 0x78, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a,
+	0x01, 0x2a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_localstate_proto_goTypes = []interface{}{
@@ -143,29 +215,49 @@ var file_localstate_proto_goTypes = []interface{}{
 	(*IterateNameSpaceRequest)(nil),        // 2: proto.IterateNameSpaceRequest
 	(*MinedTransactionRequest)(nil),        // 3: proto.MinedTransactionRequest
 	(*BlockHeaderRequest)(nil),             // 4: proto.BlockHeaderRequest
-	(*UTXORequest)(nil),                    // 5: proto.UTXORequest
-	(*PendingTransactionRequest)(nil),      // 6: proto.PendingTransactionRequest
-	(*RoundStateForValidatorRequest)(nil),  // 7: proto.RoundStateForValidatorRequest
-	(*ValidatorSetRequest)(nil),            // 8: proto.ValidatorSetRequest
-	(*BlockNumberRequest)(nil),             // 9: proto.BlockNumberRequest
-	(*ChainIDRequest)(nil),                 // 10: proto.ChainIDRequest
-	(*TransactionData)(nil),                // 11: proto.TransactionData
-	(*EpochNumberRequest)(nil),             // 12: proto.EpochNumberRequest
-	(*TxBlockNumberRequest)(nil),           // 13: proto.TxBlockNumberRequest
-	(*GetDataResponse)(nil),                // 14: proto.GetDataResponse
-	(*GetValueResponse)(nil),               // 15: proto.GetValueResponse
-	(*IterateNameSpaceResponse)(nil),       // 16: proto.IterateNameSpaceResponse
-	(*MinedTransactionResponse)(nil),       // 17: proto.MinedTransactionResponse
-	(*BlockHeaderResponse)(nil),            // 18: proto.BlockHeaderResponse
-	(*UTXOResponse)(nil),                   // 19: proto.UTXOResponse
-	(*PendingTransactionResponse)(nil),     // 20: proto.PendingTransactionResponse
-	(*RoundStateForValidatorResponse)(nil), // 21: proto.RoundStateForValidatorResponse
-	(*ValidatorSetResponse)(nil),           // 22: proto.ValidatorSetResponse
-	(*BlockNumberResponse)(nil),            // 23: proto.BlockNumberResponse
-	(*ChainIDResponse)(nil),                // 24: proto.ChainIDResponse
-	(*TransactionDetails)(nil),             // 25: proto.TransactionDetails
-	(*EpochNumberResponse)(nil),            // 26: proto.EpochNumberResponse
-	(*TxBlockNumberResponse)(nil),          // 27: proto.TxBlockNumberResponse
+	(*BlockHeaderProofRequest)(nil),        // 5: proto.BlockHeaderProofRequest
+	(*UTXORequest)(nil),                    // 6: proto.UTXORequest
+	(*PendingTransactionRequest)(nil),      // 7: proto.PendingTransactionRequest
+	(*RoundStateForValidatorRequest)(nil),  // 8: proto.RoundStateForValidatorRequest
+	(*ValidatorSetRequest)(nil),            // 9: proto.ValidatorSetRequest
+	(*ValidatorParticipationRequest)(nil),  // 10: proto.ValidatorParticipationRequest
+	(*ConsensusTraceRequest)(nil),          // 11: proto.ConsensusTraceRequest
+	(*EthDKGStatusRequest)(nil),            // 12: proto.EthDKGStatusRequest
+	(*SnapshotAlertsRequest)(nil),          // 13: proto.SnapshotAlertsRequest
+	(*KnownPeersRequest)(nil),              // 14: proto.KnownPeersRequest
+	(*ActivePeersRequest)(nil),             // 15: proto.ActivePeersRequest
+	(*DisconnectPeerRequest)(nil),          // 16: proto.DisconnectPeerRequest
+	(*DialPeerRequest)(nil),                // 17: proto.DialPeerRequest
+	(*ClearSnapshotAlertRequest)(nil),      // 18: proto.ClearSnapshotAlertRequest
+	(*BlockNumberRequest)(nil),             // 19: proto.BlockNumberRequest
+	(*ChainIDRequest)(nil),                 // 20: proto.ChainIDRequest
+	(*TransactionData)(nil),                // 21: proto.TransactionData
+	(*EpochNumberRequest)(nil),             // 22: proto.EpochNumberRequest
+	(*TxBlockNumberRequest)(nil),           // 23: proto.TxBlockNumberRequest
+	(*GetDataResponse)(nil),                // 24: proto.GetDataResponse
+	(*GetValueResponse)(nil),               // 25: proto.GetValueResponse
+	(*IterateNameSpaceResponse)(nil),       // 26: proto.IterateNameSpaceResponse
+	(*MinedTransactionResponse)(nil),       // 27: proto.MinedTransactionResponse
+	(*BlockHeaderResponse)(nil),            // 28: proto.BlockHeaderResponse
+	(*BlockHeaderProofResponse)(nil),       // 29: proto.BlockHeaderProofResponse
+	(*UTXOResponse)(nil),                   // 30: proto.UTXOResponse
+	(*PendingTransactionResponse)(nil),     // 31: proto.PendingTransactionResponse
+	(*RoundStateForValidatorResponse)(nil), // 32: proto.RoundStateForValidatorResponse
+	(*ValidatorSetResponse)(nil),           // 33: proto.ValidatorSetResponse
+	(*ValidatorParticipationResponse)(nil), // 34: proto.ValidatorParticipationResponse
+	(*ConsensusTraceResponse)(nil),         // 35: proto.ConsensusTraceResponse
+	(*EthDKGStatusResponse)(nil),           // 36: proto.EthDKGStatusResponse
+	(*SnapshotAlertsResponse)(nil),         // 37: proto.SnapshotAlertsResponse
+	(*KnownPeersResponse)(nil),             // 38: proto.KnownPeersResponse
+	(*ActivePeersResponse)(nil),            // 39: proto.ActivePeersResponse
+	(*DisconnectPeerResponse)(nil),         // 40: proto.DisconnectPeerResponse
+	(*DialPeerResponse)(nil),               // 41: proto.DialPeerResponse
+	(*ClearSnapshotAlertResponse)(nil),     // 42: proto.ClearSnapshotAlertResponse
+	(*BlockNumberResponse)(nil),            // 43: proto.BlockNumberResponse
+	(*ChainIDResponse)(nil),                // 44: proto.ChainIDResponse
+	(*TransactionDetails)(nil),             // 45: proto.TransactionDetails
+	(*EpochNumberResponse)(nil),            // 46: proto.EpochNumberResponse
+	(*TxBlockNumberResponse)(nil),          // 47: proto.TxBlockNumberResponse
 }
 var file_localstate_proto_depIdxs = []int32{
 	0,  // 0: proto.LocalState.GetData:input_type -> proto.GetDataRequest
@@ -173,31 +265,51 @@ var file_localstate_proto_depIdxs = []int32{
 	2,  // 2: proto.LocalState.IterateNameSpace:input_type -> proto.IterateNameSpaceRequest
 	3,  // 3: proto.LocalState.GetMinedTransaction:input_type -> proto.MinedTransactionRequest
 	4,  // 4: proto.LocalState.GetBlockHeader:input_type -> proto.BlockHeaderRequest
-	5,  // 5: proto.LocalState.GetUTXO:input_type -> proto.UTXORequest
-	6,  // 6: proto.LocalState.GetPendingTransaction:input_type -> proto.PendingTransactionRequest
-	7,  // 7: proto.LocalState.GetRoundStateForValidator:input_type -> proto.RoundStateForValidatorRequest
-	8,  // 8: proto.LocalState.GetValidatorSet:input_type -> proto.ValidatorSetRequest
-	9,  // 9: proto.LocalState.GetBlockNumber:input_type -> proto.BlockNumberRequest
-	10, // 10: proto.LocalState.GetChainID:input_type -> proto.ChainIDRequest
-	11, // 11: proto.LocalState.SendTransaction:input_type -> proto.TransactionData
-	12, // 12: proto.LocalState.GetEpochNumber:input_type -> proto.EpochNumberRequest
-	13, // 13: proto.LocalState.GetTxBlockNumber:input_type -> proto.TxBlockNumberRequest
-	14, // 14: proto.LocalState.GetData:output_type -> proto.GetDataResponse
-	15, // 15: proto.LocalState.GetValueForOwner:output_type -> proto.GetValueResponse
-	16, // 16: proto.LocalState.IterateNameSpace:output_type -> proto.IterateNameSpaceResponse
-	17, // 17: proto.LocalState.GetMinedTransaction:output_type -> proto.MinedTransactionResponse
-	18, // 18: proto.LocalState.GetBlockHeader:output_type -> proto.BlockHeaderResponse
-	19, // 19: proto.LocalState.GetUTXO:output_type -> proto.UTXOResponse
-	20, // 20: proto.LocalState.GetPendingTransaction:output_type -> proto.PendingTransactionResponse
-	21, // 21: proto.LocalState.GetRoundStateForValidator:output_type -> proto.RoundStateForValidatorResponse
-	22, // 22: proto.LocalState.GetValidatorSet:output_type -> proto.ValidatorSetResponse
-	23, // 23: proto.LocalState.GetBlockNumber:output_type -> proto.BlockNumberResponse
-	24, // 24: proto.LocalState.GetChainID:output_type -> proto.ChainIDResponse
-	25, // 25: proto.LocalState.SendTransaction:output_type -> proto.TransactionDetails
-	26, // 26: proto.LocalState.GetEpochNumber:output_type -> proto.EpochNumberResponse
-	27, // 27: proto.LocalState.GetTxBlockNumber:output_type -> proto.TxBlockNumberResponse
-	14, // [14:28] is the sub-list for method output_type
-	0,  // [0:14] is the sub-list for method input_type
+	5,  // 5: proto.LocalState.GetBlockHeaderProof:input_type -> proto.BlockHeaderProofRequest
+	6,  // 6: proto.LocalState.GetUTXO:input_type -> proto.UTXORequest
+	7,  // 7: proto.LocalState.GetPendingTransaction:input_type -> proto.PendingTransactionRequest
+	8,  // 8: proto.LocalState.GetRoundStateForValidator:input_type -> proto.RoundStateForValidatorRequest
+	9,  // 9: proto.LocalState.GetValidatorSet:input_type -> proto.ValidatorSetRequest
+	10, // 10: proto.LocalState.GetValidatorParticipation:input_type -> proto.ValidatorParticipationRequest
+	11, // 11: proto.LocalState.GetConsensusTrace:input_type -> proto.ConsensusTraceRequest
+	12, // 12: proto.LocalState.GetEthDKGStatus:input_type -> proto.EthDKGStatusRequest
+	13, // 13: proto.LocalState.GetSnapshotAlerts:input_type -> proto.SnapshotAlertsRequest
+	14, // 14: proto.LocalState.GetKnownPeers:input_type -> proto.KnownPeersRequest
+	15, // 15: proto.LocalState.GetActivePeers:input_type -> proto.ActivePeersRequest
+	16, // 16: proto.LocalState.DisconnectPeer:input_type -> proto.DisconnectPeerRequest
+	17, // 17: proto.LocalState.DialPeer:input_type -> proto.DialPeerRequest
+	18, // 18: proto.LocalState.ClearSnapshotAlert:input_type -> proto.ClearSnapshotAlertRequest
+	19, // 19: proto.LocalState.GetBlockNumber:input_type -> proto.BlockNumberRequest
+	20, // 20: proto.LocalState.GetChainID:input_type -> proto.ChainIDRequest
+	21, // 21: proto.LocalState.SendTransaction:input_type -> proto.TransactionData
+	22, // 22: proto.LocalState.GetEpochNumber:input_type -> proto.EpochNumberRequest
+	23, // 23: proto.LocalState.GetTxBlockNumber:input_type -> proto.TxBlockNumberRequest
+	24, // 24: proto.LocalState.GetData:output_type -> proto.GetDataResponse
+	25, // 25: proto.LocalState.GetValueForOwner:output_type -> proto.GetValueResponse
+	26, // 26: proto.LocalState.IterateNameSpace:output_type -> proto.IterateNameSpaceResponse
+	27, // 27: proto.LocalState.GetMinedTransaction:output_type -> proto.MinedTransactionResponse
+	28, // 28: proto.LocalState.GetBlockHeader:output_type -> proto.BlockHeaderResponse
+	29, // 29: proto.LocalState.GetBlockHeaderProof:output_type -> proto.BlockHeaderProofResponse
+	30, // 30: proto.LocalState.GetUTXO:output_type -> proto.UTXOResponse
+	31, // 31: proto.LocalState.GetPendingTransaction:output_type -> proto.PendingTransactionResponse
+	32, // 32: proto.LocalState.GetRoundStateForValidator:output_type -> proto.RoundStateForValidatorResponse
+	33, // 33: proto.LocalState.GetValidatorSet:output_type -> proto.ValidatorSetResponse
+	34, // 34: proto.LocalState.GetValidatorParticipation:output_type -> proto.ValidatorParticipationResponse
+	35, // 35: proto.LocalState.GetConsensusTrace:output_type -> proto.ConsensusTraceResponse
+	36, // 36: proto.LocalState.GetEthDKGStatus:output_type -> proto.EthDKGStatusResponse
+	37, // 37: proto.LocalState.GetSnapshotAlerts:output_type -> proto.SnapshotAlertsResponse
+	38, // 38: proto.LocalState.GetKnownPeers:output_type -> proto.KnownPeersResponse
+	39, // 39: proto.LocalState.GetActivePeers:output_type -> proto.ActivePeersResponse
+	40, // 40: proto.LocalState.DisconnectPeer:output_type -> proto.DisconnectPeerResponse
+	41, // 41: proto.LocalState.DialPeer:output_type -> proto.DialPeerResponse
+	42, // 42: proto.LocalState.ClearSnapshotAlert:output_type -> proto.ClearSnapshotAlertResponse
+	43, // 43: proto.LocalState.GetBlockNumber:output_type -> proto.BlockNumberResponse
+	44, // 44: proto.LocalState.GetChainID:output_type -> proto.ChainIDResponse
+	45, // 45: proto.LocalState.SendTransaction:output_type -> proto.TransactionDetails
+	46, // 46: proto.LocalState.GetEpochNumber:output_type -> proto.EpochNumberResponse
+	47, // 47: proto.LocalState.GetTxBlockNumber:output_type -> proto.TxBlockNumberResponse
+	24, // [24:48] is the sub-list for method output_type
+	0,  // [0:24] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -251,6 +363,8 @@ type LocalStateClient interface {
 	GetMinedTransaction(ctx context.Context, in *MinedTransactionRequest, opts ...grpc.CallOption) (*MinedTransactionResponse, error)
 	// Get blockheader by hash or blocknumber
 	GetBlockHeader(ctx context.Context, in *BlockHeaderRequest, opts ...grpc.CallOption) (*BlockHeaderResponse, error)
+	// Get a blockheader by blocknumber with its proof of inclusion in the header trie
+	GetBlockHeaderProof(ctx context.Context, in *BlockHeaderProofRequest, opts ...grpc.CallOption) (*BlockHeaderProofResponse, error)
 	// Get a raw UTXO by TxHash and index or by UTXOID
 	GetUTXO(ctx context.Context, in *UTXORequest, opts ...grpc.CallOption) (*UTXOResponse, error)
 	// Get a pending transaction by hash
@@ -260,6 +374,24 @@ type LocalStateClient interface {
 	GetRoundStateForValidator(ctx context.Context, in *RoundStateForValidatorRequest, opts ...grpc.CallOption) (*RoundStateForValidatorResponse, error)
 	// Get the set of validators for a specified block height
 	GetValidatorSet(ctx context.Context, in *ValidatorSetRequest, opts ...grpc.CallOption) (*ValidatorSetResponse, error)
+	// Get the proposal and vote participation of every validator for an epoch
+	GetValidatorParticipation(ctx context.Context, in *ValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipationResponse, error)
+	// Get the most recent state transitions of the consensus engine
+	GetConsensusTrace(ctx context.Context, in *ConsensusTraceRequest, opts ...grpc.CallOption) (*ConsensusTraceResponse, error)
+	// Get the ETHDKG phase, schedule and progress of every participant
+	GetEthDKGStatus(ctx context.Context, in *EthDKGStatusRequest, opts ...grpc.CallOption) (*EthDKGStatusResponse, error)
+	// Get the snapshots on Ethereum that differ from the block headers this node committed
+	GetSnapshotAlerts(ctx context.Context, in *SnapshotAlertsRequest, opts ...grpc.CallOption) (*SnapshotAlertsResponse, error)
+	// Get the peers in the address book of the node
+	GetKnownPeers(ctx context.Context, in *KnownPeersRequest, opts ...grpc.CallOption) (*KnownPeersResponse, error)
+	// Get the peers the node is connected to
+	GetActivePeers(ctx context.Context, in *ActivePeersRequest, opts ...grpc.CallOption) (*ActivePeersResponse, error)
+	// Close the connection to an active peer
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
+	// Connect to a peer by its p2p address
+	DialPeer(ctx context.Context, in *DialPeerRequest, opts ...grpc.CallOption) (*DialPeerResponse, error)
+	// Clear a snapshot alert once an operator has dealt with the mismatch
+	ClearSnapshotAlert(ctx context.Context, in *ClearSnapshotAlertRequest, opts ...grpc.CallOption) (*ClearSnapshotAlertResponse, error)
 	// Get the current block number
 	GetBlockNumber(ctx context.Context, in *BlockNumberRequest, opts ...grpc.CallOption) (*BlockNumberResponse, error)
 	// Get the current ChainID of the node
@@ -325,6 +457,15 @@ func (c *localStateClient) GetBlockHeader(ctx context.Context, in *BlockHeaderRe
 	return out, nil
 }
 
+func (c *localStateClient) GetBlockHeaderProof(ctx context.Context, in *BlockHeaderProofRequest, opts ...grpc.CallOption) (*BlockHeaderProofResponse, error) {
+	out := new(BlockHeaderProofResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetBlockHeaderProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *localStateClient) GetUTXO(ctx context.Context, in *UTXORequest, opts ...grpc.CallOption) (*UTXOResponse, error) {
 	out := new(UTXOResponse)
 	err := c.cc.Invoke(ctx, "/proto.LocalState/GetUTXO", in, out, opts...)
@@ -361,6 +502,87 @@ func (c *localStateClient) GetValidatorSet(ctx context.Context, in *ValidatorSet
 	return out, nil
 }
 
+func (c *localStateClient) GetValidatorParticipation(ctx context.Context, in *ValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipationResponse, error) {
+	out := new(ValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetValidatorParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetConsensusTrace(ctx context.Context, in *ConsensusTraceRequest, opts ...grpc.CallOption) (*ConsensusTraceResponse, error) {
+	out := new(ConsensusTraceResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetConsensusTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetEthDKGStatus(ctx context.Context, in *EthDKGStatusRequest, opts ...grpc.CallOption) (*EthDKGStatusResponse, error) {
+	out := new(EthDKGStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetEthDKGStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetSnapshotAlerts(ctx context.Context, in *SnapshotAlertsRequest, opts ...grpc.CallOption) (*SnapshotAlertsResponse, error) {
+	out := new(SnapshotAlertsResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetSnapshotAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetKnownPeers(ctx context.Context, in *KnownPeersRequest, opts ...grpc.CallOption) (*KnownPeersResponse, error) {
+	out := new(KnownPeersResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetKnownPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) GetActivePeers(ctx context.Context, in *ActivePeersRequest, opts ...grpc.CallOption) (*ActivePeersResponse, error) {
+	out := new(ActivePeersResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/GetActivePeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
+	out := new(DisconnectPeerResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/DisconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) DialPeer(ctx context.Context, in *DialPeerRequest, opts ...grpc.CallOption) (*DialPeerResponse, error) {
+	out := new(DialPeerResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/DialPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localStateClient) ClearSnapshotAlert(ctx context.Context, in *ClearSnapshotAlertRequest, opts ...grpc.CallOption) (*ClearSnapshotAlertResponse, error) {
+	out := new(ClearSnapshotAlertResponse)
+	err := c.cc.Invoke(ctx, "/proto.LocalState/ClearSnapshotAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *localStateClient) GetBlockNumber(ctx context.Context, in *BlockNumberRequest, opts ...grpc.CallOption) (*BlockNumberResponse, error) {
 	out := new(BlockNumberResponse)
 	err := c.cc.Invoke(ctx, "/proto.LocalState/GetBlockNumber", in, out, opts...)
@@ -419,6 +641,8 @@ type LocalStateServer interface {
 	GetMinedTransaction(context.Context, *MinedTransactionRequest) (*MinedTransactionResponse, error)
 	// Get blockheader by hash or blocknumber
 	GetBlockHeader(context.Context, *BlockHeaderRequest) (*BlockHeaderResponse, error)
+	// Get a blockheader by blocknumber with its proof of inclusion in the header trie
+	GetBlockHeaderProof(context.Context, *BlockHeaderProofRequest) (*BlockHeaderProofResponse, error)
 	// Get a raw UTXO by TxHash and index or by UTXOID
 	GetUTXO(context.Context, *UTXORequest) (*UTXOResponse, error)
 	// Get a pending transaction by hash
@@ -428,6 +652,24 @@ type LocalStateServer interface {
 	GetRoundStateForValidator(context.Context, *RoundStateForValidatorRequest) (*RoundStateForValidatorResponse, error)
 	// Get the set of validators for a specified block height
 	GetValidatorSet(context.Context, *ValidatorSetRequest) (*ValidatorSetResponse, error)
+	// Get the proposal and vote participation of every validator for an epoch
+	GetValidatorParticipation(context.Context, *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error)
+	// Get the most recent state transitions of the consensus engine
+	GetConsensusTrace(context.Context, *ConsensusTraceRequest) (*ConsensusTraceResponse, error)
+	// Get the ETHDKG phase, schedule and progress of every participant
+	GetEthDKGStatus(context.Context, *EthDKGStatusRequest) (*EthDKGStatusResponse, error)
+	// Get the snapshots on Ethereum that differ from the block headers this node committed
+	GetSnapshotAlerts(context.Context, *SnapshotAlertsRequest) (*SnapshotAlertsResponse, error)
+	// Get the peers in the address book of the node
+	GetKnownPeers(context.Context, *KnownPeersRequest) (*KnownPeersResponse, error)
+	// Get the peers the node is connected to
+	GetActivePeers(context.Context, *ActivePeersRequest) (*ActivePeersResponse, error)
+	// Close the connection to an active peer
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+	// Connect to a peer by its p2p address
+	DialPeer(context.Context, *DialPeerRequest) (*DialPeerResponse, error)
+	// Clear a snapshot alert once an operator has dealt with the mismatch
+	ClearSnapshotAlert(context.Context, *ClearSnapshotAlertRequest) (*ClearSnapshotAlertResponse, error)
 	// Get the current block number
 	GetBlockNumber(context.Context, *BlockNumberRequest) (*BlockNumberResponse, error)
 	// Get the current ChainID of the node
@@ -459,6 +701,9 @@ func (*UnimplementedLocalStateServer) GetMinedTransaction(context.Context, *Mine
 func (*UnimplementedLocalStateServer) GetBlockHeader(context.Context, *BlockHeaderRequest) (*BlockHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeader not implemented")
 }
+func (*UnimplementedLocalStateServer) GetBlockHeaderProof(context.Context, *BlockHeaderProofRequest) (*BlockHeaderProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderProof not implemented")
+}
 func (*UnimplementedLocalStateServer) GetUTXO(context.Context, *UTXORequest) (*UTXOResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUTXO not implemented")
 }
@@ -471,6 +716,33 @@ func (*UnimplementedLocalStateServer) GetRoundStateForValidator(context.Context,
 func (*UnimplementedLocalStateServer) GetValidatorSet(context.Context, *ValidatorSetRequest) (*ValidatorSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSet not implemented")
 }
+func (*UnimplementedLocalStateServer) GetValidatorParticipation(context.Context, *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorParticipation not implemented")
+}
+func (*UnimplementedLocalStateServer) GetConsensusTrace(context.Context, *ConsensusTraceRequest) (*ConsensusTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsensusTrace not implemented")
+}
+func (*UnimplementedLocalStateServer) GetEthDKGStatus(context.Context, *EthDKGStatusRequest) (*EthDKGStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEthDKGStatus not implemented")
+}
+func (*UnimplementedLocalStateServer) GetSnapshotAlerts(context.Context, *SnapshotAlertsRequest) (*SnapshotAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshotAlerts not implemented")
+}
+func (*UnimplementedLocalStateServer) GetKnownPeers(context.Context, *KnownPeersRequest) (*KnownPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnownPeers not implemented")
+}
+func (*UnimplementedLocalStateServer) GetActivePeers(context.Context, *ActivePeersRequest) (*ActivePeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivePeers not implemented")
+}
+func (*UnimplementedLocalStateServer) DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPeer not implemented")
+}
+func (*UnimplementedLocalStateServer) DialPeer(context.Context, *DialPeerRequest) (*DialPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DialPeer not implemented")
+}
+func (*UnimplementedLocalStateServer) ClearSnapshotAlert(context.Context, *ClearSnapshotAlertRequest) (*ClearSnapshotAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearSnapshotAlert not implemented")
+}
 func (*UnimplementedLocalStateServer) GetBlockNumber(context.Context, *BlockNumberRequest) (*BlockNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockNumber not implemented")
 }
@@ -581,6 +853,24 @@ func _LocalState_GetBlockHeader_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocalState_GetBlockHeaderProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeaderProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetBlockHeaderProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetBlockHeaderProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetBlockHeaderProof(ctx, req.(*BlockHeaderProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LocalState_GetUTXO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UTXORequest)
 	if err := dec(in); err != nil {
@@ -653,6 +943,168 @@ func _LocalState_GetValidatorSet_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocalState_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetValidatorParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetValidatorParticipation(ctx, req.(*ValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetConsensusTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsensusTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetConsensusTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetConsensusTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetConsensusTrace(ctx, req.(*ConsensusTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetEthDKGStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthDKGStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetEthDKGStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetEthDKGStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetEthDKGStatus(ctx, req.(*EthDKGStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetSnapshotAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetSnapshotAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetSnapshotAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetSnapshotAlerts(ctx, req.(*SnapshotAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetKnownPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnownPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetKnownPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetKnownPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetKnownPeers(ctx, req.(*KnownPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_GetActivePeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivePeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).GetActivePeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/GetActivePeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).GetActivePeers(ctx, req.(*ActivePeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_DialPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DialPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).DialPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/DialPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).DialPeer(ctx, req.(*DialPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalState_ClearSnapshotAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearSnapshotAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalStateServer).ClearSnapshotAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.LocalState/ClearSnapshotAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalStateServer).ClearSnapshotAlert(ctx, req.(*ClearSnapshotAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LocalState_GetBlockNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockNumberRequest)
 	if err := dec(in); err != nil {
@@ -767,6 +1219,10 @@ var _LocalState_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockHeader",
 			Handler:    _LocalState_GetBlockHeader_Handler,
 		},
+		{
+			MethodName: "GetBlockHeaderProof",
+			Handler:    _LocalState_GetBlockHeaderProof_Handler,
+		},
 		{
 			MethodName: "GetUTXO",
 			Handler:    _LocalState_GetUTXO_Handler,
@@ -783,6 +1239,42 @@ var _LocalState_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorSet",
 			Handler:    _LocalState_GetValidatorSet_Handler,
 		},
+		{
+			MethodName: "GetValidatorParticipation",
+			Handler:    _LocalState_GetValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "GetConsensusTrace",
+			Handler:    _LocalState_GetConsensusTrace_Handler,
+		},
+		{
+			MethodName: "GetEthDKGStatus",
+			Handler:    _LocalState_GetEthDKGStatus_Handler,
+		},
+		{
+			MethodName: "GetSnapshotAlerts",
+			Handler:    _LocalState_GetSnapshotAlerts_Handler,
+		},
+		{
+			MethodName: "GetKnownPeers",
+			Handler:    _LocalState_GetKnownPeers_Handler,
+		},
+		{
+			MethodName: "GetActivePeers",
+			Handler:    _LocalState_GetActivePeers_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _LocalState_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "DialPeer",
+			Handler:    _LocalState_DialPeer_Handler,
+		},
+		{
+			MethodName: "ClearSnapshotAlert",
+			Handler:    _LocalState_ClearSnapshotAlert_Handler,
+		},
 		{
 			MethodName: "GetBlockNumber",
 			Handler:    _LocalState_GetBlockNumber_Handler,
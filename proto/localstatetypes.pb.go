@@ -441,6 +441,124 @@ func (x *BlockHeaderResponse) GetBlockHeader() *BlockHeader {
 	return nil
 }
 
+type BlockHeaderProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"` // must not be zero
+}
+
+func (x *BlockHeaderProofRequest) Reset() {
+	*x = BlockHeaderProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHeaderProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeaderProofRequest) ProtoMessage() {}
+
+func (x *BlockHeaderProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeaderProofRequest.ProtoReflect.Descriptor instead.
+func (*BlockHeaderProofRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BlockHeaderProofRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type BlockHeaderProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeader *BlockHeader `protobuf:"bytes,1,opt,name=BlockHeader,proto3" json:"BlockHeader,omitempty"`
+	Proof       string       `protobuf:"bytes,2,opt,name=Proof,proto3" json:"Proof,omitempty"`            // merkle proof of the header in the header trie
+	HeaderRoot  string       `protobuf:"bytes,3,opt,name=HeaderRoot,proto3" json:"HeaderRoot,omitempty"`  // root of the header trie the proof is against
+	RootHeight  uint32       `protobuf:"varint,4,opt,name=RootHeight,proto3" json:"RootHeight,omitempty"` // height of the last header in that trie
+}
+
+func (x *BlockHeaderProofResponse) Reset() {
+	*x = BlockHeaderProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHeaderProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeaderProofResponse) ProtoMessage() {}
+
+func (x *BlockHeaderProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeaderProofResponse.ProtoReflect.Descriptor instead.
+func (*BlockHeaderProofResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BlockHeaderProofResponse) GetBlockHeader() *BlockHeader {
+	if x != nil {
+		return x.BlockHeader
+	}
+	return nil
+}
+
+func (x *BlockHeaderProofResponse) GetProof() string {
+	if x != nil {
+		return x.Proof
+	}
+	return ""
+}
+
+func (x *BlockHeaderProofResponse) GetHeaderRoot() string {
+	if x != nil {
+		return x.HeaderRoot
+	}
+	return ""
+}
+
+func (x *BlockHeaderProofResponse) GetRootHeight() uint32 {
+	if x != nil {
+		return x.RootHeight
+	}
+	return 0
+}
+
 type UTXORequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -452,7 +570,7 @@ type UTXORequest struct {
 func (x *UTXORequest) Reset() {
 	*x = UTXORequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[8]
+		mi := &file_localstatetypes_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +583,7 @@ func (x *UTXORequest) String() string {
 func (*UTXORequest) ProtoMessage() {}
 
 func (x *UTXORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[8]
+	mi := &file_localstatetypes_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +596,7 @@ func (x *UTXORequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UTXORequest.ProtoReflect.Descriptor instead.
 func (*UTXORequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{8}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UTXORequest) GetUTXOIDs() []string {
@@ -499,7 +617,7 @@ type UTXOResponse struct {
 func (x *UTXOResponse) Reset() {
 	*x = UTXOResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[9]
+		mi := &file_localstatetypes_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +630,7 @@ func (x *UTXOResponse) String() string {
 func (*UTXOResponse) ProtoMessage() {}
 
 func (x *UTXOResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[9]
+	mi := &file_localstatetypes_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +643,7 @@ func (x *UTXOResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UTXOResponse.ProtoReflect.Descriptor instead.
 func (*UTXOResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{9}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UTXOResponse) GetUTXOs() []*TXOut {
@@ -546,7 +664,7 @@ type PendingTransactionRequest struct {
 func (x *PendingTransactionRequest) Reset() {
 	*x = PendingTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[10]
+		mi := &file_localstatetypes_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -559,7 +677,7 @@ func (x *PendingTransactionRequest) String() string {
 func (*PendingTransactionRequest) ProtoMessage() {}
 
 func (x *PendingTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[10]
+	mi := &file_localstatetypes_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +690,7 @@ func (x *PendingTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingTransactionRequest.ProtoReflect.Descriptor instead.
 func (*PendingTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{10}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PendingTransactionRequest) GetTxHash() string {
@@ -593,7 +711,7 @@ type PendingTransactionResponse struct {
 func (x *PendingTransactionResponse) Reset() {
 	*x = PendingTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[11]
+		mi := &file_localstatetypes_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -606,7 +724,7 @@ func (x *PendingTransactionResponse) String() string {
 func (*PendingTransactionResponse) ProtoMessage() {}
 
 func (x *PendingTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[11]
+	mi := &file_localstatetypes_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +737,7 @@ func (x *PendingTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingTransactionResponse.ProtoReflect.Descriptor instead.
 func (*PendingTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{11}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PendingTransactionResponse) GetTx() *Tx {
@@ -638,7 +756,7 @@ type BlockNumberRequest struct {
 func (x *BlockNumberRequest) Reset() {
 	*x = BlockNumberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[12]
+		mi := &file_localstatetypes_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -651,7 +769,7 @@ func (x *BlockNumberRequest) String() string {
 func (*BlockNumberRequest) ProtoMessage() {}
 
 func (x *BlockNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[12]
+	mi := &file_localstatetypes_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +782,7 @@ func (x *BlockNumberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockNumberRequest.ProtoReflect.Descriptor instead.
 func (*BlockNumberRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{12}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{14}
 }
 
 type BlockNumberResponse struct {
@@ -678,7 +796,7 @@ type BlockNumberResponse struct {
 func (x *BlockNumberResponse) Reset() {
 	*x = BlockNumberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[13]
+		mi := &file_localstatetypes_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -691,7 +809,7 @@ func (x *BlockNumberResponse) String() string {
 func (*BlockNumberResponse) ProtoMessage() {}
 
 func (x *BlockNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[13]
+	mi := &file_localstatetypes_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,7 +822,7 @@ func (x *BlockNumberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockNumberResponse.ProtoReflect.Descriptor instead.
 func (*BlockNumberResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{13}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BlockNumberResponse) GetBlockHeight() uint32 {
@@ -723,7 +841,7 @@ type ChainIDRequest struct {
 func (x *ChainIDRequest) Reset() {
 	*x = ChainIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[14]
+		mi := &file_localstatetypes_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -736,7 +854,7 @@ func (x *ChainIDRequest) String() string {
 func (*ChainIDRequest) ProtoMessage() {}
 
 func (x *ChainIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[14]
+	mi := &file_localstatetypes_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,7 +867,7 @@ func (x *ChainIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainIDRequest.ProtoReflect.Descriptor instead.
 func (*ChainIDRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{14}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{16}
 }
 
 type ChainIDResponse struct {
@@ -763,7 +881,7 @@ type ChainIDResponse struct {
 func (x *ChainIDResponse) Reset() {
 	*x = ChainIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[15]
+		mi := &file_localstatetypes_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +894,7 @@ func (x *ChainIDResponse) String() string {
 func (*ChainIDResponse) ProtoMessage() {}
 
 func (x *ChainIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[15]
+	mi := &file_localstatetypes_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +907,7 @@ func (x *ChainIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainIDResponse.ProtoReflect.Descriptor instead.
 func (*ChainIDResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{15}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ChainIDResponse) GetChainID() uint32 {
@@ -810,7 +928,7 @@ type TransactionData struct {
 func (x *TransactionData) Reset() {
 	*x = TransactionData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[16]
+		mi := &file_localstatetypes_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -823,7 +941,7 @@ func (x *TransactionData) String() string {
 func (*TransactionData) ProtoMessage() {}
 
 func (x *TransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[16]
+	mi := &file_localstatetypes_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,7 +954,7 @@ func (x *TransactionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
 func (*TransactionData) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{16}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TransactionData) GetTx() *Tx {
@@ -857,7 +975,7 @@ type TransactionDetails struct {
 func (x *TransactionDetails) Reset() {
 	*x = TransactionDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[17]
+		mi := &file_localstatetypes_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -870,7 +988,7 @@ func (x *TransactionDetails) String() string {
 func (*TransactionDetails) ProtoMessage() {}
 
 func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[17]
+	mi := &file_localstatetypes_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1001,7 @@ func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionDetails.ProtoReflect.Descriptor instead.
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{17}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TransactionDetails) GetTxHash() string {
@@ -902,7 +1020,7 @@ type EpochNumberRequest struct {
 func (x *EpochNumberRequest) Reset() {
 	*x = EpochNumberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[18]
+		mi := &file_localstatetypes_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -915,7 +1033,7 @@ func (x *EpochNumberRequest) String() string {
 func (*EpochNumberRequest) ProtoMessage() {}
 
 func (x *EpochNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[18]
+	mi := &file_localstatetypes_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1046,7 @@ func (x *EpochNumberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochNumberRequest.ProtoReflect.Descriptor instead.
 func (*EpochNumberRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{18}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{20}
 }
 
 type EpochNumberResponse struct {
@@ -942,7 +1060,7 @@ type EpochNumberResponse struct {
 func (x *EpochNumberResponse) Reset() {
 	*x = EpochNumberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[19]
+		mi := &file_localstatetypes_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -955,7 +1073,7 @@ func (x *EpochNumberResponse) String() string {
 func (*EpochNumberResponse) ProtoMessage() {}
 
 func (x *EpochNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[19]
+	mi := &file_localstatetypes_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1086,7 @@ func (x *EpochNumberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochNumberResponse.ProtoReflect.Descriptor instead.
 func (*EpochNumberResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{19}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *EpochNumberResponse) GetEpoch() uint32 {
@@ -992,7 +1110,7 @@ type IterateNameSpaceRequest struct {
 func (x *IterateNameSpaceRequest) Reset() {
 	*x = IterateNameSpaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[20]
+		mi := &file_localstatetypes_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1005,7 +1123,7 @@ func (x *IterateNameSpaceRequest) String() string {
 func (*IterateNameSpaceRequest) ProtoMessage() {}
 
 func (x *IterateNameSpaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[20]
+	mi := &file_localstatetypes_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1136,7 @@ func (x *IterateNameSpaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IterateNameSpaceRequest.ProtoReflect.Descriptor instead.
 func (*IterateNameSpaceRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{20}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *IterateNameSpaceRequest) GetCurveSpec() uint32 {
@@ -1060,7 +1178,7 @@ type IterateNameSpaceResponse struct {
 func (x *IterateNameSpaceResponse) Reset() {
 	*x = IterateNameSpaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[21]
+		mi := &file_localstatetypes_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1073,7 +1191,7 @@ func (x *IterateNameSpaceResponse) String() string {
 func (*IterateNameSpaceResponse) ProtoMessage() {}
 
 func (x *IterateNameSpaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[21]
+	mi := &file_localstatetypes_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1204,7 @@ func (x *IterateNameSpaceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IterateNameSpaceResponse.ProtoReflect.Descriptor instead.
 func (*IterateNameSpaceResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{21}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *IterateNameSpaceResponse) GetResults() []*IterateNameSpaceResponse_Result {
@@ -1107,7 +1225,7 @@ type TxBlockNumberRequest struct {
 func (x *TxBlockNumberRequest) Reset() {
 	*x = TxBlockNumberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[22]
+		mi := &file_localstatetypes_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1120,7 +1238,7 @@ func (x *TxBlockNumberRequest) String() string {
 func (*TxBlockNumberRequest) ProtoMessage() {}
 
 func (x *TxBlockNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[22]
+	mi := &file_localstatetypes_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,7 +1251,7 @@ func (x *TxBlockNumberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxBlockNumberRequest.ProtoReflect.Descriptor instead.
 func (*TxBlockNumberRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{22}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TxBlockNumberRequest) GetTxHash() string {
@@ -1154,7 +1272,7 @@ type TxBlockNumberResponse struct {
 func (x *TxBlockNumberResponse) Reset() {
 	*x = TxBlockNumberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[23]
+		mi := &file_localstatetypes_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1167,7 +1285,7 @@ func (x *TxBlockNumberResponse) String() string {
 func (*TxBlockNumberResponse) ProtoMessage() {}
 
 func (x *TxBlockNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[23]
+	mi := &file_localstatetypes_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,7 +1298,7 @@ func (x *TxBlockNumberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxBlockNumberResponse.ProtoReflect.Descriptor instead.
 func (*TxBlockNumberResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{23}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *TxBlockNumberResponse) GetBlockHeight() uint32 {
@@ -1201,7 +1319,7 @@ type ValidatorSetRequest struct {
 func (x *ValidatorSetRequest) Reset() {
 	*x = ValidatorSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[24]
+		mi := &file_localstatetypes_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1214,7 +1332,7 @@ func (x *ValidatorSetRequest) String() string {
 func (*ValidatorSetRequest) ProtoMessage() {}
 
 func (x *ValidatorSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[24]
+	mi := &file_localstatetypes_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,7 +1345,7 @@ func (x *ValidatorSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorSetRequest.ProtoReflect.Descriptor instead.
 func (*ValidatorSetRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{24}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ValidatorSetRequest) GetHeight() uint32 {
@@ -1248,7 +1366,7 @@ type ValidatorSetResponse struct {
 func (x *ValidatorSetResponse) Reset() {
 	*x = ValidatorSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[25]
+		mi := &file_localstatetypes_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1261,7 +1379,7 @@ func (x *ValidatorSetResponse) String() string {
 func (*ValidatorSetResponse) ProtoMessage() {}
 
 func (x *ValidatorSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[25]
+	mi := &file_localstatetypes_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1392,7 @@ func (x *ValidatorSetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorSetResponse.ProtoReflect.Descriptor instead.
 func (*ValidatorSetResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{25}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ValidatorSetResponse) GetValidatorSet() string {
@@ -1297,7 +1415,7 @@ type RoundStateForValidatorRequest struct {
 func (x *RoundStateForValidatorRequest) Reset() {
 	*x = RoundStateForValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[26]
+		mi := &file_localstatetypes_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1310,7 +1428,7 @@ func (x *RoundStateForValidatorRequest) String() string {
 func (*RoundStateForValidatorRequest) ProtoMessage() {}
 
 func (x *RoundStateForValidatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[26]
+	mi := &file_localstatetypes_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,7 +1441,7 @@ func (x *RoundStateForValidatorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundStateForValidatorRequest.ProtoReflect.Descriptor instead.
 func (*RoundStateForValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{26}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RoundStateForValidatorRequest) GetVAddr() string {
@@ -1358,7 +1476,7 @@ type RoundStateForValidatorResponse struct {
 func (x *RoundStateForValidatorResponse) Reset() {
 	*x = RoundStateForValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[27]
+		mi := &file_localstatetypes_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1371,7 +1489,7 @@ func (x *RoundStateForValidatorResponse) String() string {
 func (*RoundStateForValidatorResponse) ProtoMessage() {}
 
 func (x *RoundStateForValidatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[27]
+	mi := &file_localstatetypes_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,7 +1502,7 @@ func (x *RoundStateForValidatorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundStateForValidatorResponse.ProtoReflect.Descriptor instead.
 func (*RoundStateForValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{27}
+	return file_localstatetypes_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *RoundStateForValidatorResponse) GetRoundState() []byte {
@@ -1394,32 +1512,31 @@ func (x *RoundStateForValidatorResponse) GetRoundState() []byte {
 	return nil
 }
 
-type IterateNameSpaceResponse_Result struct {
+type ValidatorParticipationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UTXOID string `protobuf:"bytes,1,opt,name=UTXOID,proto3" json:"UTXOID,omitempty"`
-	Index  string `protobuf:"bytes,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	Epoch uint32 `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"` // zero for the current epoch
 }
 
-func (x *IterateNameSpaceResponse_Result) Reset() {
-	*x = IterateNameSpaceResponse_Result{}
+func (x *ValidatorParticipationRequest) Reset() {
+	*x = ValidatorParticipationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_localstatetypes_proto_msgTypes[28]
+		mi := &file_localstatetypes_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IterateNameSpaceResponse_Result) String() string {
+func (x *ValidatorParticipationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IterateNameSpaceResponse_Result) ProtoMessage() {}
+func (*ValidatorParticipationRequest) ProtoMessage() {}
 
-func (x *IterateNameSpaceResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_localstatetypes_proto_msgTypes[28]
+func (x *ValidatorParticipationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1430,43 +1547,1540 @@ func (x *IterateNameSpaceResponse_Result) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IterateNameSpaceResponse_Result.ProtoReflect.Descriptor instead.
-func (*IterateNameSpaceResponse_Result) Descriptor() ([]byte, []int) {
-	return file_localstatetypes_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use ValidatorParticipationRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *IterateNameSpaceResponse_Result) GetUTXOID() string {
+func (x *ValidatorParticipationRequest) GetEpoch() uint32 {
 	if x != nil {
-		return x.UTXOID
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (x *IterateNameSpaceResponse_Result) GetIndex() string {
-	if x != nil {
-		return x.Index
+type ValidatorParticipationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      uint32                                      `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	LastHeight uint32                                      `protobuf:"varint,2,opt,name=LastHeight,proto3" json:"LastHeight,omitempty"` // last height included in the counts
+	Validators []*ValidatorParticipationResponse_Validator `protobuf:"bytes,3,rep,name=Validators,proto3" json:"Validators,omitempty"`
+}
+
+func (x *ValidatorParticipationResponse) Reset() {
+	*x = ValidatorParticipationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-var File_localstatetypes_proto protoreflect.FileDescriptor
+func (x *ValidatorParticipationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_localstatetypes_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b,
-	0x61, 0x6f, 0x62, 0x6a, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x63, 0x6f, 0x62,
-	0x6a, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x75,
-	0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x43,
-	0x75, 0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52,
-	0x61, 0x77, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x61,
-	0x77, 0x64, 0x61, 0x74, 0x61, 0x22, 0x65, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x75, 0x72, 0x76,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x43, 0x75, 0x72,
+func (*ValidatorParticipationResponse) ProtoMessage() {}
+
+func (x *ValidatorParticipationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorParticipationResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ValidatorParticipationResponse) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorParticipationResponse) GetLastHeight() uint32 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+func (x *ValidatorParticipationResponse) GetValidators() []*ValidatorParticipationResponse_Validator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+type EthDKGStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EthDKGStatusRequest) Reset() {
+	*x = EthDKGStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthDKGStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthDKGStatusRequest) ProtoMessage() {}
+
+func (x *EthDKGStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthDKGStatusRequest.ProtoReflect.Descriptor instead.
+func (*EthDKGStatusRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{32}
+}
+
+type EthDKGStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase          string                              `protobuf:"bytes,1,opt,name=Phase,proto3" json:"Phase,omitempty"`
+	Address        string                              `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`                // our account
+	CurrentBlock   uint64                              `protobuf:"varint,3,opt,name=CurrentBlock,proto3" json:"CurrentBlock,omitempty"`     // latest Ethereum block
+	ProcessedBlock uint64                              `protobuf:"varint,4,opt,name=ProcessedBlock,proto3" json:"ProcessedBlock,omitempty"` // latest Ethereum block processed by the node
+	Schedule       []*EthDKGStatusResponse_Window      `protobuf:"bytes,5,rep,name=Schedule,proto3" json:"Schedule,omitempty"`
+	Participants   []*EthDKGStatusResponse_Participant `protobuf:"bytes,6,rep,name=Participants,proto3" json:"Participants,omitempty"`
+	Failures       uint32                              `protobuf:"varint,7,opt,name=Failures,proto3" json:"Failures,omitempty"`         // consecutive rounds that failed
+	RestartBlock   uint64                              `protobuf:"varint,8,opt,name=RestartBlock,proto3" json:"RestartBlock,omitempty"` // block a new round will be asked for at, zero if none
+}
+
+func (x *EthDKGStatusResponse) Reset() {
+	*x = EthDKGStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthDKGStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthDKGStatusResponse) ProtoMessage() {}
+
+func (x *EthDKGStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthDKGStatusResponse.ProtoReflect.Descriptor instead.
+func (*EthDKGStatusResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EthDKGStatusResponse) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *EthDKGStatusResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EthDKGStatusResponse) GetCurrentBlock() uint64 {
+	if x != nil {
+		return x.CurrentBlock
+	}
+	return 0
+}
+
+func (x *EthDKGStatusResponse) GetProcessedBlock() uint64 {
+	if x != nil {
+		return x.ProcessedBlock
+	}
+	return 0
+}
+
+func (x *EthDKGStatusResponse) GetSchedule() []*EthDKGStatusResponse_Window {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *EthDKGStatusResponse) GetParticipants() []*EthDKGStatusResponse_Participant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *EthDKGStatusResponse) GetFailures() uint32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *EthDKGStatusResponse) GetRestartBlock() uint64 {
+	if x != nil {
+		return x.RestartBlock
+	}
+	return 0
+}
+
+type SnapshotAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SnapshotAlertsRequest) Reset() {
+	*x = SnapshotAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotAlertsRequest) ProtoMessage() {}
+
+func (x *SnapshotAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotAlertsRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{34}
+}
+
+type SnapshotAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alerts []*SnapshotAlertsResponse_Alert `protobuf:"bytes,1,rep,name=Alerts,proto3" json:"Alerts,omitempty"` // ordered by height
+}
+
+func (x *SnapshotAlertsResponse) Reset() {
+	*x = SnapshotAlertsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotAlertsResponse) ProtoMessage() {}
+
+func (x *SnapshotAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotAlertsResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SnapshotAlertsResponse) GetAlerts() []*SnapshotAlertsResponse_Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type KnownPeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KnownPeersRequest) Reset() {
+	*x = KnownPeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnownPeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnownPeersRequest) ProtoMessage() {}
+
+func (x *KnownPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnownPeersRequest.ProtoReflect.Descriptor instead.
+func (*KnownPeersRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{36}
+}
+
+type KnownPeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers []*KnownPeersResponse_Peer `protobuf:"bytes,1,rep,name=Peers,proto3" json:"Peers,omitempty"`
+}
+
+func (x *KnownPeersResponse) Reset() {
+	*x = KnownPeersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnownPeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnownPeersResponse) ProtoMessage() {}
+
+func (x *KnownPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnownPeersResponse.ProtoReflect.Descriptor instead.
+func (*KnownPeersResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *KnownPeersResponse) GetPeers() []*KnownPeersResponse_Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type ActivePeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ActivePeersRequest) Reset() {
+	*x = ActivePeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivePeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivePeersRequest) ProtoMessage() {}
+
+func (x *ActivePeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivePeersRequest.ProtoReflect.Descriptor instead.
+func (*ActivePeersRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{38}
+}
+
+type ActivePeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers []*ActivePeersResponse_Peer `protobuf:"bytes,1,rep,name=Peers,proto3" json:"Peers,omitempty"`
+}
+
+func (x *ActivePeersResponse) Reset() {
+	*x = ActivePeersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivePeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivePeersResponse) ProtoMessage() {}
+
+func (x *ActivePeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivePeersResponse.ProtoReflect.Descriptor instead.
+func (*ActivePeersResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ActivePeersResponse) GetPeers() []*ActivePeersResponse_Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type DisconnectPeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"` // p2p address of the peer
+}
+
+func (x *DisconnectPeerRequest) Reset() {
+	*x = DisconnectPeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectPeerRequest) ProtoMessage() {}
+
+func (x *DisconnectPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectPeerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DisconnectPeerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DisconnectPeerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisconnectPeerResponse) Reset() {
+	*x = DisconnectPeerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectPeerResponse) ProtoMessage() {}
+
+func (x *DisconnectPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectPeerResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{41}
+}
+
+type DialPeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"` // p2p address of the peer
+}
+
+func (x *DialPeerRequest) Reset() {
+	*x = DialPeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DialPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DialPeerRequest) ProtoMessage() {}
+
+func (x *DialPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DialPeerRequest.ProtoReflect.Descriptor instead.
+func (*DialPeerRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DialPeerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DialPeerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DialPeerResponse) Reset() {
+	*x = DialPeerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DialPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DialPeerResponse) ProtoMessage() {}
+
+func (x *DialPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DialPeerResponse.ProtoReflect.Descriptor instead.
+func (*DialPeerResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{43}
+}
+
+type ClearSnapshotAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"` // height of the alert to clear
+}
+
+func (x *ClearSnapshotAlertRequest) Reset() {
+	*x = ClearSnapshotAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearSnapshotAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSnapshotAlertRequest) ProtoMessage() {}
+
+func (x *ClearSnapshotAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSnapshotAlertRequest.ProtoReflect.Descriptor instead.
+func (*ClearSnapshotAlertRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ClearSnapshotAlertRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ClearSnapshotAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearSnapshotAlertResponse) Reset() {
+	*x = ClearSnapshotAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearSnapshotAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSnapshotAlertResponse) ProtoMessage() {}
+
+func (x *ClearSnapshotAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSnapshotAlertResponse.ProtoReflect.Descriptor instead.
+func (*ClearSnapshotAlertResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{45}
+}
+
+type ConsensusTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint32 `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"` // number of most recent events to return
+}
+
+func (x *ConsensusTraceRequest) Reset() {
+	*x = ConsensusTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusTraceRequest) ProtoMessage() {}
+
+func (x *ConsensusTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusTraceRequest.ProtoReflect.Descriptor instead.
+func (*ConsensusTraceRequest) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ConsensusTraceRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ConsensusTraceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*ConsensusTraceResponse_Event `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"` // newest first
+}
+
+func (x *ConsensusTraceResponse) Reset() {
+	*x = ConsensusTraceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusTraceResponse) ProtoMessage() {}
+
+func (x *ConsensusTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusTraceResponse.ProtoReflect.Descriptor instead.
+func (*ConsensusTraceResponse) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ConsensusTraceResponse) GetEvents() []*ConsensusTraceResponse_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type IterateNameSpaceResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UTXOID string `protobuf:"bytes,1,opt,name=UTXOID,proto3" json:"UTXOID,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=Index,proto3" json:"Index,omitempty"`
+}
+
+func (x *IterateNameSpaceResponse_Result) Reset() {
+	*x = IterateNameSpaceResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IterateNameSpaceResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IterateNameSpaceResponse_Result) ProtoMessage() {}
+
+func (x *IterateNameSpaceResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IterateNameSpaceResponse_Result.ProtoReflect.Descriptor instead.
+func (*IterateNameSpaceResponse_Result) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *IterateNameSpaceResponse_Result) GetUTXOID() string {
+	if x != nil {
+		return x.UTXOID
+	}
+	return ""
+}
+
+func (x *IterateNameSpaceResponse_Result) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type ValidatorParticipationResponse_Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VAddr           string `protobuf:"bytes,1,opt,name=VAddr,proto3" json:"VAddr,omitempty"`
+	Rounds          uint32 `protobuf:"varint,2,opt,name=Rounds,proto3" json:"Rounds,omitempty"`
+	ProposalsMade   uint32 `protobuf:"varint,3,opt,name=ProposalsMade,proto3" json:"ProposalsMade,omitempty"`
+	ProposalsMissed uint32 `protobuf:"varint,4,opt,name=ProposalsMissed,proto3" json:"ProposalsMissed,omitempty"`
+	PreVotes        uint32 `protobuf:"varint,5,opt,name=PreVotes,proto3" json:"PreVotes,omitempty"`
+	PreCommits      uint32 `protobuf:"varint,6,opt,name=PreCommits,proto3" json:"PreCommits,omitempty"`
+}
+
+func (x *ValidatorParticipationResponse_Validator) Reset() {
+	*x = ValidatorParticipationResponse_Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorParticipationResponse_Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorParticipationResponse_Validator) ProtoMessage() {}
+
+func (x *ValidatorParticipationResponse_Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorParticipationResponse_Validator.ProtoReflect.Descriptor instead.
+func (*ValidatorParticipationResponse_Validator) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *ValidatorParticipationResponse_Validator) GetVAddr() string {
+	if x != nil {
+		return x.VAddr
+	}
+	return ""
+}
+
+func (x *ValidatorParticipationResponse_Validator) GetRounds() uint32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *ValidatorParticipationResponse_Validator) GetProposalsMade() uint32 {
+	if x != nil {
+		return x.ProposalsMade
+	}
+	return 0
+}
+
+func (x *ValidatorParticipationResponse_Validator) GetProposalsMissed() uint32 {
+	if x != nil {
+		return x.ProposalsMissed
+	}
+	return 0
+}
+
+func (x *ValidatorParticipationResponse_Validator) GetPreVotes() uint32 {
+	if x != nil {
+		return x.PreVotes
+	}
+	return 0
+}
+
+func (x *ValidatorParticipationResponse_Validator) GetPreCommits() uint32 {
+	if x != nil {
+		return x.PreCommits
+	}
+	return 0
+}
+
+type EthDKGStatusResponse_Window struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase      string `protobuf:"bytes,1,opt,name=Phase,proto3" json:"Phase,omitempty"`
+	StartBlock uint64 `protobuf:"varint,2,opt,name=StartBlock,proto3" json:"StartBlock,omitempty"`
+	EndBlock   uint64 `protobuf:"varint,3,opt,name=EndBlock,proto3" json:"EndBlock,omitempty"`
+	TaskStatus string `protobuf:"bytes,4,opt,name=TaskStatus,proto3" json:"TaskStatus,omitempty"` // how far our task for the phase got
+}
+
+func (x *EthDKGStatusResponse_Window) Reset() {
+	*x = EthDKGStatusResponse_Window{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthDKGStatusResponse_Window) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthDKGStatusResponse_Window) ProtoMessage() {}
+
+func (x *EthDKGStatusResponse_Window) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthDKGStatusResponse_Window.ProtoReflect.Descriptor instead.
+func (*EthDKGStatusResponse_Window) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *EthDKGStatusResponse_Window) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *EthDKGStatusResponse_Window) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *EthDKGStatusResponse_Window) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+func (x *EthDKGStatusResponse_Window) GetTaskStatus() string {
+	if x != nil {
+		return x.TaskStatus
+	}
+	return ""
+}
+
+type EthDKGStatusResponse_Participant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address           string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Index             uint32 `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	SharesDistributed bool   `protobuf:"varint,3,opt,name=SharesDistributed,proto3" json:"SharesDistributed,omitempty"`
+	KeyShareSubmitted bool   `protobuf:"varint,4,opt,name=KeyShareSubmitted,proto3" json:"KeyShareSubmitted,omitempty"`
+	GPKjSubmitted     bool   `protobuf:"varint,5,opt,name=GPKjSubmitted,proto3" json:"GPKjSubmitted,omitempty"`
+	Malicious         bool   `protobuf:"varint,6,opt,name=Malicious,proto3" json:"Malicious,omitempty"` // accused and found malicious
+}
+
+func (x *EthDKGStatusResponse_Participant) Reset() {
+	*x = EthDKGStatusResponse_Participant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthDKGStatusResponse_Participant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthDKGStatusResponse_Participant) ProtoMessage() {}
+
+func (x *EthDKGStatusResponse_Participant) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthDKGStatusResponse_Participant.ProtoReflect.Descriptor instead.
+func (*EthDKGStatusResponse_Participant) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{33, 1}
+}
+
+func (x *EthDKGStatusResponse_Participant) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EthDKGStatusResponse_Participant) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EthDKGStatusResponse_Participant) GetSharesDistributed() bool {
+	if x != nil {
+		return x.SharesDistributed
+	}
+	return false
+}
+
+func (x *EthDKGStatusResponse_Participant) GetKeyShareSubmitted() bool {
+	if x != nil {
+		return x.KeyShareSubmitted
+	}
+	return false
+}
+
+func (x *EthDKGStatusResponse_Participant) GetGPKjSubmitted() bool {
+	if x != nil {
+		return x.GPKjSubmitted
+	}
+	return false
+}
+
+func (x *EthDKGStatusResponse_Participant) GetMalicious() bool {
+	if x != nil {
+		return x.Malicious
+	}
+	return false
+}
+
+type SnapshotAlertsResponse_Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height          uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Epoch           uint32 `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Validator       string `protobuf:"bytes,3,opt,name=Validator,proto3" json:"Validator,omitempty"` // Ethereum address of the validator that took the snapshot
+	BClaimsMismatch bool   `protobuf:"varint,4,opt,name=BClaimsMismatch,proto3" json:"BClaimsMismatch,omitempty"`
+	SigMismatch     bool   `protobuf:"varint,5,opt,name=SigMismatch,proto3" json:"SigMismatch,omitempty"`
+	LocalBlockHash  string `protobuf:"bytes,6,opt,name=LocalBlockHash,proto3" json:"LocalBlockHash,omitempty"`
+	RemoteBlockHash string `protobuf:"bytes,7,opt,name=RemoteBlockHash,proto3" json:"RemoteBlockHash,omitempty"`
+}
+
+func (x *SnapshotAlertsResponse_Alert) Reset() {
+	*x = SnapshotAlertsResponse_Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotAlertsResponse_Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotAlertsResponse_Alert) ProtoMessage() {}
+
+func (x *SnapshotAlertsResponse_Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotAlertsResponse_Alert.ProtoReflect.Descriptor instead.
+func (*SnapshotAlertsResponse_Alert) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *SnapshotAlertsResponse_Alert) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SnapshotAlertsResponse_Alert) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SnapshotAlertsResponse_Alert) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *SnapshotAlertsResponse_Alert) GetBClaimsMismatch() bool {
+	if x != nil {
+		return x.BClaimsMismatch
+	}
+	return false
+}
+
+func (x *SnapshotAlertsResponse_Alert) GetSigMismatch() bool {
+	if x != nil {
+		return x.SigMismatch
+	}
+	return false
+}
+
+func (x *SnapshotAlertsResponse_Alert) GetLocalBlockHash() string {
+	if x != nil {
+		return x.LocalBlockHash
+	}
+	return ""
+}
+
+func (x *SnapshotAlertsResponse_Alert) GetRemoteBlockHash() string {
+	if x != nil {
+		return x.RemoteBlockHash
+	}
+	return ""
+}
+
+type KnownPeersResponse_Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`    // p2p address of the peer
+	LastSeen  int64  `protobuf:"varint,2,opt,name=LastSeen,proto3" json:"LastSeen,omitempty"` // unix time in seconds
+	Successes uint64 `protobuf:"varint,3,opt,name=Successes,proto3" json:"Successes,omitempty"`
+	Failures  uint64 `protobuf:"varint,4,opt,name=Failures,proto3" json:"Failures,omitempty"`
+	Active    bool   `protobuf:"varint,5,opt,name=Active,proto3" json:"Active,omitempty"`
+}
+
+func (x *KnownPeersResponse_Peer) Reset() {
+	*x = KnownPeersResponse_Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnownPeersResponse_Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnownPeersResponse_Peer) ProtoMessage() {}
+
+func (x *KnownPeersResponse_Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnownPeersResponse_Peer.ProtoReflect.Descriptor instead.
+func (*KnownPeersResponse_Peer) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *KnownPeersResponse_Peer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *KnownPeersResponse_Peer) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *KnownPeersResponse_Peer) GetSuccesses() uint64 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *KnownPeersResponse_Peer) GetFailures() uint64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *KnownPeersResponse_Peer) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ActivePeersResponse_Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address             string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`                          // p2p address of the peer
+	Initiator           string `protobuf:"bytes,2,opt,name=Initiator,proto3" json:"Initiator,omitempty"`                      // outbound if the node dialed the peer, inbound otherwise
+	ProtoVersion        uint32 `protobuf:"varint,3,opt,name=ProtoVersion,proto3" json:"ProtoVersion,omitempty"`               // protocol version negotiated with the peer
+	ConnectedSince      int64  `protobuf:"varint,4,opt,name=ConnectedSince,proto3" json:"ConnectedSince,omitempty"`           // unix time in seconds
+	ConsensusQueueDepth uint32 `protobuf:"varint,5,opt,name=ConsensusQueueDepth,proto3" json:"ConsensusQueueDepth,omitempty"` // consensus messages waiting to be sent
+	TxQueueDepth        uint32 `protobuf:"varint,6,opt,name=TxQueueDepth,proto3" json:"TxQueueDepth,omitempty"`               // transactions waiting to be sent
+	BytesIn             uint64 `protobuf:"varint,7,opt,name=BytesIn,proto3" json:"BytesIn,omitempty"`                         // bytes received from the peer
+	BytesOut            uint64 `protobuf:"varint,8,opt,name=BytesOut,proto3" json:"BytesOut,omitempty"`                       // bytes sent to the peer
+	Capabilities        uint64 `protobuf:"varint,9,opt,name=Capabilities,proto3" json:"Capabilities,omitempty"`               // bitmap of the optional features both nodes support
+}
+
+func (x *ActivePeersResponse_Peer) Reset() {
+	*x = ActivePeersResponse_Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivePeersResponse_Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivePeersResponse_Peer) ProtoMessage() {}
+
+func (x *ActivePeersResponse_Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivePeersResponse_Peer.ProtoReflect.Descriptor instead.
+func (*ActivePeersResponse_Peer) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *ActivePeersResponse_Peer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ActivePeersResponse_Peer) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
+}
+
+func (x *ActivePeersResponse_Peer) GetProtoVersion() uint32 {
+	if x != nil {
+		return x.ProtoVersion
+	}
+	return 0
+}
+
+func (x *ActivePeersResponse_Peer) GetConnectedSince() int64 {
+	if x != nil {
+		return x.ConnectedSince
+	}
+	return 0
+}
+
+func (x *ActivePeersResponse_Peer) GetConsensusQueueDepth() uint32 {
+	if x != nil {
+		return x.ConsensusQueueDepth
+	}
+	return 0
+}
+
+func (x *ActivePeersResponse_Peer) GetTxQueueDepth() uint32 {
+	if x != nil {
+		return x.TxQueueDepth
+	}
+	return 0
+}
+
+func (x *ActivePeersResponse_Peer) GetBytesIn() uint64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *ActivePeersResponse_Peer) GetBytesOut() uint64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *ActivePeersResponse_Peer) GetCapabilities() uint64 {
+	if x != nil {
+		return x.Capabilities
+	}
+	return 0
+}
+
+type ConsensusTraceResponse_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq          uint64 `protobuf:"varint,1,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	Time         int64  `protobuf:"varint,2,opt,name=Time,proto3" json:"Time,omitempty"` // unix time in nanoseconds
+	Height       uint32 `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	Round        uint32 `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	TargetHeight uint32 `protobuf:"varint,5,opt,name=TargetHeight,proto3" json:"TargetHeight,omitempty"`
+	TargetRound  uint32 `protobuf:"varint,6,opt,name=TargetRound,proto3" json:"TargetRound,omitempty"`
+	Step         string `protobuf:"bytes,7,opt,name=Step,proto3" json:"Step,omitempty"`
+	Trigger      string `protobuf:"bytes,8,opt,name=Trigger,proto3" json:"Trigger,omitempty"`
+	Hash         string `protobuf:"bytes,9,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (x *ConsensusTraceResponse_Event) Reset() {
+	*x = ConsensusTraceResponse_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_localstatetypes_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusTraceResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusTraceResponse_Event) ProtoMessage() {}
+
+func (x *ConsensusTraceResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_localstatetypes_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusTraceResponse_Event.ProtoReflect.Descriptor instead.
+func (*ConsensusTraceResponse_Event) Descriptor() ([]byte, []int) {
+	return file_localstatetypes_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *ConsensusTraceResponse_Event) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ConsensusTraceResponse_Event) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ConsensusTraceResponse_Event) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ConsensusTraceResponse_Event) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ConsensusTraceResponse_Event) GetTargetHeight() uint32 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+func (x *ConsensusTraceResponse_Event) GetTargetRound() uint32 {
+	if x != nil {
+		return x.TargetRound
+	}
+	return 0
+}
+
+func (x *ConsensusTraceResponse_Event) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *ConsensusTraceResponse_Event) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *ConsensusTraceResponse_Event) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+var File_localstatetypes_proto protoreflect.FileDescriptor
+
+var file_localstatetypes_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b,
+	0x61, 0x6f, 0x62, 0x6a, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x63, 0x6f, 0x62,
+	0x6a, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x75,
+	0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x43,
+	0x75, 0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x61, 0x77, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x61,
+	0x77, 0x64, 0x61, 0x74, 0x61, 0x22, 0x65, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x75, 0x72, 0x76,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x43, 0x75, 0x72,
 	0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
@@ -1490,80 +3104,267 @@ var file_localstatetypes_proto_rawDesc = []byte{
 	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
 	0x65, 0x72, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22,
-	0x27, 0x0a, 0x0b, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x73, 0x22, 0x32, 0x0a, 0x0c, 0x55, 0x54, 0x58, 0x4f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x55, 0x54, 0x58, 0x4f,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x54, 0x58, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x55, 0x54, 0x58, 0x4f, 0x73, 0x22, 0x33, 0x0a, 0x19,
-	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73,
-	0x68, 0x22, 0x37, 0x0a, 0x1a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x19, 0x0a, 0x02, 0x54, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x54, 0x78, 0x22, 0x14, 0x0a, 0x12, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x37, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x02, 0x54,
+	0x31, 0x0a, 0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x18, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x52,
+	0x6f, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x27, 0x0a, 0x0b, 0x55,
+	0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x54,
+	0x58, 0x4f, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x55, 0x54, 0x58,
+	0x4f, 0x49, 0x44, 0x73, 0x22, 0x32, 0x0a, 0x0c, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x55, 0x54, 0x58, 0x4f, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x58, 0x4f, 0x75,
+	0x74, 0x52, 0x05, 0x55, 0x54, 0x58, 0x4f, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x37, 0x0a,
+	0x1a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x02, 0x54,
 	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x54, 0x78, 0x52, 0x02, 0x54, 0x78, 0x22, 0x2c, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78,
-	0x48, 0x61, 0x73, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x45, 0x70,
-	0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x49, 0x74, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x75, 0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x43, 0x75, 0x72, 0x76, 0x65, 0x53, 0x70, 0x65,
-	0x63, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x40, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x1a, 0x36, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x55, 0x54, 0x58, 0x4f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x54,
-	0x58, 0x4f, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2e, 0x0a, 0x14, 0x54, 0x78,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x39, 0x0a, 0x15, 0x54, 0x78,
+	0x54, 0x78, 0x52, 0x02, 0x54, 0x78, 0x22, 0x14, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x13,
 	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
 	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2d, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x02, 0x54, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x02,
+	0x54, 0x78, 0x22, 0x2c, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0x14, 0x0a, 0x12, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x43, 0x75, 0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x43, 0x75, 0x72, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x94, 0x01, 0x0a, 0x18, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x36,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x54, 0x58, 0x4f,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x54, 0x58, 0x4f, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2e, 0x0a, 0x14, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x39, 0x0a, 0x15, 0x54, 0x78, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x2d, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x3a, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x22, 0x63, 0x0a, 0x1d,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x56, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0x40, 0x0a, 0x1e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46,
+	0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x1d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xef, 0x02, 0x0a, 0x1e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x4f, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x1a, 0xc5, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x56, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x4d, 0x61, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x4d, 0x61, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x15, 0x0a, 0x13,
+	0x45, 0x74, 0x68, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xbb, 0x05, 0x0a, 0x14, 0x45, 0x74, 0x68, 0x44, 0x4b, 0x47, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3e, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x74, 0x68, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x08,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x74, 0x68, 0x44, 0x4b, 0x47, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x7a, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x14, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x1a, 0xdd, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x2c, 0x0a, 0x11, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x12,
+	0x2c, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x4b, 0x65, 0x79, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x47, 0x50, 0x4b, 0x6a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x47, 0x50, 0x4b, 0x6a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x6c, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4d, 0x61, 0x6c, 0x69, 0x63, 0x69, 0x6f, 0x75,
+	0x73, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc9, 0x02, 0x0a, 0x16, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x73, 0x1a, 0xf1, 0x01, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06,
 	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x3a, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
-	0x22, 0x63, 0x0a, 0x1d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x56, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x40, 0x0a, 0x1e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x73, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x42, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x53, 0x69, 0x67, 0x4d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x0f,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x13, 0x0a, 0x11, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x12,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x8e, 0x01, 0x0a, 0x04, 0x50, 0x65, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x89, 0x03, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x1a, 0xba,
+	0x02, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x22, 0x0a,
+	0x0c, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x18,
+	0x0a, 0x16, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x0f, 0x44, 0x69, 0x61, 0x6c,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x69, 0x61, 0x6c, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x1c,
+	0x0a, 0x1a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x15,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbb, 0x02, 0x0a, 0x16,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x1a, 0xe3, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x53, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1578,53 +3379,88 @@ func file_localstatetypes_proto_rawDescGZIP() []byte {
 	return file_localstatetypes_proto_rawDescData
 }
 
-var file_localstatetypes_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_localstatetypes_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_localstatetypes_proto_goTypes = []interface{}{
-	(*GetDataRequest)(nil),                  // 0: proto.GetDataRequest
-	(*GetDataResponse)(nil),                 // 1: proto.GetDataResponse
-	(*GetValueRequest)(nil),                 // 2: proto.GetValueRequest
-	(*GetValueResponse)(nil),                // 3: proto.GetValueResponse
-	(*MinedTransactionRequest)(nil),         // 4: proto.MinedTransactionRequest
-	(*MinedTransactionResponse)(nil),        // 5: proto.MinedTransactionResponse
-	(*BlockHeaderRequest)(nil),              // 6: proto.BlockHeaderRequest
-	(*BlockHeaderResponse)(nil),             // 7: proto.BlockHeaderResponse
-	(*UTXORequest)(nil),                     // 8: proto.UTXORequest
-	(*UTXOResponse)(nil),                    // 9: proto.UTXOResponse
-	(*PendingTransactionRequest)(nil),       // 10: proto.PendingTransactionRequest
-	(*PendingTransactionResponse)(nil),      // 11: proto.PendingTransactionResponse
-	(*BlockNumberRequest)(nil),              // 12: proto.BlockNumberRequest
-	(*BlockNumberResponse)(nil),             // 13: proto.BlockNumberResponse
-	(*ChainIDRequest)(nil),                  // 14: proto.ChainIDRequest
-	(*ChainIDResponse)(nil),                 // 15: proto.ChainIDResponse
-	(*TransactionData)(nil),                 // 16: proto.TransactionData
-	(*TransactionDetails)(nil),              // 17: proto.TransactionDetails
-	(*EpochNumberRequest)(nil),              // 18: proto.EpochNumberRequest
-	(*EpochNumberResponse)(nil),             // 19: proto.EpochNumberResponse
-	(*IterateNameSpaceRequest)(nil),         // 20: proto.IterateNameSpaceRequest
-	(*IterateNameSpaceResponse)(nil),        // 21: proto.IterateNameSpaceResponse
-	(*TxBlockNumberRequest)(nil),            // 22: proto.TxBlockNumberRequest
-	(*TxBlockNumberResponse)(nil),           // 23: proto.TxBlockNumberResponse
-	(*ValidatorSetRequest)(nil),             // 24: proto.ValidatorSetRequest
-	(*ValidatorSetResponse)(nil),            // 25: proto.ValidatorSetResponse
-	(*RoundStateForValidatorRequest)(nil),   // 26: proto.RoundStateForValidatorRequest
-	(*RoundStateForValidatorResponse)(nil),  // 27: proto.RoundStateForValidatorResponse
-	(*IterateNameSpaceResponse_Result)(nil), // 28: proto.IterateNameSpaceResponse.Result
-	(*Tx)(nil),                              // 29: proto.Tx
-	(*BlockHeader)(nil),                     // 30: proto.BlockHeader
-	(*TXOut)(nil),                           // 31: proto.TXOut
+	(*GetDataRequest)(nil),                           // 0: proto.GetDataRequest
+	(*GetDataResponse)(nil),                          // 1: proto.GetDataResponse
+	(*GetValueRequest)(nil),                          // 2: proto.GetValueRequest
+	(*GetValueResponse)(nil),                         // 3: proto.GetValueResponse
+	(*MinedTransactionRequest)(nil),                  // 4: proto.MinedTransactionRequest
+	(*MinedTransactionResponse)(nil),                 // 5: proto.MinedTransactionResponse
+	(*BlockHeaderRequest)(nil),                       // 6: proto.BlockHeaderRequest
+	(*BlockHeaderResponse)(nil),                      // 7: proto.BlockHeaderResponse
+	(*BlockHeaderProofRequest)(nil),                  // 8: proto.BlockHeaderProofRequest
+	(*BlockHeaderProofResponse)(nil),                 // 9: proto.BlockHeaderProofResponse
+	(*UTXORequest)(nil),                              // 10: proto.UTXORequest
+	(*UTXOResponse)(nil),                             // 11: proto.UTXOResponse
+	(*PendingTransactionRequest)(nil),                // 12: proto.PendingTransactionRequest
+	(*PendingTransactionResponse)(nil),               // 13: proto.PendingTransactionResponse
+	(*BlockNumberRequest)(nil),                       // 14: proto.BlockNumberRequest
+	(*BlockNumberResponse)(nil),                      // 15: proto.BlockNumberResponse
+	(*ChainIDRequest)(nil),                           // 16: proto.ChainIDRequest
+	(*ChainIDResponse)(nil),                          // 17: proto.ChainIDResponse
+	(*TransactionData)(nil),                          // 18: proto.TransactionData
+	(*TransactionDetails)(nil),                       // 19: proto.TransactionDetails
+	(*EpochNumberRequest)(nil),                       // 20: proto.EpochNumberRequest
+	(*EpochNumberResponse)(nil),                      // 21: proto.EpochNumberResponse
+	(*IterateNameSpaceRequest)(nil),                  // 22: proto.IterateNameSpaceRequest
+	(*IterateNameSpaceResponse)(nil),                 // 23: proto.IterateNameSpaceResponse
+	(*TxBlockNumberRequest)(nil),                     // 24: proto.TxBlockNumberRequest
+	(*TxBlockNumberResponse)(nil),                    // 25: proto.TxBlockNumberResponse
+	(*ValidatorSetRequest)(nil),                      // 26: proto.ValidatorSetRequest
+	(*ValidatorSetResponse)(nil),                     // 27: proto.ValidatorSetResponse
+	(*RoundStateForValidatorRequest)(nil),            // 28: proto.RoundStateForValidatorRequest
+	(*RoundStateForValidatorResponse)(nil),           // 29: proto.RoundStateForValidatorResponse
+	(*ValidatorParticipationRequest)(nil),            // 30: proto.ValidatorParticipationRequest
+	(*ValidatorParticipationResponse)(nil),           // 31: proto.ValidatorParticipationResponse
+	(*EthDKGStatusRequest)(nil),                      // 32: proto.EthDKGStatusRequest
+	(*EthDKGStatusResponse)(nil),                     // 33: proto.EthDKGStatusResponse
+	(*SnapshotAlertsRequest)(nil),                    // 34: proto.SnapshotAlertsRequest
+	(*SnapshotAlertsResponse)(nil),                   // 35: proto.SnapshotAlertsResponse
+	(*KnownPeersRequest)(nil),                        // 36: proto.KnownPeersRequest
+	(*KnownPeersResponse)(nil),                       // 37: proto.KnownPeersResponse
+	(*ActivePeersRequest)(nil),                       // 38: proto.ActivePeersRequest
+	(*ActivePeersResponse)(nil),                      // 39: proto.ActivePeersResponse
+	(*DisconnectPeerRequest)(nil),                    // 40: proto.DisconnectPeerRequest
+	(*DisconnectPeerResponse)(nil),                   // 41: proto.DisconnectPeerResponse
+	(*DialPeerRequest)(nil),                          // 42: proto.DialPeerRequest
+	(*DialPeerResponse)(nil),                         // 43: proto.DialPeerResponse
+	(*ClearSnapshotAlertRequest)(nil),                // 44: proto.ClearSnapshotAlertRequest
+	(*ClearSnapshotAlertResponse)(nil),               // 45: proto.ClearSnapshotAlertResponse
+	(*ConsensusTraceRequest)(nil),                    // 46: proto.ConsensusTraceRequest
+	(*ConsensusTraceResponse)(nil),                   // 47: proto.ConsensusTraceResponse
+	(*IterateNameSpaceResponse_Result)(nil),          // 48: proto.IterateNameSpaceResponse.Result
+	(*ValidatorParticipationResponse_Validator)(nil), // 49: proto.ValidatorParticipationResponse.Validator
+	(*EthDKGStatusResponse_Window)(nil),              // 50: proto.EthDKGStatusResponse.Window
+	(*EthDKGStatusResponse_Participant)(nil),         // 51: proto.EthDKGStatusResponse.Participant
+	(*SnapshotAlertsResponse_Alert)(nil),             // 52: proto.SnapshotAlertsResponse.Alert
+	(*KnownPeersResponse_Peer)(nil),                  // 53: proto.KnownPeersResponse.Peer
+	(*ActivePeersResponse_Peer)(nil),                 // 54: proto.ActivePeersResponse.Peer
+	(*ConsensusTraceResponse_Event)(nil),             // 55: proto.ConsensusTraceResponse.Event
+	(*Tx)(nil),                                       // 56: proto.Tx
+	(*BlockHeader)(nil),                              // 57: proto.BlockHeader
+	(*TXOut)(nil),                                    // 58: proto.TXOut
 }
 var file_localstatetypes_proto_depIdxs = []int32{
-	29, // 0: proto.MinedTransactionResponse.Tx:type_name -> proto.Tx
-	30, // 1: proto.BlockHeaderResponse.BlockHeader:type_name -> proto.BlockHeader
-	31, // 2: proto.UTXOResponse.UTXOs:type_name -> proto.TXOut
-	29, // 3: proto.PendingTransactionResponse.Tx:type_name -> proto.Tx
-	29, // 4: proto.TransactionData.Tx:type_name -> proto.Tx
-	28, // 5: proto.IterateNameSpaceResponse.Results:type_name -> proto.IterateNameSpaceResponse.Result
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	56, // 0: proto.MinedTransactionResponse.Tx:type_name -> proto.Tx
+	57, // 1: proto.BlockHeaderResponse.BlockHeader:type_name -> proto.BlockHeader
+	57, // 2: proto.BlockHeaderProofResponse.BlockHeader:type_name -> proto.BlockHeader
+	58, // 3: proto.UTXOResponse.UTXOs:type_name -> proto.TXOut
+	56, // 4: proto.PendingTransactionResponse.Tx:type_name -> proto.Tx
+	56, // 5: proto.TransactionData.Tx:type_name -> proto.Tx
+	48, // 6: proto.IterateNameSpaceResponse.Results:type_name -> proto.IterateNameSpaceResponse.Result
+	49, // 7: proto.ValidatorParticipationResponse.Validators:type_name -> proto.ValidatorParticipationResponse.Validator
+	50, // 8: proto.EthDKGStatusResponse.Schedule:type_name -> proto.EthDKGStatusResponse.Window
+	51, // 9: proto.EthDKGStatusResponse.Participants:type_name -> proto.EthDKGStatusResponse.Participant
+	52, // 10: proto.SnapshotAlertsResponse.Alerts:type_name -> proto.SnapshotAlertsResponse.Alert
+	53, // 11: proto.KnownPeersResponse.Peers:type_name -> proto.KnownPeersResponse.Peer
+	54, // 12: proto.ActivePeersResponse.Peers:type_name -> proto.ActivePeersResponse.Peer
+	55, // 13: proto.ConsensusTraceResponse.Events:type_name -> proto.ConsensusTraceResponse.Event
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_localstatetypes_proto_init() }
@@ -1647,8 +3483,140 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataResponse); i {
+		file_localstatetypes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinedTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinedTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UTXORequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UTXOResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1659,8 +3627,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValueRequest); i {
+		file_localstatetypes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1671,8 +3639,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValueResponse); i {
+		file_localstatetypes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockNumberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1683,8 +3651,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinedTransactionRequest); i {
+		file_localstatetypes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockNumberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1695,8 +3663,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinedTransactionResponse); i {
+		file_localstatetypes_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1707,8 +3675,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderRequest); i {
+		file_localstatetypes_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1719,8 +3687,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderResponse); i {
+		file_localstatetypes_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1731,8 +3699,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UTXORequest); i {
+		file_localstatetypes_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1743,8 +3711,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UTXOResponse); i {
+		file_localstatetypes_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochNumberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1755,8 +3723,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingTransactionRequest); i {
+		file_localstatetypes_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochNumberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1767,8 +3735,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingTransactionResponse); i {
+		file_localstatetypes_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IterateNameSpaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1779,8 +3747,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockNumberRequest); i {
+		file_localstatetypes_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IterateNameSpaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1791,8 +3759,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockNumberResponse); i {
+		file_localstatetypes_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxBlockNumberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1803,8 +3771,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainIDRequest); i {
+		file_localstatetypes_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxBlockNumberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1815,8 +3783,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainIDResponse); i {
+		file_localstatetypes_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1827,8 +3795,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionData); i {
+		file_localstatetypes_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1839,8 +3807,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionDetails); i {
+		file_localstatetypes_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundStateForValidatorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1851,8 +3819,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochNumberRequest); i {
+		file_localstatetypes_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundStateForValidatorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1863,8 +3831,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochNumberResponse); i {
+		file_localstatetypes_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorParticipationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1875,8 +3843,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IterateNameSpaceRequest); i {
+		file_localstatetypes_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorParticipationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1887,8 +3855,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IterateNameSpaceResponse); i {
+		file_localstatetypes_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthDKGStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1899,8 +3867,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxBlockNumberRequest); i {
+		file_localstatetypes_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthDKGStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1911,8 +3879,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxBlockNumberResponse); i {
+		file_localstatetypes_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotAlertsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1923,8 +3891,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSetRequest); i {
+		file_localstatetypes_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotAlertsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1935,8 +3903,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSetResponse); i {
+		file_localstatetypes_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KnownPeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1947,8 +3915,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundStateForValidatorRequest); i {
+		file_localstatetypes_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KnownPeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1959,8 +3927,8 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundStateForValidatorResponse); i {
+		file_localstatetypes_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivePeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1971,7 +3939,115 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
-		file_localstatetypes_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_localstatetypes_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivePeersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectPeerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DialPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DialPeerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearSnapshotAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearSnapshotAlertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsensusTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsensusTraceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IterateNameSpaceResponse_Result); i {
 			case 0:
 				return &v.state
@@ -1983,6 +4059,90 @@ func file_localstatetypes_proto_init() {
 				return nil
 			}
 		}
+		file_localstatetypes_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorParticipationResponse_Validator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthDKGStatusResponse_Window); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthDKGStatusResponse_Participant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotAlertsResponse_Alert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KnownPeersResponse_Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivePeersResponse_Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_localstatetypes_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsensusTraceResponse_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1990,7 +4150,7 @@ func file_localstatetypes_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_localstatetypes_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
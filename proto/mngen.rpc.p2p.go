@@ -152,6 +152,14 @@ type DiscoveryGetPeersHandler interface {
 	HandleDiscoveryGetPeers(context.Context, *GetPeersRequest) (*GetPeersResponse, error)
 }
 
+// DHTFindNodeHandler is an interface class that only contains
+// the method HandleDHTFindNode
+// The class that implements this method MUST handle the RPC call for
+// the method FindNode of the RPC service DHT
+type DHTFindNodeHandler interface {
+	HandleDHTFindNode(context.Context, *FindNodeRequest) (*FindNodeResponse, error)
+}
+
 // inboundRPCDispatch allows handlers to be registered for all RPC methods
 // using the Register<Service><Name> methods.
 // After registration, the inboundRPCDispatch struct will dispatch calls
@@ -284,6 +292,13 @@ type inboundRPCDispatch struct {
 	// method GetPeers on service Discovery to block until the
 	// method has been registered.
 	waitChanDiscoveryGetPeers chan struct{}
+  //	handlerDHTFindNode is the registered handler for the
+	//  FindNode RPC method of service DHT
+	handlerDHTFindNode DHTFindNodeHandler
+	// waitChanDHTFindNode will cause a caller of the RPC
+	// method FindNode on service DHT to block until the
+	// method has been registered.
+	waitChanDHTFindNode chan struct{}
 }
 
 // RegisterP2PStatus will register the object 't' as the service
@@ -790,6 +805,34 @@ func (d *inboundRPCDispatch) DiscoveryGetPeers(ctx context.Context, r *GetPeersR
 	}
 }
 
+// RegisterDHTFindNode will register the object 't' as the service
+// handler for the RPC method FindNode from service DHT
+func (d *inboundRPCDispatch) RegisterDHTFindNode(t DHTFindNodeHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerDHTFindNode != nil {
+		panic("double registration of DHTFindNode")
+	}
+	// register the service handler
+	d.handlerDHTFindNode = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanDHTFindNode)
+}
+
+// DHTFindNode will invoke the handler for the RPC method
+// FindNode from service DHT
+func (d *inboundRPCDispatch) DHTFindNode(ctx context.Context, r *FindNodeRequest) (*FindNodeResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanDHTFindNode:
+		// return the invoked methods response
+		return d.handlerDHTFindNode.HandleDHTFindNode(ctx, r)
+	}
+}
+
 // NewInboundRPCDispatch will construct a new inboundRPCDispatcher with all fields properly
 // initialized.
 func NewInboundRPCDispatch() *inboundRPCDispatch {
@@ -830,6 +873,8 @@ func NewInboundRPCDispatch() *inboundRPCDispatch {
 		waitChanP2PGetPeers: make(chan struct{}),
 		// initialize the wait channel for method GetPeers on service Discovery
 		waitChanDiscoveryGetPeers: make(chan struct{}),
+		// initialize the wait channel for method FindNode on service DHT
+		waitChanDHTFindNode: make(chan struct{}),
 	}
 }
 
@@ -990,3 +1035,26 @@ func NewGeneratedDiscoveryServer(dispatch *inboundRPCDispatch) *GeneratedDiscove
   }
 }
 
+
+// GeneratedDHTServer implements the DHT service as a gRPC
+// server. GeneratedDHTServer invokes methods on the services
+// through the inboundRPCDispatch handlers.
+type GeneratedDHTServer struct {
+	dispatch *inboundRPCDispatch
+}
+
+// FindNode will invoke the method FindNode on the RPC service DHT
+// using the inboundRPCDispatch handler.
+func (s *GeneratedDHTServer) FindNode(ctx context.Context, r *FindNodeRequest) (*FindNodeResponse, error) {
+	return s.dispatch.DHTFindNode(ctx, r)
+}
+
+
+
+// NewGeneratedDHTServer constructs a new server for the service.
+func NewGeneratedDHTServer(dispatch *inboundRPCDispatch) *GeneratedDHTServer {
+  return &GeneratedDHTServer{
+    dispatch: dispatch,
+  }
+}
+
@@ -1231,3 +1231,71 @@ func TestDiscoveryGetPeersCancel(t *testing.T) {
 	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
 }
 
+type testDHTFindNodeHandler struct{}
+
+func (th *testDHTFindNodeHandler) HandleDHTFindNode(context.Context, *FindNodeRequest) (*FindNodeResponse, error) {
+	return &FindNodeResponse{}, nil
+}
+
+func TestDHTFindNode(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewInboundRPCDispatch()
+
+	// Setup the handler for the TestService
+	h := &testDHTFindNodeHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterDHTFindNode(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedDHTServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.FindNode(context.Background(), &FindNodeRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationDHTFindNode(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewInboundRPCDispatch()
+
+	// Setup the handler for the TestService
+	h := &testDHTFindNodeHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterDHTFindNode(h)
+
+	fn := func() {
+		d.RegisterDHTFindNode(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestDHTFindNodeCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewInboundRPCDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedDHTServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.FindNode(cancelCtx, &FindNodeRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
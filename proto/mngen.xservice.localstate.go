@@ -49,6 +49,14 @@ type LocalStateGetBlockHeaderHandler interface {
 	HandleLocalStateGetBlockHeader(context.Context, *BlockHeaderRequest) (*BlockHeaderResponse, error)
 }
 
+// LocalStateGetBlockHeaderProofHandler is an interface class that only contains
+// the method HandleLocalStateGetBlockHeaderProof
+// The class that implements this method MUST handle the RPC call for
+// the method GetBlockHeaderProof of the RPC service LocalState
+type LocalStateGetBlockHeaderProofHandler interface {
+	HandleLocalStateGetBlockHeaderProof(context.Context, *BlockHeaderProofRequest) (*BlockHeaderProofResponse, error)
+}
+
 // LocalStateGetUTXOHandler is an interface class that only contains
 // the method HandleLocalStateGetUTXO
 // The class that implements this method MUST handle the RPC call for
@@ -81,6 +89,78 @@ type LocalStateGetValidatorSetHandler interface {
 	HandleLocalStateGetValidatorSet(context.Context, *ValidatorSetRequest) (*ValidatorSetResponse, error)
 }
 
+// LocalStateGetValidatorParticipationHandler is an interface class that only contains
+// the method HandleLocalStateGetValidatorParticipation
+// The class that implements this method MUST handle the RPC call for
+// the method GetValidatorParticipation of the RPC service LocalState
+type LocalStateGetValidatorParticipationHandler interface {
+	HandleLocalStateGetValidatorParticipation(context.Context, *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error)
+}
+
+// LocalStateGetConsensusTraceHandler is an interface class that only contains
+// the method HandleLocalStateGetConsensusTrace
+// The class that implements this method MUST handle the RPC call for
+// the method GetConsensusTrace of the RPC service LocalState
+type LocalStateGetConsensusTraceHandler interface {
+	HandleLocalStateGetConsensusTrace(context.Context, *ConsensusTraceRequest) (*ConsensusTraceResponse, error)
+}
+
+// LocalStateGetEthDKGStatusHandler is an interface class that only contains
+// the method HandleLocalStateGetEthDKGStatus
+// The class that implements this method MUST handle the RPC call for
+// the method GetEthDKGStatus of the RPC service LocalState
+type LocalStateGetEthDKGStatusHandler interface {
+	HandleLocalStateGetEthDKGStatus(context.Context, *EthDKGStatusRequest) (*EthDKGStatusResponse, error)
+}
+
+// LocalStateGetSnapshotAlertsHandler is an interface class that only contains
+// the method HandleLocalStateGetSnapshotAlerts
+// The class that implements this method MUST handle the RPC call for
+// the method GetSnapshotAlerts of the RPC service LocalState
+type LocalStateGetSnapshotAlertsHandler interface {
+	HandleLocalStateGetSnapshotAlerts(context.Context, *SnapshotAlertsRequest) (*SnapshotAlertsResponse, error)
+}
+
+// LocalStateGetKnownPeersHandler is an interface class that only contains
+// the method HandleLocalStateGetKnownPeers
+// The class that implements this method MUST handle the RPC call for
+// the method GetKnownPeers of the RPC service LocalState
+type LocalStateGetKnownPeersHandler interface {
+	HandleLocalStateGetKnownPeers(context.Context, *KnownPeersRequest) (*KnownPeersResponse, error)
+}
+
+// LocalStateGetActivePeersHandler is an interface class that only contains
+// the method HandleLocalStateGetActivePeers
+// The class that implements this method MUST handle the RPC call for
+// the method GetActivePeers of the RPC service LocalState
+type LocalStateGetActivePeersHandler interface {
+	HandleLocalStateGetActivePeers(context.Context, *ActivePeersRequest) (*ActivePeersResponse, error)
+}
+
+// LocalStateDisconnectPeerHandler is an interface class that only contains
+// the method HandleLocalStateDisconnectPeer
+// The class that implements this method MUST handle the RPC call for
+// the method DisconnectPeer of the RPC service LocalState
+type LocalStateDisconnectPeerHandler interface {
+	HandleLocalStateDisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+}
+
+// LocalStateDialPeerHandler is an interface class that only contains
+// the method HandleLocalStateDialPeer
+// The class that implements this method MUST handle the RPC call for
+// the method DialPeer of the RPC service LocalState
+type LocalStateDialPeerHandler interface {
+	HandleLocalStateDialPeer(context.Context, *DialPeerRequest) (*DialPeerResponse, error)
+}
+
+// LocalStateClearSnapshotAlertHandler is an interface class that only contains
+// the method HandleLocalStateClearSnapshotAlert
+// The class that implements this method MUST handle the RPC call for
+// the method ClearSnapshotAlert of the RPC service LocalState
+type LocalStateClearSnapshotAlertHandler interface {
+	HandleLocalStateClearSnapshotAlert(context.Context, *ClearSnapshotAlertRequest) (*ClearSnapshotAlertResponse, error)
+}
+
 // LocalStateGetBlockNumberHandler is an interface class that only contains
 // the method HandleLocalStateGetBlockNumber
 // The class that implements this method MUST handle the RPC call for
@@ -164,6 +244,13 @@ type LocalStateDispatch struct {
 	// method GetBlockHeader on service LocalState to block until the
 	// method has been registered.
 	waitChanLocalStateGetBlockHeader chan struct{}
+  //	handlerLocalStateGetBlockHeaderProof is the registered handler for the
+	//  GetBlockHeaderProof RPC method of service LocalState
+	handlerLocalStateGetBlockHeaderProof LocalStateGetBlockHeaderProofHandler
+	// waitChanLocalStateGetBlockHeaderProof will cause a caller of the RPC
+	// method GetBlockHeaderProof on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetBlockHeaderProof chan struct{}
   //	handlerLocalStateGetUTXO is the registered handler for the
 	//  GetUTXO RPC method of service LocalState
 	handlerLocalStateGetUTXO LocalStateGetUTXOHandler
@@ -192,6 +279,69 @@ type LocalStateDispatch struct {
 	// method GetValidatorSet on service LocalState to block until the
 	// method has been registered.
 	waitChanLocalStateGetValidatorSet chan struct{}
+  //	handlerLocalStateGetValidatorParticipation is the registered handler for the
+	//  GetValidatorParticipation RPC method of service LocalState
+	handlerLocalStateGetValidatorParticipation LocalStateGetValidatorParticipationHandler
+	// waitChanLocalStateGetValidatorParticipation will cause a caller of the RPC
+	// method GetValidatorParticipation on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetValidatorParticipation chan struct{}
+  //	handlerLocalStateGetConsensusTrace is the registered handler for the
+	//  GetConsensusTrace RPC method of service LocalState
+	handlerLocalStateGetConsensusTrace LocalStateGetConsensusTraceHandler
+	// waitChanLocalStateGetConsensusTrace will cause a caller of the RPC
+	// method GetConsensusTrace on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetConsensusTrace chan struct{}
+  //	handlerLocalStateGetEthDKGStatus is the registered handler for the
+	//  GetEthDKGStatus RPC method of service LocalState
+	handlerLocalStateGetEthDKGStatus LocalStateGetEthDKGStatusHandler
+	// waitChanLocalStateGetEthDKGStatus will cause a caller of the RPC
+	// method GetEthDKGStatus on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetEthDKGStatus chan struct{}
+  //	handlerLocalStateGetSnapshotAlerts is the registered handler for the
+	//  GetSnapshotAlerts RPC method of service LocalState
+	handlerLocalStateGetSnapshotAlerts LocalStateGetSnapshotAlertsHandler
+	// waitChanLocalStateGetSnapshotAlerts will cause a caller of the RPC
+	// method GetSnapshotAlerts on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetSnapshotAlerts chan struct{}
+  //	handlerLocalStateGetKnownPeers is the registered handler for the
+	//  GetKnownPeers RPC method of service LocalState
+	handlerLocalStateGetKnownPeers LocalStateGetKnownPeersHandler
+	// waitChanLocalStateGetKnownPeers will cause a caller of the RPC
+	// method GetKnownPeers on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetKnownPeers chan struct{}
+  //	handlerLocalStateGetActivePeers is the registered handler for the
+	//  GetActivePeers RPC method of service LocalState
+	handlerLocalStateGetActivePeers LocalStateGetActivePeersHandler
+	// waitChanLocalStateGetActivePeers will cause a caller of the RPC
+	// method GetActivePeers on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateGetActivePeers chan struct{}
+  //	handlerLocalStateDisconnectPeer is the registered handler for the
+	//  DisconnectPeer RPC method of service LocalState
+	handlerLocalStateDisconnectPeer LocalStateDisconnectPeerHandler
+	// waitChanLocalStateDisconnectPeer will cause a caller of the RPC
+	// method DisconnectPeer on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateDisconnectPeer chan struct{}
+  //	handlerLocalStateDialPeer is the registered handler for the
+	//  DialPeer RPC method of service LocalState
+	handlerLocalStateDialPeer LocalStateDialPeerHandler
+	// waitChanLocalStateDialPeer will cause a caller of the RPC
+	// method DialPeer on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateDialPeer chan struct{}
+  //	handlerLocalStateClearSnapshotAlert is the registered handler for the
+	//  ClearSnapshotAlert RPC method of service LocalState
+	handlerLocalStateClearSnapshotAlert LocalStateClearSnapshotAlertHandler
+	// waitChanLocalStateClearSnapshotAlert will cause a caller of the RPC
+	// method ClearSnapshotAlert on service LocalState to block until the
+	// method has been registered.
+	waitChanLocalStateClearSnapshotAlert chan struct{}
   //	handlerLocalStateGetBlockNumber is the registered handler for the
 	//  GetBlockNumber RPC method of service LocalState
 	handlerLocalStateGetBlockNumber LocalStateGetBlockNumberHandler
@@ -371,6 +521,34 @@ func (d *LocalStateDispatch) LocalStateGetBlockHeader(ctx context.Context, r *Bl
 	}
 }
 
+// RegisterLocalStateGetBlockHeaderProof will register the object 't' as the service
+// handler for the RPC method GetBlockHeaderProof from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetBlockHeaderProof(t LocalStateGetBlockHeaderProofHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetBlockHeaderProof != nil {
+		panic("double registration of LocalStateGetBlockHeaderProof")
+	}
+	// register the service handler
+	d.handlerLocalStateGetBlockHeaderProof = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetBlockHeaderProof)
+}
+
+// LocalStateGetBlockHeaderProof will invoke the handler for the RPC method
+// GetBlockHeaderProof from service LocalState
+func (d *LocalStateDispatch) LocalStateGetBlockHeaderProof(ctx context.Context, r *BlockHeaderProofRequest) (*BlockHeaderProofResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetBlockHeaderProof:
+		// return the invoked methods response
+		return d.handlerLocalStateGetBlockHeaderProof.HandleLocalStateGetBlockHeaderProof(ctx, r)
+	}
+}
+
 // RegisterLocalStateGetUTXO will register the object 't' as the service
 // handler for the RPC method GetUTXO from service LocalState
 func (d *LocalStateDispatch) RegisterLocalStateGetUTXO(t LocalStateGetUTXOHandler) {
@@ -483,6 +661,258 @@ func (d *LocalStateDispatch) LocalStateGetValidatorSet(ctx context.Context, r *V
 	}
 }
 
+// RegisterLocalStateGetValidatorParticipation will register the object 't' as the service
+// handler for the RPC method GetValidatorParticipation from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetValidatorParticipation(t LocalStateGetValidatorParticipationHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetValidatorParticipation != nil {
+		panic("double registration of LocalStateGetValidatorParticipation")
+	}
+	// register the service handler
+	d.handlerLocalStateGetValidatorParticipation = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetValidatorParticipation)
+}
+
+// LocalStateGetValidatorParticipation will invoke the handler for the RPC method
+// GetValidatorParticipation from service LocalState
+func (d *LocalStateDispatch) LocalStateGetValidatorParticipation(ctx context.Context, r *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetValidatorParticipation:
+		// return the invoked methods response
+		return d.handlerLocalStateGetValidatorParticipation.HandleLocalStateGetValidatorParticipation(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetConsensusTrace will register the object 't' as the service
+// handler for the RPC method GetConsensusTrace from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetConsensusTrace(t LocalStateGetConsensusTraceHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetConsensusTrace != nil {
+		panic("double registration of LocalStateGetConsensusTrace")
+	}
+	// register the service handler
+	d.handlerLocalStateGetConsensusTrace = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetConsensusTrace)
+}
+
+// LocalStateGetConsensusTrace will invoke the handler for the RPC method
+// GetConsensusTrace from service LocalState
+func (d *LocalStateDispatch) LocalStateGetConsensusTrace(ctx context.Context, r *ConsensusTraceRequest) (*ConsensusTraceResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetConsensusTrace:
+		// return the invoked methods response
+		return d.handlerLocalStateGetConsensusTrace.HandleLocalStateGetConsensusTrace(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetEthDKGStatus will register the object 't' as the service
+// handler for the RPC method GetEthDKGStatus from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetEthDKGStatus(t LocalStateGetEthDKGStatusHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetEthDKGStatus != nil {
+		panic("double registration of LocalStateGetEthDKGStatus")
+	}
+	// register the service handler
+	d.handlerLocalStateGetEthDKGStatus = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetEthDKGStatus)
+}
+
+// LocalStateGetEthDKGStatus will invoke the handler for the RPC method
+// GetEthDKGStatus from service LocalState
+func (d *LocalStateDispatch) LocalStateGetEthDKGStatus(ctx context.Context, r *EthDKGStatusRequest) (*EthDKGStatusResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetEthDKGStatus:
+		// return the invoked methods response
+		return d.handlerLocalStateGetEthDKGStatus.HandleLocalStateGetEthDKGStatus(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetSnapshotAlerts will register the object 't' as the service
+// handler for the RPC method GetSnapshotAlerts from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetSnapshotAlerts(t LocalStateGetSnapshotAlertsHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetSnapshotAlerts != nil {
+		panic("double registration of LocalStateGetSnapshotAlerts")
+	}
+	// register the service handler
+	d.handlerLocalStateGetSnapshotAlerts = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetSnapshotAlerts)
+}
+
+// LocalStateGetSnapshotAlerts will invoke the handler for the RPC method
+// GetSnapshotAlerts from service LocalState
+func (d *LocalStateDispatch) LocalStateGetSnapshotAlerts(ctx context.Context, r *SnapshotAlertsRequest) (*SnapshotAlertsResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetSnapshotAlerts:
+		// return the invoked methods response
+		return d.handlerLocalStateGetSnapshotAlerts.HandleLocalStateGetSnapshotAlerts(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetKnownPeers will register the object 't' as the service
+// handler for the RPC method GetKnownPeers from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetKnownPeers(t LocalStateGetKnownPeersHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetKnownPeers != nil {
+		panic("double registration of LocalStateGetKnownPeers")
+	}
+	// register the service handler
+	d.handlerLocalStateGetKnownPeers = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetKnownPeers)
+}
+
+// LocalStateGetKnownPeers will invoke the handler for the RPC method
+// GetKnownPeers from service LocalState
+func (d *LocalStateDispatch) LocalStateGetKnownPeers(ctx context.Context, r *KnownPeersRequest) (*KnownPeersResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetKnownPeers:
+		// return the invoked methods response
+		return d.handlerLocalStateGetKnownPeers.HandleLocalStateGetKnownPeers(ctx, r)
+	}
+}
+
+// RegisterLocalStateGetActivePeers will register the object 't' as the service
+// handler for the RPC method GetActivePeers from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateGetActivePeers(t LocalStateGetActivePeersHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateGetActivePeers != nil {
+		panic("double registration of LocalStateGetActivePeers")
+	}
+	// register the service handler
+	d.handlerLocalStateGetActivePeers = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateGetActivePeers)
+}
+
+// LocalStateGetActivePeers will invoke the handler for the RPC method
+// GetActivePeers from service LocalState
+func (d *LocalStateDispatch) LocalStateGetActivePeers(ctx context.Context, r *ActivePeersRequest) (*ActivePeersResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateGetActivePeers:
+		// return the invoked methods response
+		return d.handlerLocalStateGetActivePeers.HandleLocalStateGetActivePeers(ctx, r)
+	}
+}
+
+// RegisterLocalStateDisconnectPeer will register the object 't' as the service
+// handler for the RPC method DisconnectPeer from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateDisconnectPeer(t LocalStateDisconnectPeerHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateDisconnectPeer != nil {
+		panic("double registration of LocalStateDisconnectPeer")
+	}
+	// register the service handler
+	d.handlerLocalStateDisconnectPeer = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateDisconnectPeer)
+}
+
+// LocalStateDisconnectPeer will invoke the handler for the RPC method
+// DisconnectPeer from service LocalState
+func (d *LocalStateDispatch) LocalStateDisconnectPeer(ctx context.Context, r *DisconnectPeerRequest) (*DisconnectPeerResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateDisconnectPeer:
+		// return the invoked methods response
+		return d.handlerLocalStateDisconnectPeer.HandleLocalStateDisconnectPeer(ctx, r)
+	}
+}
+
+// RegisterLocalStateDialPeer will register the object 't' as the service
+// handler for the RPC method DialPeer from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateDialPeer(t LocalStateDialPeerHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateDialPeer != nil {
+		panic("double registration of LocalStateDialPeer")
+	}
+	// register the service handler
+	d.handlerLocalStateDialPeer = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateDialPeer)
+}
+
+// LocalStateDialPeer will invoke the handler for the RPC method
+// DialPeer from service LocalState
+func (d *LocalStateDispatch) LocalStateDialPeer(ctx context.Context, r *DialPeerRequest) (*DialPeerResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateDialPeer:
+		// return the invoked methods response
+		return d.handlerLocalStateDialPeer.HandleLocalStateDialPeer(ctx, r)
+	}
+}
+
+// RegisterLocalStateClearSnapshotAlert will register the object 't' as the service
+// handler for the RPC method ClearSnapshotAlert from service LocalState
+func (d *LocalStateDispatch) RegisterLocalStateClearSnapshotAlert(t LocalStateClearSnapshotAlertHandler) {
+	d.Lock()
+	defer d.Unlock()
+	// double registration is not allowed
+	if d.handlerLocalStateClearSnapshotAlert != nil {
+		panic("double registration of LocalStateClearSnapshotAlert")
+	}
+	// register the service handler
+	d.handlerLocalStateClearSnapshotAlert = t
+	// close the wait channel to signal that the method is ready to use
+	close(d.waitChanLocalStateClearSnapshotAlert)
+}
+
+// LocalStateClearSnapshotAlert will invoke the handler for the RPC method
+// ClearSnapshotAlert from service LocalState
+func (d *LocalStateDispatch) LocalStateClearSnapshotAlert(ctx context.Context, r *ClearSnapshotAlertRequest) (*ClearSnapshotAlertResponse, error) {
+	// wait for registration to complete or context to be canceled
+	select {
+	case <-ctx.Done():
+		return nil, errors.New("context canceled")
+	case <-d.waitChanLocalStateClearSnapshotAlert:
+		// return the invoked methods response
+		return d.handlerLocalStateClearSnapshotAlert.HandleLocalStateClearSnapshotAlert(ctx, r)
+	}
+}
+
 // RegisterLocalStateGetBlockNumber will register the object 't' as the service
 // handler for the RPC method GetBlockNumber from service LocalState
 func (d *LocalStateDispatch) RegisterLocalStateGetBlockNumber(t LocalStateGetBlockNumberHandler) {
@@ -639,6 +1069,8 @@ func NewLocalStateDispatch() *LocalStateDispatch {
 		waitChanLocalStateGetMinedTransaction: make(chan struct{}),
 		// initialize the wait channel for method GetBlockHeader on service LocalState
 		waitChanLocalStateGetBlockHeader: make(chan struct{}),
+		// initialize the wait channel for method GetBlockHeaderProof on service LocalState
+		waitChanLocalStateGetBlockHeaderProof: make(chan struct{}),
 		// initialize the wait channel for method GetUTXO on service LocalState
 		waitChanLocalStateGetUTXO: make(chan struct{}),
 		// initialize the wait channel for method GetPendingTransaction on service LocalState
@@ -647,6 +1079,24 @@ func NewLocalStateDispatch() *LocalStateDispatch {
 		waitChanLocalStateGetRoundStateForValidator: make(chan struct{}),
 		// initialize the wait channel for method GetValidatorSet on service LocalState
 		waitChanLocalStateGetValidatorSet: make(chan struct{}),
+		// initialize the wait channel for method GetValidatorParticipation on service LocalState
+		waitChanLocalStateGetValidatorParticipation: make(chan struct{}),
+		// initialize the wait channel for method GetConsensusTrace on service LocalState
+		waitChanLocalStateGetConsensusTrace: make(chan struct{}),
+		// initialize the wait channel for method GetEthDKGStatus on service LocalState
+		waitChanLocalStateGetEthDKGStatus: make(chan struct{}),
+		// initialize the wait channel for method GetSnapshotAlerts on service LocalState
+		waitChanLocalStateGetSnapshotAlerts: make(chan struct{}),
+		// initialize the wait channel for method GetKnownPeers on service LocalState
+		waitChanLocalStateGetKnownPeers: make(chan struct{}),
+		// initialize the wait channel for method GetActivePeers on service LocalState
+		waitChanLocalStateGetActivePeers: make(chan struct{}),
+		// initialize the wait channel for method DisconnectPeer on service LocalState
+		waitChanLocalStateDisconnectPeer: make(chan struct{}),
+		// initialize the wait channel for method DialPeer on service LocalState
+		waitChanLocalStateDialPeer: make(chan struct{}),
+		// initialize the wait channel for method ClearSnapshotAlert on service LocalState
+		waitChanLocalStateClearSnapshotAlert: make(chan struct{}),
 		// initialize the wait channel for method GetBlockNumber on service LocalState
 		waitChanLocalStateGetBlockNumber: make(chan struct{}),
 		// initialize the wait channel for method GetChainID on service LocalState
@@ -702,6 +1152,13 @@ func (s *GeneratedLocalStateServer) GetBlockHeader(ctx context.Context, r *Block
 }
 
 
+// GetBlockHeaderProof will invoke the method GetBlockHeaderProof on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetBlockHeaderProof(ctx context.Context, r *BlockHeaderProofRequest) (*BlockHeaderProofResponse, error) {
+	return s.dispatch.LocalStateGetBlockHeaderProof(ctx, r)
+}
+
+
 // GetUTXO will invoke the method GetUTXO on the RPC service LocalState
 // using the LocalStateDispatch handler.
 func (s *GeneratedLocalStateServer) GetUTXO(ctx context.Context, r *UTXORequest) (*UTXOResponse, error) {
@@ -730,6 +1187,69 @@ func (s *GeneratedLocalStateServer) GetValidatorSet(ctx context.Context, r *Vali
 }
 
 
+// GetValidatorParticipation will invoke the method GetValidatorParticipation on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetValidatorParticipation(ctx context.Context, r *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error) {
+	return s.dispatch.LocalStateGetValidatorParticipation(ctx, r)
+}
+
+
+// GetConsensusTrace will invoke the method GetConsensusTrace on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetConsensusTrace(ctx context.Context, r *ConsensusTraceRequest) (*ConsensusTraceResponse, error) {
+	return s.dispatch.LocalStateGetConsensusTrace(ctx, r)
+}
+
+
+// GetEthDKGStatus will invoke the method GetEthDKGStatus on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetEthDKGStatus(ctx context.Context, r *EthDKGStatusRequest) (*EthDKGStatusResponse, error) {
+	return s.dispatch.LocalStateGetEthDKGStatus(ctx, r)
+}
+
+
+// GetSnapshotAlerts will invoke the method GetSnapshotAlerts on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetSnapshotAlerts(ctx context.Context, r *SnapshotAlertsRequest) (*SnapshotAlertsResponse, error) {
+	return s.dispatch.LocalStateGetSnapshotAlerts(ctx, r)
+}
+
+
+// GetKnownPeers will invoke the method GetKnownPeers on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetKnownPeers(ctx context.Context, r *KnownPeersRequest) (*KnownPeersResponse, error) {
+	return s.dispatch.LocalStateGetKnownPeers(ctx, r)
+}
+
+
+// GetActivePeers will invoke the method GetActivePeers on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) GetActivePeers(ctx context.Context, r *ActivePeersRequest) (*ActivePeersResponse, error) {
+	return s.dispatch.LocalStateGetActivePeers(ctx, r)
+}
+
+
+// DisconnectPeer will invoke the method DisconnectPeer on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) DisconnectPeer(ctx context.Context, r *DisconnectPeerRequest) (*DisconnectPeerResponse, error) {
+	return s.dispatch.LocalStateDisconnectPeer(ctx, r)
+}
+
+
+// DialPeer will invoke the method DialPeer on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) DialPeer(ctx context.Context, r *DialPeerRequest) (*DialPeerResponse, error) {
+	return s.dispatch.LocalStateDialPeer(ctx, r)
+}
+
+
+// ClearSnapshotAlert will invoke the method ClearSnapshotAlert on the RPC service LocalState
+// using the LocalStateDispatch handler.
+func (s *GeneratedLocalStateServer) ClearSnapshotAlert(ctx context.Context, r *ClearSnapshotAlertRequest) (*ClearSnapshotAlertResponse, error) {
+	return s.dispatch.LocalStateClearSnapshotAlert(ctx, r)
+}
+
+
 // GetBlockNumber will invoke the method GetBlockNumber on the RPC service LocalState
 // using the LocalStateDispatch handler.
 func (s *GeneratedLocalStateServer) GetBlockNumber(ctx context.Context, r *BlockNumberRequest) (*BlockNumberResponse, error) {
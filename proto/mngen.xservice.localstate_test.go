@@ -347,6 +347,74 @@ func TestLocalStateGetBlockHeaderCancel(t *testing.T) {
 	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
 }
 
+type testLocalStateGetBlockHeaderProofHandler struct{}
+
+func (th *testLocalStateGetBlockHeaderProofHandler) HandleLocalStateGetBlockHeaderProof(context.Context, *BlockHeaderProofRequest) (*BlockHeaderProofResponse, error) {
+	return &BlockHeaderProofResponse{}, nil
+}
+
+func TestLocalStateGetBlockHeaderProof(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetBlockHeaderProofHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetBlockHeaderProof(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetBlockHeaderProof(context.Background(), &BlockHeaderProofRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetBlockHeaderProof(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetBlockHeaderProofHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetBlockHeaderProof(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetBlockHeaderProof(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetBlockHeaderProofCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetBlockHeaderProof(cancelCtx, &BlockHeaderProofRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
 type testLocalStateGetUTXOHandler struct{}
 
 func (th *testLocalStateGetUTXOHandler) HandleLocalStateGetUTXO(context.Context, *UTXORequest) (*UTXOResponse, error) {
@@ -619,6 +687,618 @@ func TestLocalStateGetValidatorSetCancel(t *testing.T) {
 	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
 }
 
+type testLocalStateGetValidatorParticipationHandler struct{}
+
+func (th *testLocalStateGetValidatorParticipationHandler) HandleLocalStateGetValidatorParticipation(context.Context, *ValidatorParticipationRequest) (*ValidatorParticipationResponse, error) {
+	return &ValidatorParticipationResponse{}, nil
+}
+
+func TestLocalStateGetValidatorParticipation(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetValidatorParticipationHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetValidatorParticipation(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetValidatorParticipation(context.Background(), &ValidatorParticipationRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetValidatorParticipation(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetValidatorParticipationHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetValidatorParticipation(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetValidatorParticipation(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetValidatorParticipationCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetValidatorParticipation(cancelCtx, &ValidatorParticipationRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetConsensusTraceHandler struct{}
+
+func (th *testLocalStateGetConsensusTraceHandler) HandleLocalStateGetConsensusTrace(context.Context, *ConsensusTraceRequest) (*ConsensusTraceResponse, error) {
+	return &ConsensusTraceResponse{}, nil
+}
+
+func TestLocalStateGetConsensusTrace(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetConsensusTraceHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetConsensusTrace(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetConsensusTrace(context.Background(), &ConsensusTraceRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetConsensusTrace(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetConsensusTraceHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetConsensusTrace(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetConsensusTrace(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetConsensusTraceCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetConsensusTrace(cancelCtx, &ConsensusTraceRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetEthDKGStatusHandler struct{}
+
+func (th *testLocalStateGetEthDKGStatusHandler) HandleLocalStateGetEthDKGStatus(context.Context, *EthDKGStatusRequest) (*EthDKGStatusResponse, error) {
+	return &EthDKGStatusResponse{}, nil
+}
+
+func TestLocalStateGetEthDKGStatus(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetEthDKGStatusHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetEthDKGStatus(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetEthDKGStatus(context.Background(), &EthDKGStatusRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetEthDKGStatus(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetEthDKGStatusHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetEthDKGStatus(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetEthDKGStatus(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetEthDKGStatusCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetEthDKGStatus(cancelCtx, &EthDKGStatusRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetSnapshotAlertsHandler struct{}
+
+func (th *testLocalStateGetSnapshotAlertsHandler) HandleLocalStateGetSnapshotAlerts(context.Context, *SnapshotAlertsRequest) (*SnapshotAlertsResponse, error) {
+	return &SnapshotAlertsResponse{}, nil
+}
+
+func TestLocalStateGetSnapshotAlerts(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetSnapshotAlertsHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetSnapshotAlerts(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetSnapshotAlerts(context.Background(), &SnapshotAlertsRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetSnapshotAlerts(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetSnapshotAlertsHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetSnapshotAlerts(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetSnapshotAlerts(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetSnapshotAlertsCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetSnapshotAlerts(cancelCtx, &SnapshotAlertsRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetKnownPeersHandler struct{}
+
+func (th *testLocalStateGetKnownPeersHandler) HandleLocalStateGetKnownPeers(context.Context, *KnownPeersRequest) (*KnownPeersResponse, error) {
+	return &KnownPeersResponse{}, nil
+}
+
+func TestLocalStateGetKnownPeers(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetKnownPeersHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetKnownPeers(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetKnownPeers(context.Background(), &KnownPeersRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetKnownPeers(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetKnownPeersHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetKnownPeers(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetKnownPeers(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetKnownPeersCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetKnownPeers(cancelCtx, &KnownPeersRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateGetActivePeersHandler struct{}
+
+func (th *testLocalStateGetActivePeersHandler) HandleLocalStateGetActivePeers(context.Context, *ActivePeersRequest) (*ActivePeersResponse, error) {
+	return &ActivePeersResponse{}, nil
+}
+
+func TestLocalStateGetActivePeers(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetActivePeersHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetActivePeers(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.GetActivePeers(context.Background(), &ActivePeersRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateGetActivePeers(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateGetActivePeersHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateGetActivePeers(h)
+
+	fn := func() {
+		d.RegisterLocalStateGetActivePeers(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateGetActivePeersCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.GetActivePeers(cancelCtx, &ActivePeersRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateDisconnectPeerHandler struct{}
+
+func (th *testLocalStateDisconnectPeerHandler) HandleLocalStateDisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error) {
+	return &DisconnectPeerResponse{}, nil
+}
+
+func TestLocalStateDisconnectPeer(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateDisconnectPeerHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateDisconnectPeer(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.DisconnectPeer(context.Background(), &DisconnectPeerRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateDisconnectPeer(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateDisconnectPeerHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateDisconnectPeer(h)
+
+	fn := func() {
+		d.RegisterLocalStateDisconnectPeer(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateDisconnectPeerCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.DisconnectPeer(cancelCtx, &DisconnectPeerRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateDialPeerHandler struct{}
+
+func (th *testLocalStateDialPeerHandler) HandleLocalStateDialPeer(context.Context, *DialPeerRequest) (*DialPeerResponse, error) {
+	return &DialPeerResponse{}, nil
+}
+
+func TestLocalStateDialPeer(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateDialPeerHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateDialPeer(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.DialPeer(context.Background(), &DialPeerRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateDialPeer(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateDialPeerHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateDialPeer(h)
+
+	fn := func() {
+		d.RegisterLocalStateDialPeer(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateDialPeerCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.DialPeer(cancelCtx, &DialPeerRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
+type testLocalStateClearSnapshotAlertHandler struct{}
+
+func (th *testLocalStateClearSnapshotAlertHandler) HandleLocalStateClearSnapshotAlert(context.Context, *ClearSnapshotAlertRequest) (*ClearSnapshotAlertResponse, error) {
+	return &ClearSnapshotAlertResponse{}, nil
+}
+
+func TestLocalStateClearSnapshotAlert(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateClearSnapshotAlertHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateClearSnapshotAlert(h)
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	_, err := srvr.ClearSnapshotAlert(context.Background(), &ClearSnapshotAlertRequest{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDoubleregistrationLocalStateClearSnapshotAlert(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Setup the handler for the TestService
+	h := &testLocalStateClearSnapshotAlertHandler{}
+
+	// Register the handler with the dispatch class
+	d.RegisterLocalStateClearSnapshotAlert(h)
+
+	fn := func() {
+		d.RegisterLocalStateClearSnapshotAlert(h)
+	}
+	assert.Panics(t, fn, "double registration must panic")
+}
+
+func TestLocalStateClearSnapshotAlertCancel(t *testing.T) {
+	// Setup the dispatch handler
+	d := NewLocalStateDispatch()
+
+	// Create the server and pass in the dispatch class
+	srvr := GeneratedLocalStateServer{
+		dispatch: d,
+	}
+
+	// Test calling the method TestCall
+	errChan := make(chan error)
+	defer close(errChan)
+	ctx := context.Background()
+	cancelCtx, cancelFunc := context.WithCancel(ctx)
+	fn := func() {
+		_, err := srvr.ClearSnapshotAlert(cancelCtx, &ClearSnapshotAlertRequest{})
+		errChan <- err
+	}
+	go fn()
+	cancelFunc()
+	cancelErr := <-errChan
+	assert.EqualError(t, cancelErr, "context canceled", "the error returned must be a context canceled error")
+}
+
 type testLocalStateGetBlockNumberHandler struct{}
 
 func (th *testLocalStateGetBlockNumberHandler) HandleLocalStateGetBlockNumber(context.Context, *BlockNumberRequest) (*BlockNumberResponse, error) {
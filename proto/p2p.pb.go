@@ -29,6 +29,108 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type FindNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target string `protobuf:"bytes,1,opt,name=Target,proto3" json:"Target,omitempty"` // hex encoded key to find the closest peers to
+	Record []byte `protobuf:"bytes,2,opt,name=Record,proto3" json:"Record,omitempty"` // signed node record of the caller
+}
+
+func (x *FindNodeRequest) Reset() {
+	*x = FindNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNodeRequest) ProtoMessage() {}
+
+func (x *FindNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNodeRequest.ProtoReflect.Descriptor instead.
+func (*FindNodeRequest) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FindNodeRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *FindNodeRequest) GetRecord() []byte {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type FindNodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records [][]byte `protobuf:"bytes,2,rep,name=Records,proto3" json:"Records,omitempty"` // signed node records of the peers found
+}
+
+func (x *FindNodeResponse) Reset() {
+	*x = FindNodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNodeResponse) ProtoMessage() {}
+
+func (x *FindNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNodeResponse.ProtoReflect.Descriptor instead.
+func (*FindNodeResponse) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindNodeResponse) GetRecords() [][]byte {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
 type GetPeersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -40,7 +142,7 @@ type GetPeersRequest struct {
 func (x *GetPeersRequest) Reset() {
 	*x = GetPeersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[0]
+		mi := &file_p2p_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -53,7 +155,7 @@ func (x *GetPeersRequest) String() string {
 func (*GetPeersRequest) ProtoMessage() {}
 
 func (x *GetPeersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[0]
+	mi := &file_p2p_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -66,7 +168,7 @@ func (x *GetPeersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeersRequest.ProtoReflect.Descriptor instead.
 func (*GetPeersRequest) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{0}
+	return file_p2p_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetPeersRequest) GetTarget() string {
@@ -81,13 +183,14 @@ type GetPeersResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers []string `protobuf:"bytes,1,rep,name=Peers,proto3" json:"Peers,omitempty"`
+	Peers   []string `protobuf:"bytes,1,rep,name=Peers,proto3" json:"Peers,omitempty"`     // p2p addresses of the peers for legacy nodes
+	Records [][]byte `protobuf:"bytes,2,rep,name=Records,proto3" json:"Records,omitempty"` // signed node records of the peers
 }
 
 func (x *GetPeersResponse) Reset() {
 	*x = GetPeersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[1]
+		mi := &file_p2p_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -100,7 +203,7 @@ func (x *GetPeersResponse) String() string {
 func (*GetPeersResponse) ProtoMessage() {}
 
 func (x *GetPeersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[1]
+	mi := &file_p2p_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -113,7 +216,7 @@ func (x *GetPeersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeersResponse.ProtoReflect.Descriptor instead.
 func (*GetPeersResponse) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{1}
+	return file_p2p_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetPeersResponse) GetPeers() []string {
@@ -123,6 +226,13 @@ func (x *GetPeersResponse) GetPeers() []string {
 	return nil
 }
 
+func (x *GetPeersResponse) GetRecords() [][]byte {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
 type StatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -132,7 +242,7 @@ type StatusRequest struct {
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[2]
+		mi := &file_p2p_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -145,7 +255,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[2]
+	mi := &file_p2p_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,7 +268,7 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{2}
+	return file_p2p_proto_rawDescGZIP(), []int{4}
 }
 
 type StatusResponse struct {
@@ -172,7 +282,7 @@ type StatusResponse struct {
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[3]
+		mi := &file_p2p_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -185,7 +295,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[3]
+	mi := &file_p2p_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +308,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{3}
+	return file_p2p_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StatusResponse) GetHighestBlockHeader() []byte {
@@ -219,7 +329,7 @@ type GetBlockHeadersRequest struct {
 func (x *GetBlockHeadersRequest) Reset() {
 	*x = GetBlockHeadersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[4]
+		mi := &file_p2p_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -232,7 +342,7 @@ func (x *GetBlockHeadersRequest) String() string {
 func (*GetBlockHeadersRequest) ProtoMessage() {}
 
 func (x *GetBlockHeadersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[4]
+	mi := &file_p2p_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +355,7 @@ func (x *GetBlockHeadersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersRequest) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{4}
+	return file_p2p_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetBlockHeadersRequest) GetBlockNumbers() []uint32 {
@@ -266,7 +376,7 @@ type GetBlockHeadersResponse struct {
 func (x *GetBlockHeadersResponse) Reset() {
 	*x = GetBlockHeadersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[5]
+		mi := &file_p2p_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -279,7 +389,7 @@ func (x *GetBlockHeadersResponse) String() string {
 func (*GetBlockHeadersResponse) ProtoMessage() {}
 
 func (x *GetBlockHeadersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[5]
+	mi := &file_p2p_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +402,7 @@ func (x *GetBlockHeadersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersResponse) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{5}
+	return file_p2p_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetBlockHeadersResponse) GetBlockHeaders() [][]byte {
@@ -313,7 +423,7 @@ type GetPendingTxsRequest struct {
 func (x *GetPendingTxsRequest) Reset() {
 	*x = GetPendingTxsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[6]
+		mi := &file_p2p_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -326,7 +436,7 @@ func (x *GetPendingTxsRequest) String() string {
 func (*GetPendingTxsRequest) ProtoMessage() {}
 
 func (x *GetPendingTxsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[6]
+	mi := &file_p2p_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +449,7 @@ func (x *GetPendingTxsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPendingTxsRequest.ProtoReflect.Descriptor instead.
 func (*GetPendingTxsRequest) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{6}
+	return file_p2p_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPendingTxsRequest) GetTxHashes() [][]byte {
@@ -360,7 +470,7 @@ type GetPendingTxsResponse struct {
 func (x *GetPendingTxsResponse) Reset() {
 	*x = GetPendingTxsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[7]
+		mi := &file_p2p_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -373,7 +483,7 @@ func (x *GetPendingTxsResponse) String() string {
 func (*GetPendingTxsResponse) ProtoMessage() {}
 
 func (x *GetPendingTxsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[7]
+	mi := &file_p2p_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +496,7 @@ func (x *GetPendingTxsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPendingTxsResponse.ProtoReflect.Descriptor instead.
 func (*GetPendingTxsResponse) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{7}
+	return file_p2p_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPendingTxsResponse) GetTxs() [][]byte {
@@ -407,7 +517,7 @@ type GetMinedTxsRequest struct {
 func (x *GetMinedTxsRequest) Reset() {
 	*x = GetMinedTxsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[8]
+		mi := &file_p2p_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -420,7 +530,7 @@ func (x *GetMinedTxsRequest) String() string {
 func (*GetMinedTxsRequest) ProtoMessage() {}
 
 func (x *GetMinedTxsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[8]
+	mi := &file_p2p_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +543,7 @@ func (x *GetMinedTxsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinedTxsRequest.ProtoReflect.Descriptor instead.
 func (*GetMinedTxsRequest) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{8}
+	return file_p2p_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetMinedTxsRequest) GetTxHashes() [][]byte {
@@ -454,7 +564,7 @@ type GetMinedTxsResponse struct {
 func (x *GetMinedTxsResponse) Reset() {
 	*x = GetMinedTxsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[9]
+		mi := &file_p2p_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -467,7 +577,7 @@ func (x *GetMinedTxsResponse) String() string {
 func (*GetMinedTxsResponse) ProtoMessage() {}
 
 func (x *GetMinedTxsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[9]
+	mi := &file_p2p_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +590,7 @@ func (x *GetMinedTxsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinedTxsResponse.ProtoReflect.Descriptor instead.
 func (*GetMinedTxsResponse) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{9}
+	return file_p2p_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetMinedTxsResponse) GetTxs() [][]byte {
@@ -502,7 +612,7 @@ type GetSnapShotNodeRequest struct {
 func (x *GetSnapShotNodeRequest) Reset() {
 	*x = GetSnapShotNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[10]
+		mi := &file_p2p_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -515,7 +625,7 @@ func (x *GetSnapShotNodeRequest) String() string {
 func (*GetSnapShotNodeRequest) ProtoMessage() {}
 
 func (x *GetSnapShotNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[10]
+	mi := &file_p2p_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +638,7 @@ func (x *GetSnapShotNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapShotNodeRequest.ProtoReflect.Descriptor instead.
 func (*GetSnapShotNodeRequest) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{10}
+	return file_p2p_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetSnapShotNodeRequest) GetHeight() uint32 {
@@ -556,7 +666,7 @@ type GetSnapShotNodeResponse struct {
 func (x *GetSnapShotNodeResponse) Reset() {
 	*x = GetSnapShotNodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[11]
+		mi := &file_p2p_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -569,7 +679,7 @@ func (x *GetSnapShotNodeResponse) String() string {
 func (*GetSnapShotNodeResponse) ProtoMessage() {}
 
 func (x *GetSnapShotNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[11]
+	mi := &file_p2p_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +692,7 @@ func (x *GetSnapShotNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapShotNodeResponse.ProtoReflect.Descriptor instead.
 func (*GetSnapShotNodeResponse) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{11}
+	return file_p2p_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetSnapShotNodeResponse) GetNode() []byte {
@@ -603,7 +713,7 @@ type GetSnapShotStateDataRequest struct {
 func (x *GetSnapShotStateDataRequest) Reset() {
 	*x = GetSnapShotStateDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[12]
+		mi := &file_p2p_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -616,7 +726,7 @@ func (x *GetSnapShotStateDataRequest) String() string {
 func (*GetSnapShotStateDataRequest) ProtoMessage() {}
 
 func (x *GetSnapShotStateDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[12]
+	mi := &file_p2p_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +739,7 @@ func (x *GetSnapShotStateDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapShotStateDataRequest.ProtoReflect.Descriptor instead.
 func (*GetSnapShotStateDataRequest) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{12}
+	return file_p2p_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetSnapShotStateDataRequest) GetKey() []byte {
@@ -650,7 +760,7 @@ type GetSnapShotStateDataResponse struct {
 func (x *GetSnapShotStateDataResponse) Reset() {
 	*x = GetSnapShotStateDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[13]
+		mi := &file_p2p_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -663,7 +773,7 @@ func (x *GetSnapShotStateDataResponse) String() string {
 func (*GetSnapShotStateDataResponse) ProtoMessage() {}
 
 func (x *GetSnapShotStateDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[13]
+	mi := &file_p2p_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +786,7 @@ func (x *GetSnapShotStateDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapShotStateDataResponse.ProtoReflect.Descriptor instead.
 func (*GetSnapShotStateDataResponse) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{13}
+	return file_p2p_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetSnapShotStateDataResponse) GetData() []byte {
@@ -697,7 +807,7 @@ type GetSnapShotHdrNodeRequest struct {
 func (x *GetSnapShotHdrNodeRequest) Reset() {
 	*x = GetSnapShotHdrNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[14]
+		mi := &file_p2p_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -710,7 +820,7 @@ func (x *GetSnapShotHdrNodeRequest) String() string {
 func (*GetSnapShotHdrNodeRequest) ProtoMessage() {}
 
 func (x *GetSnapShotHdrNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[14]
+	mi := &file_p2p_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,7 +833,7 @@ func (x *GetSnapShotHdrNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapShotHdrNodeRequest.ProtoReflect.Descriptor instead.
 func (*GetSnapShotHdrNodeRequest) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{14}
+	return file_p2p_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetSnapShotHdrNodeRequest) GetNodeHash() []byte {
@@ -744,7 +854,7 @@ type GetSnapShotHdrNodeResponse struct {
 func (x *GetSnapShotHdrNodeResponse) Reset() {
 	*x = GetSnapShotHdrNodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[15]
+		mi := &file_p2p_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -757,7 +867,7 @@ func (x *GetSnapShotHdrNodeResponse) String() string {
 func (*GetSnapShotHdrNodeResponse) ProtoMessage() {}
 
 func (x *GetSnapShotHdrNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[15]
+	mi := &file_p2p_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +880,7 @@ func (x *GetSnapShotHdrNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSnapShotHdrNodeResponse.ProtoReflect.Descriptor instead.
 func (*GetSnapShotHdrNodeResponse) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{15}
+	return file_p2p_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetSnapShotHdrNodeResponse) GetNode() []byte {
@@ -791,7 +901,7 @@ type GossipProposalMessage struct {
 func (x *GossipProposalMessage) Reset() {
 	*x = GossipProposalMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[16]
+		mi := &file_p2p_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -804,7 +914,7 @@ func (x *GossipProposalMessage) String() string {
 func (*GossipProposalMessage) ProtoMessage() {}
 
 func (x *GossipProposalMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[16]
+	mi := &file_p2p_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +927,7 @@ func (x *GossipProposalMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipProposalMessage.ProtoReflect.Descriptor instead.
 func (*GossipProposalMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{16}
+	return file_p2p_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GossipProposalMessage) GetProposal() []byte {
@@ -836,7 +946,7 @@ type GossipProposalAck struct {
 func (x *GossipProposalAck) Reset() {
 	*x = GossipProposalAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[17]
+		mi := &file_p2p_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -849,7 +959,7 @@ func (x *GossipProposalAck) String() string {
 func (*GossipProposalAck) ProtoMessage() {}
 
 func (x *GossipProposalAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[17]
+	mi := &file_p2p_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,7 +972,7 @@ func (x *GossipProposalAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipProposalAck.ProtoReflect.Descriptor instead.
 func (*GossipProposalAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{17}
+	return file_p2p_proto_rawDescGZIP(), []int{19}
 }
 
 type GossipPreVoteMessage struct {
@@ -876,7 +986,7 @@ type GossipPreVoteMessage struct {
 func (x *GossipPreVoteMessage) Reset() {
 	*x = GossipPreVoteMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[18]
+		mi := &file_p2p_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -889,7 +999,7 @@ func (x *GossipPreVoteMessage) String() string {
 func (*GossipPreVoteMessage) ProtoMessage() {}
 
 func (x *GossipPreVoteMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[18]
+	mi := &file_p2p_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1012,7 @@ func (x *GossipPreVoteMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipPreVoteMessage.ProtoReflect.Descriptor instead.
 func (*GossipPreVoteMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{18}
+	return file_p2p_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GossipPreVoteMessage) GetPreVote() []byte {
@@ -921,7 +1031,7 @@ type GossipPreVoteAck struct {
 func (x *GossipPreVoteAck) Reset() {
 	*x = GossipPreVoteAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[19]
+		mi := &file_p2p_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -934,7 +1044,7 @@ func (x *GossipPreVoteAck) String() string {
 func (*GossipPreVoteAck) ProtoMessage() {}
 
 func (x *GossipPreVoteAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[19]
+	mi := &file_p2p_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +1057,7 @@ func (x *GossipPreVoteAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipPreVoteAck.ProtoReflect.Descriptor instead.
 func (*GossipPreVoteAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{19}
+	return file_p2p_proto_rawDescGZIP(), []int{21}
 }
 
 type GossipPreVoteNilMessage struct {
@@ -961,7 +1071,7 @@ type GossipPreVoteNilMessage struct {
 func (x *GossipPreVoteNilMessage) Reset() {
 	*x = GossipPreVoteNilMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[20]
+		mi := &file_p2p_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -974,7 +1084,7 @@ func (x *GossipPreVoteNilMessage) String() string {
 func (*GossipPreVoteNilMessage) ProtoMessage() {}
 
 func (x *GossipPreVoteNilMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[20]
+	mi := &file_p2p_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,7 +1097,7 @@ func (x *GossipPreVoteNilMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipPreVoteNilMessage.ProtoReflect.Descriptor instead.
 func (*GossipPreVoteNilMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{20}
+	return file_p2p_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GossipPreVoteNilMessage) GetPreVoteNil() []byte {
@@ -1006,7 +1116,7 @@ type GossipPreVoteNilAck struct {
 func (x *GossipPreVoteNilAck) Reset() {
 	*x = GossipPreVoteNilAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[21]
+		mi := &file_p2p_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1019,7 +1129,7 @@ func (x *GossipPreVoteNilAck) String() string {
 func (*GossipPreVoteNilAck) ProtoMessage() {}
 
 func (x *GossipPreVoteNilAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[21]
+	mi := &file_p2p_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1142,7 @@ func (x *GossipPreVoteNilAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipPreVoteNilAck.ProtoReflect.Descriptor instead.
 func (*GossipPreVoteNilAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{21}
+	return file_p2p_proto_rawDescGZIP(), []int{23}
 }
 
 type GossipPreCommitMessage struct {
@@ -1046,7 +1156,7 @@ type GossipPreCommitMessage struct {
 func (x *GossipPreCommitMessage) Reset() {
 	*x = GossipPreCommitMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[22]
+		mi := &file_p2p_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1059,7 +1169,7 @@ func (x *GossipPreCommitMessage) String() string {
 func (*GossipPreCommitMessage) ProtoMessage() {}
 
 func (x *GossipPreCommitMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[22]
+	mi := &file_p2p_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1182,7 @@ func (x *GossipPreCommitMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipPreCommitMessage.ProtoReflect.Descriptor instead.
 func (*GossipPreCommitMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{22}
+	return file_p2p_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GossipPreCommitMessage) GetPreCommit() []byte {
@@ -1091,7 +1201,7 @@ type GossipPreCommitAck struct {
 func (x *GossipPreCommitAck) Reset() {
 	*x = GossipPreCommitAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[23]
+		mi := &file_p2p_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1104,7 +1214,7 @@ func (x *GossipPreCommitAck) String() string {
 func (*GossipPreCommitAck) ProtoMessage() {}
 
 func (x *GossipPreCommitAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[23]
+	mi := &file_p2p_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,7 +1227,7 @@ func (x *GossipPreCommitAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipPreCommitAck.ProtoReflect.Descriptor instead.
 func (*GossipPreCommitAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{23}
+	return file_p2p_proto_rawDescGZIP(), []int{25}
 }
 
 type GossipPreCommitNilMessage struct {
@@ -1131,7 +1241,7 @@ type GossipPreCommitNilMessage struct {
 func (x *GossipPreCommitNilMessage) Reset() {
 	*x = GossipPreCommitNilMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[24]
+		mi := &file_p2p_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1144,7 +1254,7 @@ func (x *GossipPreCommitNilMessage) String() string {
 func (*GossipPreCommitNilMessage) ProtoMessage() {}
 
 func (x *GossipPreCommitNilMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[24]
+	mi := &file_p2p_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,7 +1267,7 @@ func (x *GossipPreCommitNilMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipPreCommitNilMessage.ProtoReflect.Descriptor instead.
 func (*GossipPreCommitNilMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{24}
+	return file_p2p_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GossipPreCommitNilMessage) GetPreCommitNil() []byte {
@@ -1176,7 +1286,7 @@ type GossipPreCommitNilAck struct {
 func (x *GossipPreCommitNilAck) Reset() {
 	*x = GossipPreCommitNilAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[25]
+		mi := &file_p2p_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1189,7 +1299,7 @@ func (x *GossipPreCommitNilAck) String() string {
 func (*GossipPreCommitNilAck) ProtoMessage() {}
 
 func (x *GossipPreCommitNilAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[25]
+	mi := &file_p2p_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,7 +1312,7 @@ func (x *GossipPreCommitNilAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipPreCommitNilAck.ProtoReflect.Descriptor instead.
 func (*GossipPreCommitNilAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{25}
+	return file_p2p_proto_rawDescGZIP(), []int{27}
 }
 
 type GossipNextRoundMessage struct {
@@ -1216,7 +1326,7 @@ type GossipNextRoundMessage struct {
 func (x *GossipNextRoundMessage) Reset() {
 	*x = GossipNextRoundMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[26]
+		mi := &file_p2p_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1229,7 +1339,7 @@ func (x *GossipNextRoundMessage) String() string {
 func (*GossipNextRoundMessage) ProtoMessage() {}
 
 func (x *GossipNextRoundMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[26]
+	mi := &file_p2p_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,7 +1352,7 @@ func (x *GossipNextRoundMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipNextRoundMessage.ProtoReflect.Descriptor instead.
 func (*GossipNextRoundMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{26}
+	return file_p2p_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GossipNextRoundMessage) GetNextRound() []byte {
@@ -1261,7 +1371,7 @@ type GossipNextRoundAck struct {
 func (x *GossipNextRoundAck) Reset() {
 	*x = GossipNextRoundAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[27]
+		mi := &file_p2p_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1274,7 +1384,7 @@ func (x *GossipNextRoundAck) String() string {
 func (*GossipNextRoundAck) ProtoMessage() {}
 
 func (x *GossipNextRoundAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[27]
+	mi := &file_p2p_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1397,7 @@ func (x *GossipNextRoundAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipNextRoundAck.ProtoReflect.Descriptor instead.
 func (*GossipNextRoundAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{27}
+	return file_p2p_proto_rawDescGZIP(), []int{29}
 }
 
 type GossipNextHeightMessage struct {
@@ -1301,7 +1411,7 @@ type GossipNextHeightMessage struct {
 func (x *GossipNextHeightMessage) Reset() {
 	*x = GossipNextHeightMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[28]
+		mi := &file_p2p_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1314,7 +1424,7 @@ func (x *GossipNextHeightMessage) String() string {
 func (*GossipNextHeightMessage) ProtoMessage() {}
 
 func (x *GossipNextHeightMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[28]
+	mi := &file_p2p_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,7 +1437,7 @@ func (x *GossipNextHeightMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipNextHeightMessage.ProtoReflect.Descriptor instead.
 func (*GossipNextHeightMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{28}
+	return file_p2p_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GossipNextHeightMessage) GetNextHeight() []byte {
@@ -1346,7 +1456,7 @@ type GossipNextHeightAck struct {
 func (x *GossipNextHeightAck) Reset() {
 	*x = GossipNextHeightAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[29]
+		mi := &file_p2p_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1359,7 +1469,7 @@ func (x *GossipNextHeightAck) String() string {
 func (*GossipNextHeightAck) ProtoMessage() {}
 
 func (x *GossipNextHeightAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[29]
+	mi := &file_p2p_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,7 +1482,7 @@ func (x *GossipNextHeightAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipNextHeightAck.ProtoReflect.Descriptor instead.
 func (*GossipNextHeightAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{29}
+	return file_p2p_proto_rawDescGZIP(), []int{31}
 }
 
 type GossipBlockHeaderMessage struct {
@@ -1386,7 +1496,7 @@ type GossipBlockHeaderMessage struct {
 func (x *GossipBlockHeaderMessage) Reset() {
 	*x = GossipBlockHeaderMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[30]
+		mi := &file_p2p_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1399,7 +1509,7 @@ func (x *GossipBlockHeaderMessage) String() string {
 func (*GossipBlockHeaderMessage) ProtoMessage() {}
 
 func (x *GossipBlockHeaderMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[30]
+	mi := &file_p2p_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,7 +1522,7 @@ func (x *GossipBlockHeaderMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipBlockHeaderMessage.ProtoReflect.Descriptor instead.
 func (*GossipBlockHeaderMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{30}
+	return file_p2p_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GossipBlockHeaderMessage) GetBlockHeader() []byte {
@@ -1431,7 +1541,7 @@ type GossipBlockHeaderAck struct {
 func (x *GossipBlockHeaderAck) Reset() {
 	*x = GossipBlockHeaderAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[31]
+		mi := &file_p2p_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1444,7 +1554,7 @@ func (x *GossipBlockHeaderAck) String() string {
 func (*GossipBlockHeaderAck) ProtoMessage() {}
 
 func (x *GossipBlockHeaderAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[31]
+	mi := &file_p2p_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1567,7 @@ func (x *GossipBlockHeaderAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipBlockHeaderAck.ProtoReflect.Descriptor instead.
 func (*GossipBlockHeaderAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{31}
+	return file_p2p_proto_rawDescGZIP(), []int{33}
 }
 
 type GossipTransactionMessage struct {
@@ -1471,7 +1581,7 @@ type GossipTransactionMessage struct {
 func (x *GossipTransactionMessage) Reset() {
 	*x = GossipTransactionMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[32]
+		mi := &file_p2p_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1484,7 +1594,7 @@ func (x *GossipTransactionMessage) String() string {
 func (*GossipTransactionMessage) ProtoMessage() {}
 
 func (x *GossipTransactionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[32]
+	mi := &file_p2p_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,7 +1607,7 @@ func (x *GossipTransactionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipTransactionMessage.ProtoReflect.Descriptor instead.
 func (*GossipTransactionMessage) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{32}
+	return file_p2p_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GossipTransactionMessage) GetTransaction() []byte {
@@ -1516,7 +1626,7 @@ type GossipTransactionAck struct {
 func (x *GossipTransactionAck) Reset() {
 	*x = GossipTransactionAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[33]
+		mi := &file_p2p_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1529,7 +1639,7 @@ func (x *GossipTransactionAck) String() string {
 func (*GossipTransactionAck) ProtoMessage() {}
 
 func (x *GossipTransactionAck) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[33]
+	mi := &file_p2p_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,200 +1652,214 @@ func (x *GossipTransactionAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipTransactionAck.ProtoReflect.Descriptor instead.
 func (*GossipTransactionAck) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{33}
+	return file_p2p_proto_rawDescGZIP(), []int{35}
 }
 
 var File_p2p_proto protoreflect.FileDescriptor
 
 var file_p2p_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x70, 0x32, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x74, 0x6f, 0x22, 0x41, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x28, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x48, 0x69,
-	0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x08, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x03, 0x54, 0x78, 0x73, 0x22, 0x30, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
-	0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08,
-	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
-	0x69, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x54, 0x78,
-	0x73, 0x22, 0x4c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22,
-	0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x2f,
-	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22,
-	0x32, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68,
-	0x6f, 0x74, 0x48, 0x64, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0x30, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x48, 0x64, 0x72, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x33,
-	0x0a, 0x15, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x22, 0x30, 0x0a, 0x14, 0x47, 0x6f, 0x73, 0x73,
-	0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x6f,
-	0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x6b, 0x22, 0x39,
-	0x0a, 0x17, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e,
-	0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x65,
-	0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x50,
-	0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x6f, 0x73,
-	0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x41, 0x63, 0x6b,
-	0x22, 0x36, 0x0a, 0x16, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x50,
-	0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x6f, 0x73, 0x73,
-	0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x6b, 0x22, 0x3f,
-	0x0a, 0x19, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x4e, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50,
-	0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4e, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4e, 0x69, 0x6c, 0x22,
-	0x17, 0x0a, 0x15, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x4e, 0x69, 0x6c, 0x41, 0x63, 0x6b, 0x22, 0x36, 0x0a, 0x16, 0x47, 0x6f, 0x73, 0x73,
-	0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x22, 0x14, 0x0a, 0x12, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x41, 0x63, 0x6b, 0x22, 0x39, 0x0a, 0x17, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
-	0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x6b, 0x22, 0x3c, 0x0a, 0x18, 0x47, 0x6f, 0x73, 0x73,
-	0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x63, 0x6b, 0x22, 0x3c,
-	0x0a, 0x18, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14,
-	0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x63, 0x6b, 0x32, 0xd4, 0x0a, 0x0a, 0x03, 0x50, 0x32, 0x50, 0x12, 0x37, 0x0a, 0x06,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x32, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x22, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x07, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x0e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x48,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x08, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x03, 0x54, 0x78, 0x73, 0x22, 0x30, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69,
+	0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x08, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x54,
+	0x78, 0x73, 0x22, 0x4c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22,
+	0x2f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79,
+	0x22, 0x32, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53,
+	0x68, 0x6f, 0x74, 0x48, 0x64, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0x30, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x48, 0x64, 0x72, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22,
+	0x33, 0x0a, 0x15, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x22, 0x30, 0x0a, 0x14, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x6b, 0x22,
+	0x39, 0x0a, 0x17, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65,
+	0x4e, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72,
+	0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x41, 0x63,
+	0x6b, 0x22, 0x36, 0x0a, 0x16, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50,
+	0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x6b, 0x22,
+	0x3f, 0x0a, 0x19, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x4e, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4e, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4e, 0x69, 0x6c,
+	0x22, 0x17, 0x0a, 0x15, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4e, 0x69, 0x6c, 0x41, 0x63, 0x6b, 0x22, 0x36, 0x0a, 0x16, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x6b, 0x22, 0x39, 0x0a, 0x17, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x6b, 0x22, 0x3c, 0x0a, 0x18, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x63, 0x6b, 0x22,
+	0x3c, 0x0a, 0x18, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a,
+	0x14, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x32, 0xd4, 0x0a, 0x0a, 0x03, 0x50, 0x32, 0x50, 0x12, 0x37, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x69, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
-	0x78, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e,
-	0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61,
-	0x70, 0x53, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68,
-	0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53,
-	0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61,
-	0x70, 0x53, 0x68, 0x6f, 0x74, 0x48, 0x64, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53,
+	0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f, 0x74,
-	0x48, 0x64, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68,
-	0x6f, 0x74, 0x48, 0x64, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x11, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70,
+	0x53, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x53, 0x68, 0x6f, 0x74, 0x48, 0x64, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53, 0x68, 0x6f,
+	0x74, 0x48, 0x64, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x53,
+	0x68, 0x6f, 0x74, 0x48, 0x64, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x11, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x6f, 0x73, 0x73,
-	0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x41,
-	0x63, 0x6b, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72,
-	0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f,
-	0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69,
-	0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x50, 0x0a,
-	0x10, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69,
-	0x6c, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
-	0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
-	0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
-	0x4d, 0x0a, 0x0f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69,
-	0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
-	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x56,
-	0x0a, 0x12, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x4e, 0x69, 0x6c, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73,
-	0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4e, 0x69, 0x6c, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4e, 0x69,
-	0x6c, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
-	0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50,
+	0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x50,
+	0x0a, 0x10, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e,
+	0x69, 0x6c, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x50, 0x72, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x4e, 0x69, 0x6c, 0x41, 0x63, 0x6b, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x0f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x12, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x4e, 0x69, 0x6c, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4e, 0x69, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4e,
+	0x69, 0x6c, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0f, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e,
-	0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x11, 0x47, 0x6f, 0x73, 0x73, 0x69,
-	0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1b, 0x2e,
+	0x64, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x11, 0x47, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x4a, 0x0a, 0x09, 0x44,
-	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x4a, 0x0a, 0x09,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x44, 0x0a, 0x03, 0x44, 0x48, 0x54, 0x12,
+	0x3d, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1750,82 +1874,86 @@ func file_p2p_proto_rawDescGZIP() []byte {
 	return file_p2p_proto_rawDescData
 }
 
-var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_p2p_proto_goTypes = []interface{}{
-	(*GetPeersRequest)(nil),              // 0: proto.GetPeersRequest
-	(*GetPeersResponse)(nil),             // 1: proto.GetPeersResponse
-	(*StatusRequest)(nil),                // 2: proto.StatusRequest
-	(*StatusResponse)(nil),               // 3: proto.StatusResponse
-	(*GetBlockHeadersRequest)(nil),       // 4: proto.GetBlockHeadersRequest
-	(*GetBlockHeadersResponse)(nil),      // 5: proto.GetBlockHeadersResponse
-	(*GetPendingTxsRequest)(nil),         // 6: proto.GetPendingTxsRequest
-	(*GetPendingTxsResponse)(nil),        // 7: proto.GetPendingTxsResponse
-	(*GetMinedTxsRequest)(nil),           // 8: proto.GetMinedTxsRequest
-	(*GetMinedTxsResponse)(nil),          // 9: proto.GetMinedTxsResponse
-	(*GetSnapShotNodeRequest)(nil),       // 10: proto.GetSnapShotNodeRequest
-	(*GetSnapShotNodeResponse)(nil),      // 11: proto.GetSnapShotNodeResponse
-	(*GetSnapShotStateDataRequest)(nil),  // 12: proto.GetSnapShotStateDataRequest
-	(*GetSnapShotStateDataResponse)(nil), // 13: proto.GetSnapShotStateDataResponse
-	(*GetSnapShotHdrNodeRequest)(nil),    // 14: proto.GetSnapShotHdrNodeRequest
-	(*GetSnapShotHdrNodeResponse)(nil),   // 15: proto.GetSnapShotHdrNodeResponse
-	(*GossipProposalMessage)(nil),        // 16: proto.GossipProposalMessage
-	(*GossipProposalAck)(nil),            // 17: proto.GossipProposalAck
-	(*GossipPreVoteMessage)(nil),         // 18: proto.GossipPreVoteMessage
-	(*GossipPreVoteAck)(nil),             // 19: proto.GossipPreVoteAck
-	(*GossipPreVoteNilMessage)(nil),      // 20: proto.GossipPreVoteNilMessage
-	(*GossipPreVoteNilAck)(nil),          // 21: proto.GossipPreVoteNilAck
-	(*GossipPreCommitMessage)(nil),       // 22: proto.GossipPreCommitMessage
-	(*GossipPreCommitAck)(nil),           // 23: proto.GossipPreCommitAck
-	(*GossipPreCommitNilMessage)(nil),    // 24: proto.GossipPreCommitNilMessage
-	(*GossipPreCommitNilAck)(nil),        // 25: proto.GossipPreCommitNilAck
-	(*GossipNextRoundMessage)(nil),       // 26: proto.GossipNextRoundMessage
-	(*GossipNextRoundAck)(nil),           // 27: proto.GossipNextRoundAck
-	(*GossipNextHeightMessage)(nil),      // 28: proto.GossipNextHeightMessage
-	(*GossipNextHeightAck)(nil),          // 29: proto.GossipNextHeightAck
-	(*GossipBlockHeaderMessage)(nil),     // 30: proto.GossipBlockHeaderMessage
-	(*GossipBlockHeaderAck)(nil),         // 31: proto.GossipBlockHeaderAck
-	(*GossipTransactionMessage)(nil),     // 32: proto.GossipTransactionMessage
-	(*GossipTransactionAck)(nil),         // 33: proto.GossipTransactionAck
+	(*FindNodeRequest)(nil),              // 0: proto.FindNodeRequest
+	(*FindNodeResponse)(nil),             // 1: proto.FindNodeResponse
+	(*GetPeersRequest)(nil),              // 2: proto.GetPeersRequest
+	(*GetPeersResponse)(nil),             // 3: proto.GetPeersResponse
+	(*StatusRequest)(nil),                // 4: proto.StatusRequest
+	(*StatusResponse)(nil),               // 5: proto.StatusResponse
+	(*GetBlockHeadersRequest)(nil),       // 6: proto.GetBlockHeadersRequest
+	(*GetBlockHeadersResponse)(nil),      // 7: proto.GetBlockHeadersResponse
+	(*GetPendingTxsRequest)(nil),         // 8: proto.GetPendingTxsRequest
+	(*GetPendingTxsResponse)(nil),        // 9: proto.GetPendingTxsResponse
+	(*GetMinedTxsRequest)(nil),           // 10: proto.GetMinedTxsRequest
+	(*GetMinedTxsResponse)(nil),          // 11: proto.GetMinedTxsResponse
+	(*GetSnapShotNodeRequest)(nil),       // 12: proto.GetSnapShotNodeRequest
+	(*GetSnapShotNodeResponse)(nil),      // 13: proto.GetSnapShotNodeResponse
+	(*GetSnapShotStateDataRequest)(nil),  // 14: proto.GetSnapShotStateDataRequest
+	(*GetSnapShotStateDataResponse)(nil), // 15: proto.GetSnapShotStateDataResponse
+	(*GetSnapShotHdrNodeRequest)(nil),    // 16: proto.GetSnapShotHdrNodeRequest
+	(*GetSnapShotHdrNodeResponse)(nil),   // 17: proto.GetSnapShotHdrNodeResponse
+	(*GossipProposalMessage)(nil),        // 18: proto.GossipProposalMessage
+	(*GossipProposalAck)(nil),            // 19: proto.GossipProposalAck
+	(*GossipPreVoteMessage)(nil),         // 20: proto.GossipPreVoteMessage
+	(*GossipPreVoteAck)(nil),             // 21: proto.GossipPreVoteAck
+	(*GossipPreVoteNilMessage)(nil),      // 22: proto.GossipPreVoteNilMessage
+	(*GossipPreVoteNilAck)(nil),          // 23: proto.GossipPreVoteNilAck
+	(*GossipPreCommitMessage)(nil),       // 24: proto.GossipPreCommitMessage
+	(*GossipPreCommitAck)(nil),           // 25: proto.GossipPreCommitAck
+	(*GossipPreCommitNilMessage)(nil),    // 26: proto.GossipPreCommitNilMessage
+	(*GossipPreCommitNilAck)(nil),        // 27: proto.GossipPreCommitNilAck
+	(*GossipNextRoundMessage)(nil),       // 28: proto.GossipNextRoundMessage
+	(*GossipNextRoundAck)(nil),           // 29: proto.GossipNextRoundAck
+	(*GossipNextHeightMessage)(nil),      // 30: proto.GossipNextHeightMessage
+	(*GossipNextHeightAck)(nil),          // 31: proto.GossipNextHeightAck
+	(*GossipBlockHeaderMessage)(nil),     // 32: proto.GossipBlockHeaderMessage
+	(*GossipBlockHeaderAck)(nil),         // 33: proto.GossipBlockHeaderAck
+	(*GossipTransactionMessage)(nil),     // 34: proto.GossipTransactionMessage
+	(*GossipTransactionAck)(nil),         // 35: proto.GossipTransactionAck
 }
 var file_p2p_proto_depIdxs = []int32{
-	2,  // 0: proto.P2P.Status:input_type -> proto.StatusRequest
-	4,  // 1: proto.P2P.GetBlockHeaders:input_type -> proto.GetBlockHeadersRequest
-	8,  // 2: proto.P2P.GetMinedTxs:input_type -> proto.GetMinedTxsRequest
-	6,  // 3: proto.P2P.GetPendingTxs:input_type -> proto.GetPendingTxsRequest
-	10, // 4: proto.P2P.GetSnapShotNode:input_type -> proto.GetSnapShotNodeRequest
-	12, // 5: proto.P2P.GetSnapShotStateData:input_type -> proto.GetSnapShotStateDataRequest
-	14, // 6: proto.P2P.GetSnapShotHdrNode:input_type -> proto.GetSnapShotHdrNodeRequest
-	32, // 7: proto.P2P.GossipTransaction:input_type -> proto.GossipTransactionMessage
-	16, // 8: proto.P2P.GossipProposal:input_type -> proto.GossipProposalMessage
-	18, // 9: proto.P2P.GossipPreVote:input_type -> proto.GossipPreVoteMessage
-	20, // 10: proto.P2P.GossipPreVoteNil:input_type -> proto.GossipPreVoteNilMessage
-	22, // 11: proto.P2P.GossipPreCommit:input_type -> proto.GossipPreCommitMessage
-	24, // 12: proto.P2P.GossipPreCommitNil:input_type -> proto.GossipPreCommitNilMessage
-	26, // 13: proto.P2P.GossipNextRound:input_type -> proto.GossipNextRoundMessage
-	28, // 14: proto.P2P.GossipNextHeight:input_type -> proto.GossipNextHeightMessage
-	30, // 15: proto.P2P.GossipBlockHeader:input_type -> proto.GossipBlockHeaderMessage
-	0,  // 16: proto.P2P.GetPeers:input_type -> proto.GetPeersRequest
-	0,  // 17: proto.Discovery.GetPeers:input_type -> proto.GetPeersRequest
-	3,  // 18: proto.P2P.Status:output_type -> proto.StatusResponse
-	5,  // 19: proto.P2P.GetBlockHeaders:output_type -> proto.GetBlockHeadersResponse
-	9,  // 20: proto.P2P.GetMinedTxs:output_type -> proto.GetMinedTxsResponse
-	7,  // 21: proto.P2P.GetPendingTxs:output_type -> proto.GetPendingTxsResponse
-	11, // 22: proto.P2P.GetSnapShotNode:output_type -> proto.GetSnapShotNodeResponse
-	13, // 23: proto.P2P.GetSnapShotStateData:output_type -> proto.GetSnapShotStateDataResponse
-	15, // 24: proto.P2P.GetSnapShotHdrNode:output_type -> proto.GetSnapShotHdrNodeResponse
-	33, // 25: proto.P2P.GossipTransaction:output_type -> proto.GossipTransactionAck
-	17, // 26: proto.P2P.GossipProposal:output_type -> proto.GossipProposalAck
-	19, // 27: proto.P2P.GossipPreVote:output_type -> proto.GossipPreVoteAck
-	21, // 28: proto.P2P.GossipPreVoteNil:output_type -> proto.GossipPreVoteNilAck
-	23, // 29: proto.P2P.GossipPreCommit:output_type -> proto.GossipPreCommitAck
-	25, // 30: proto.P2P.GossipPreCommitNil:output_type -> proto.GossipPreCommitNilAck
-	27, // 31: proto.P2P.GossipNextRound:output_type -> proto.GossipNextRoundAck
-	29, // 32: proto.P2P.GossipNextHeight:output_type -> proto.GossipNextHeightAck
-	31, // 33: proto.P2P.GossipBlockHeader:output_type -> proto.GossipBlockHeaderAck
-	1,  // 34: proto.P2P.GetPeers:output_type -> proto.GetPeersResponse
-	1,  // 35: proto.Discovery.GetPeers:output_type -> proto.GetPeersResponse
-	18, // [18:36] is the sub-list for method output_type
-	0,  // [0:18] is the sub-list for method input_type
+	4,  // 0: proto.P2P.Status:input_type -> proto.StatusRequest
+	6,  // 1: proto.P2P.GetBlockHeaders:input_type -> proto.GetBlockHeadersRequest
+	10, // 2: proto.P2P.GetMinedTxs:input_type -> proto.GetMinedTxsRequest
+	8,  // 3: proto.P2P.GetPendingTxs:input_type -> proto.GetPendingTxsRequest
+	12, // 4: proto.P2P.GetSnapShotNode:input_type -> proto.GetSnapShotNodeRequest
+	14, // 5: proto.P2P.GetSnapShotStateData:input_type -> proto.GetSnapShotStateDataRequest
+	16, // 6: proto.P2P.GetSnapShotHdrNode:input_type -> proto.GetSnapShotHdrNodeRequest
+	34, // 7: proto.P2P.GossipTransaction:input_type -> proto.GossipTransactionMessage
+	18, // 8: proto.P2P.GossipProposal:input_type -> proto.GossipProposalMessage
+	20, // 9: proto.P2P.GossipPreVote:input_type -> proto.GossipPreVoteMessage
+	22, // 10: proto.P2P.GossipPreVoteNil:input_type -> proto.GossipPreVoteNilMessage
+	24, // 11: proto.P2P.GossipPreCommit:input_type -> proto.GossipPreCommitMessage
+	26, // 12: proto.P2P.GossipPreCommitNil:input_type -> proto.GossipPreCommitNilMessage
+	28, // 13: proto.P2P.GossipNextRound:input_type -> proto.GossipNextRoundMessage
+	30, // 14: proto.P2P.GossipNextHeight:input_type -> proto.GossipNextHeightMessage
+	32, // 15: proto.P2P.GossipBlockHeader:input_type -> proto.GossipBlockHeaderMessage
+	2,  // 16: proto.P2P.GetPeers:input_type -> proto.GetPeersRequest
+	2,  // 17: proto.Discovery.GetPeers:input_type -> proto.GetPeersRequest
+	0,  // 18: proto.DHT.FindNode:input_type -> proto.FindNodeRequest
+	5,  // 19: proto.P2P.Status:output_type -> proto.StatusResponse
+	7,  // 20: proto.P2P.GetBlockHeaders:output_type -> proto.GetBlockHeadersResponse
+	11, // 21: proto.P2P.GetMinedTxs:output_type -> proto.GetMinedTxsResponse
+	9,  // 22: proto.P2P.GetPendingTxs:output_type -> proto.GetPendingTxsResponse
+	13, // 23: proto.P2P.GetSnapShotNode:output_type -> proto.GetSnapShotNodeResponse
+	15, // 24: proto.P2P.GetSnapShotStateData:output_type -> proto.GetSnapShotStateDataResponse
+	17, // 25: proto.P2P.GetSnapShotHdrNode:output_type -> proto.GetSnapShotHdrNodeResponse
+	35, // 26: proto.P2P.GossipTransaction:output_type -> proto.GossipTransactionAck
+	19, // 27: proto.P2P.GossipProposal:output_type -> proto.GossipProposalAck
+	21, // 28: proto.P2P.GossipPreVote:output_type -> proto.GossipPreVoteAck
+	23, // 29: proto.P2P.GossipPreVoteNil:output_type -> proto.GossipPreVoteNilAck
+	25, // 30: proto.P2P.GossipPreCommit:output_type -> proto.GossipPreCommitAck
+	27, // 31: proto.P2P.GossipPreCommitNil:output_type -> proto.GossipPreCommitNilAck
+	29, // 32: proto.P2P.GossipNextRound:output_type -> proto.GossipNextRoundAck
+	31, // 33: proto.P2P.GossipNextHeight:output_type -> proto.GossipNextHeightAck
+	33, // 34: proto.P2P.GossipBlockHeader:output_type -> proto.GossipBlockHeaderAck
+	3,  // 35: proto.P2P.GetPeers:output_type -> proto.GetPeersResponse
+	3,  // 36: proto.Discovery.GetPeers:output_type -> proto.GetPeersResponse
+	1,  // 37: proto.DHT.FindNode:output_type -> proto.FindNodeResponse
+	19, // [19:38] is the sub-list for method output_type
+	0,  // [0:19] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -1838,7 +1966,7 @@ func file_p2p_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_p2p_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeersRequest); i {
+			switch v := v.(*FindNodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1850,7 +1978,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeersResponse); i {
+			switch v := v.(*FindNodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1862,7 +1990,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusRequest); i {
+			switch v := v.(*GetPeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1874,7 +2002,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+			switch v := v.(*GetPeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1886,7 +2014,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockHeadersRequest); i {
+			switch v := v.(*StatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1898,7 +2026,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockHeadersResponse); i {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1910,7 +2038,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPendingTxsRequest); i {
+			switch v := v.(*GetBlockHeadersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1922,7 +2050,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPendingTxsResponse); i {
+			switch v := v.(*GetBlockHeadersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1934,7 +2062,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinedTxsRequest); i {
+			switch v := v.(*GetPendingTxsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1946,7 +2074,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinedTxsResponse); i {
+			switch v := v.(*GetPendingTxsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1958,7 +2086,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSnapShotNodeRequest); i {
+			switch v := v.(*GetMinedTxsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1970,7 +2098,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSnapShotNodeResponse); i {
+			switch v := v.(*GetMinedTxsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1982,7 +2110,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSnapShotStateDataRequest); i {
+			switch v := v.(*GetSnapShotNodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1994,7 +2122,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSnapShotStateDataResponse); i {
+			switch v := v.(*GetSnapShotNodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2006,7 +2134,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSnapShotHdrNodeRequest); i {
+			switch v := v.(*GetSnapShotStateDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2018,7 +2146,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSnapShotHdrNodeResponse); i {
+			switch v := v.(*GetSnapShotStateDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2030,7 +2158,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipProposalMessage); i {
+			switch v := v.(*GetSnapShotHdrNodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2042,7 +2170,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipProposalAck); i {
+			switch v := v.(*GetSnapShotHdrNodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2054,7 +2182,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipPreVoteMessage); i {
+			switch v := v.(*GossipProposalMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2066,7 +2194,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipPreVoteAck); i {
+			switch v := v.(*GossipProposalAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2078,7 +2206,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipPreVoteNilMessage); i {
+			switch v := v.(*GossipPreVoteMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2090,7 +2218,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipPreVoteNilAck); i {
+			switch v := v.(*GossipPreVoteAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2102,7 +2230,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipPreCommitMessage); i {
+			switch v := v.(*GossipPreVoteNilMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2114,7 +2242,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipPreCommitAck); i {
+			switch v := v.(*GossipPreVoteNilAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2126,7 +2254,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipPreCommitNilMessage); i {
+			switch v := v.(*GossipPreCommitMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2138,7 +2266,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipPreCommitNilAck); i {
+			switch v := v.(*GossipPreCommitAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2150,7 +2278,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipNextRoundMessage); i {
+			switch v := v.(*GossipPreCommitNilMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2162,7 +2290,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipNextRoundAck); i {
+			switch v := v.(*GossipPreCommitNilAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2174,7 +2302,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipNextHeightMessage); i {
+			switch v := v.(*GossipNextRoundMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2186,7 +2314,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipNextHeightAck); i {
+			switch v := v.(*GossipNextRoundAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2198,7 +2326,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipBlockHeaderMessage); i {
+			switch v := v.(*GossipNextHeightMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2210,7 +2338,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipBlockHeaderAck); i {
+			switch v := v.(*GossipNextHeightAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2222,7 +2350,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GossipTransactionMessage); i {
+			switch v := v.(*GossipBlockHeaderMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2234,6 +2362,30 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipBlockHeaderAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipTransactionMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GossipTransactionAck); i {
 			case 0:
 				return &v.state
@@ -2252,9 +2404,9 @@ func file_p2p_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_p2p_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   36,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_p2p_proto_goTypes,
 		DependencyIndexes: file_p2p_proto_depIdxs,
@@ -2993,3 +3145,75 @@ var _Discovery_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "p2p.proto",
 }
+
+// DHTClient is the client API for DHT service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DHTClient interface {
+	FindNode(ctx context.Context, in *FindNodeRequest, opts ...grpc.CallOption) (*FindNodeResponse, error)
+}
+
+type dHTClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDHTClient(cc grpc.ClientConnInterface) DHTClient {
+	return &dHTClient{cc}
+}
+
+func (c *dHTClient) FindNode(ctx context.Context, in *FindNodeRequest, opts ...grpc.CallOption) (*FindNodeResponse, error) {
+	out := new(FindNodeResponse)
+	err := c.cc.Invoke(ctx, "/proto.DHT/FindNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DHTServer is the server API for DHT service.
+type DHTServer interface {
+	FindNode(context.Context, *FindNodeRequest) (*FindNodeResponse, error)
+}
+
+// UnimplementedDHTServer can be embedded to have forward compatible implementations.
+type UnimplementedDHTServer struct {
+}
+
+func (*UnimplementedDHTServer) FindNode(context.Context, *FindNodeRequest) (*FindNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNode not implemented")
+}
+
+func RegisterDHTServer(s *grpc.Server, srv DHTServer) {
+	s.RegisterService(&_DHT_serviceDesc, srv)
+}
+
+func _DHT_FindNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DHTServer).FindNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DHT/FindNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DHTServer).FindNode(ctx, req.(*FindNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DHT_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.DHT",
+	HandlerType: (*DHTServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindNode",
+			Handler:    _DHT_FindNode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "p2p.proto",
+}
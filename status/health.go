@@ -0,0 +1,222 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/blockchain"
+	"github.com/MadBase/MadNet/blockchain/monitor"
+	"github.com/MadBase/MadNet/consensus/admin"
+	"github.com/MadBase/MadNet/consensus/db"
+	"github.com/MadBase/MadNet/consensus/lstate"
+	"github.com/MadBase/MadNet/consensus/objs"
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/logging"
+	"github.com/MadBase/MadNet/peering"
+	"github.com/MadBase/MadNet/utils"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// HealthReport is the JSON body returned by the health and readiness
+// endpoints.
+type HealthReport struct {
+	Healthy                bool     `json:"healthy"`
+	Initialized            bool     `json:"initialized"`
+	EthereumSynchronized   bool     `json:"ethereumSynchronized"`
+	ConsensusSynchronized  bool     `json:"consensusSynchronized"`
+	SyncToHeight           uint32   `json:"syncToHeight"`
+	MaxHeightSeen          uint32   `json:"maxHeightSeen"`
+	Peers                  int      `json:"peers"`
+	PeerLimitMin           int      `json:"peerLimitMin"`
+	EthereumAccessible     bool     `json:"ethereumAccessible"`
+	EthereumEndpointInSync bool     `json:"ethereumEndpointInSync"`
+	EthereumPeers          uint32   `json:"ethereumPeers"`
+	LastBlockHeight        uint32   `json:"lastBlockHeight"`
+	SecondsSinceLastBlock  float64  `json:"secondsSinceLastBlock"`
+	Failures               []string `json:"failures"`
+}
+
+// Health serves the liveness and readiness endpoints used by orchestrators
+// to decide if a node should be restarted or should receive traffic.
+type Health struct {
+	sync.Mutex
+	ctx        context.Context
+	cancelFunc func()
+	closeOnce  sync.Once
+	log        *logrus.Logger
+	database   *db.Database
+	sstore     *lstate.Store
+	pm         *peering.PeerManager
+	ad         *admin.Handlers
+	eth        blockchain.Ethereum
+	svcs       *monitor.Services
+	lastHeight uint32
+	lastBlock  time.Time
+}
+
+// Init initializes the object
+func (h *Health) Init(database *db.Database, pm *peering.PeerManager, ad *admin.Handlers, eth blockchain.Ethereum, svcs *monitor.Services) error {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	h.ctx = ctx
+	h.cancelFunc = cancelFunc
+	h.closeOnce = sync.Once{}
+	h.log = logging.GetLogger(constants.StatusLogger)
+	h.database = database
+	h.pm = pm
+	h.ad = ad
+	h.eth = eth
+	h.svcs = svcs
+	h.lastBlock = time.Now()
+	h.sstore = &lstate.Store{}
+	if err := h.sstore.Init(database); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Start subscribes to locally committed block headers in order to track
+// the time since the last block
+func (h *Health) Start() {
+	h.database.SubscribeBroadcastBlockHeader(h.ctx, h.onBlockHeader)
+}
+
+// Close terminates the block header subscription
+func (h *Health) Close() {
+	h.closeOnce.Do(func() {
+		h.cancelFunc()
+	})
+}
+
+func (h *Health) onBlockHeader(v []byte) error {
+	bh := &objs.BlockHeader{}
+	if err := bh.UnmarshalBinary(v); err != nil {
+		utils.DebugTrace(h.log, err)
+		return nil
+	}
+	h.Lock()
+	defer h.Unlock()
+	if bh.BClaims.Height > h.lastHeight {
+		h.lastHeight = bh.BClaims.Height
+		h.lastBlock = time.Now()
+	}
+	return nil
+}
+
+// HandleHealthz reports if the node is alive. A node is considered alive
+// unless it is synchronized with Ethereum and has not committed a block
+// within constants.HealthMaxBlockAge.
+func (h *Health) HandleHealthz(w http.ResponseWriter, r *http.Request) {
+	report := h.report(false)
+	checkLive(report)
+	h.write(w, report)
+}
+
+// checkLive sets Healthy unless report shows a synchronized node that
+// stopped committing blocks
+func checkLive(report *HealthReport) {
+	report.Healthy = true
+	if report.EthereumSynchronized && report.ConsensusSynchronized &&
+		report.SecondsSinceLastBlock > constants.HealthMaxBlockAge.Seconds() {
+		report.Failures = append(report.Failures, "no block committed recently")
+		report.Healthy = false
+	}
+}
+
+// HandleReadyz reports if the node is initialized, synchronized with both
+// Ethereum and MadNet, has enough peers and has a usable Ethereum endpoint.
+func (h *Health) HandleReadyz(w http.ResponseWriter, r *http.Request) {
+	report := h.report(true)
+	checkReady(report)
+	h.write(w, report)
+}
+
+// checkReady records the readiness failures of report and sets Healthy
+func checkReady(report *HealthReport) {
+	if !report.Initialized {
+		report.Failures = append(report.Failures, "not initialized")
+	}
+	if !report.EthereumSynchronized {
+		report.Failures = append(report.Failures, "not synchronized with ethereum")
+	}
+	if !report.ConsensusSynchronized {
+		report.Failures = append(report.Failures, "not synchronized with peers")
+	}
+	if report.Peers < report.PeerLimitMin {
+		report.Failures = append(report.Failures, "insufficient peers")
+	}
+	if !report.EthereumAccessible {
+		report.Failures = append(report.Failures, "ethereum endpoint not accessible")
+	}
+	if !report.EthereumEndpointInSync {
+		report.Failures = append(report.Failures, "ethereum endpoint not in sync")
+	}
+	report.Healthy = len(report.Failures) == 0
+}
+
+// report gathers the state of all services. Ethereum is only queried if
+// checkEth is true since those calls may block until the endpoint timeout.
+func (h *Health) report(checkEth bool) *HealthReport {
+	report := &HealthReport{
+		Initialized:          h.ad.IsInitialized(),
+		EthereumSynchronized: h.ad.IsSynchronized(),
+		PeerLimitMin:         h.pm.PeerLimitMin(),
+		Failures:             []string{},
+	}
+	report.Peers, _ = h.pm.Counts()
+	err := h.database.View(func(txn *badger.Txn) error {
+		isSync, err := h.sstore.IsSync(txn)
+		if err != nil {
+			return err
+		}
+		syncToBH, err := h.sstore.GetSyncToBH(txn)
+		if err != nil {
+			return err
+		}
+		maxBH, err := h.sstore.GetMaxBH(txn)
+		if err != nil {
+			return err
+		}
+		report.ConsensusSynchronized = isSync
+		report.SyncToHeight = syncToBH.BClaims.Height
+		report.MaxHeightSeen = maxBH.BClaims.Height
+		return nil
+	})
+	if err != nil && err != badger.ErrKeyNotFound {
+		utils.DebugTrace(h.log, err)
+		report.Failures = append(report.Failures, "could not read local state")
+	}
+	h.Lock()
+	report.LastBlockHeight = h.lastHeight
+	report.SecondsSinceLastBlock = time.Since(h.lastBlock).Seconds()
+	h.Unlock()
+	if checkEth {
+		report.EthereumAccessible = h.eth.IsEthereumAccessible()
+		if report.EthereumAccessible {
+			ctx, cf := h.eth.GetTimeoutContext()
+			defer cf()
+			state := &monitor.State{}
+			if err := h.svcs.EndpointInSync(ctx, state); err != nil {
+				utils.DebugTrace(h.log, err)
+			}
+			report.EthereumEndpointInSync = state.EthereumInSync
+			report.EthereumPeers = state.PeerCount
+		}
+	}
+	return report
+}
+
+func (h *Health) write(w http.ResponseWriter, report *HealthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	if report.Healthy {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		utils.DebugTrace(h.log, err)
+	}
+}
@@ -0,0 +1,106 @@
+package status
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/sirupsen/logrus"
+)
+
+// readyReport returns the report of a node that passes every check
+func readyReport() *HealthReport {
+	return &HealthReport{
+		Initialized:            true,
+		EthereumSynchronized:   true,
+		ConsensusSynchronized:  true,
+		Peers:                  4,
+		PeerLimitMin:           4,
+		EthereumAccessible:     true,
+		EthereumEndpointInSync: true,
+		Failures:               []string{},
+	}
+}
+
+func TestHealthChecks(t *testing.T) {
+	stale := constants.HealthMaxBlockAge.Seconds() + 1
+	for _, tt := range []struct {
+		name   string
+		update func(*HealthReport)
+		live   []string
+		ready  []string
+	}{
+		{
+			name:   "ready",
+			update: func(r *HealthReport) {},
+			live:   []string{},
+			ready:  []string{},
+		},
+		{
+			name:   "peers below limit",
+			update: func(r *HealthReport) { r.Peers = r.PeerLimitMin - 1 },
+			live:   []string{},
+			ready:  []string{"insufficient peers"},
+		},
+		{
+			name:   "stale block",
+			update: func(r *HealthReport) { r.SecondsSinceLastBlock = stale },
+			live:   []string{"no block committed recently"},
+			ready:  []string{},
+		},
+		{
+			name: "not synchronized",
+			update: func(r *HealthReport) {
+				r.ConsensusSynchronized = false
+				r.SecondsSinceLastBlock = stale
+			},
+			live:  []string{},
+			ready: []string{"not synchronized with peers"},
+		},
+		{
+			name: "not synchronized with ethereum",
+			update: func(r *HealthReport) {
+				r.EthereumSynchronized = false
+				r.EthereumEndpointInSync = false
+			},
+			live:  []string{},
+			ready: []string{"not synchronized with ethereum", "ethereum endpoint not in sync"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			live := readyReport()
+			tt.update(live)
+			checkLive(live)
+			if !reflect.DeepEqual(live.Failures, tt.live) || live.Healthy != (len(tt.live) == 0) {
+				t.Fatalf("liveness %v %v", live.Healthy, live.Failures)
+			}
+			ready := readyReport()
+			tt.update(ready)
+			checkReady(ready)
+			if !reflect.DeepEqual(ready.Failures, tt.ready) || ready.Healthy != (len(tt.ready) == 0) {
+				t.Fatalf("readiness %v %v", ready.Healthy, ready.Failures)
+			}
+
+			h := &Health{log: logrus.New()}
+			w := httptest.NewRecorder()
+			h.write(w, ready)
+			want := http.StatusOK
+			if !ready.Healthy {
+				want = http.StatusServiceUnavailable
+			}
+			if w.Code != want {
+				t.Fatalf("status %d", w.Code)
+			}
+			body := &HealthReport{}
+			if err := json.NewDecoder(w.Body).Decode(body); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(body, ready) {
+				t.Fatalf("body %+v", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package transport
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/MadBase/MadNet/crypto/secp256k1"
+	"github.com/MadBase/MadNet/transport/brontide"
+)
+
+var _ brontide.Filter = (*AccessList)(nil)
+
+// AccessList is an allow and deny list of peers. Peers are listed by
+// identity, as returned by NodeAddr.Identity, or by address as an IP or a
+// CIDR range. A peer on the deny list is always refused. In whitelist only
+// mode a peer must also be on the allow list by identity or by address.
+type AccessList struct {
+	allowIdents   map[string]bool
+	denyIdents    map[string]bool
+	allowNets     []*net.IPNet
+	denyNets      []*net.IPNet
+	whitelistOnly bool
+}
+
+// NewAccessList parses the comma separated whitelist and blacklist into an
+// AccessList.
+func NewAccessList(whitelist string, blacklist string, whitelistOnly bool) (*AccessList, error) {
+	al := &AccessList{
+		allowIdents:   make(map[string]bool),
+		denyIdents:    make(map[string]bool),
+		whitelistOnly: whitelistOnly,
+	}
+	allowNets, err := parseAccessList(whitelist, al.allowIdents)
+	if err != nil {
+		return nil, err
+	}
+	denyNets, err := parseAccessList(blacklist, al.denyIdents)
+	if err != nil {
+		return nil, err
+	}
+	al.allowNets = allowNets
+	al.denyNets = denyNets
+	return al, nil
+}
+
+// parseAccessList adds the identities in list to idents and returns the
+// networks in list.
+func parseAccessList(list string, idents map[string]bool) ([]*net.IPNet, error) {
+	nets := []*net.IPNet{}
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if len(entry) == compressedPublicKeyHexStringLength {
+			pubkeybytes, err := hex.DecodeString(entry)
+			if err == nil {
+				pubkey, err := secp256k1.ParsePubKey(pubkeybytes, secp256k1.S256())
+				if err != nil {
+					return nil, fmt.Errorf("%w: %s", ErrInvalidAccessListEntry, entry)
+				}
+				idents[pubkeyToIdent(pubkey)] = true
+				continue
+			}
+		}
+		if strings.Contains(entry, "/") {
+			_, ipnet, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidAccessListEntry, entry)
+			}
+			nets = append(nets, ipnet)
+			continue
+		}
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidAccessListEntry, entry)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			bits = 8 * net.IPv4len
+		}
+		nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return nets, nil
+}
+
+// containsIP returns true if ip is in one of nets.
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, ipnet := range nets {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceptHost returns false if the host at ip is denied. Hosts that are not
+// on the allow list are still accepted in whitelist only mode, since the
+// identity of the peer may be.
+func (al *AccessList) AcceptHost(ip net.IP) bool {
+	return !containsIP(al.denyNets, ip)
+}
+
+// AcceptPeer returns true if the peer with identity pubk at ip may connect.
+func (al *AccessList) AcceptPeer(ip net.IP, pubk *secp256k1.PublicKey) bool {
+	ident := pubkeyToIdent(pubk)
+	if al.denyIdents[ident] || containsIP(al.denyNets, ip) {
+		return false
+	}
+	if !al.whitelistOnly {
+		return true
+	}
+	return al.allowIdents[ident] || containsIP(al.allowNets, ip)
+}
@@ -0,0 +1,192 @@
+package transport
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/crypto/secp256k1"
+	"github.com/MadBase/MadNet/transport/brontide"
+	"github.com/sirupsen/logrus"
+)
+
+func TestAccessListParse(t *testing.T) {
+	privk, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ident := pubkeyToIdent(publicKeyFromPrivateKey(privk))
+
+	if _, err := NewAccessList(ident+", 10.0.0.0/8 ,127.0.0.1,::1,", "", true); err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range []string{"10.0.0.0/33", "localhost", ident[:64], "02" + ident[2:64] + "zz"} {
+		if _, err := NewAccessList(entry, "", false); !errors.Is(err, ErrInvalidAccessListEntry) {
+			t.Fatalf("entry %q: got %v", entry, err)
+		}
+	}
+}
+
+func TestAccessListAcceptPeer(t *testing.T) {
+	privk1, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubk1 := publicKeyFromPrivateKey(privk1)
+	privk2, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubk2 := publicKeyFromPrivateKey(privk2)
+	inside := net.ParseIP("10.1.2.3")
+	outside := net.ParseIP("192.168.0.1")
+
+	al, err := NewAccessList("10.0.0.0/8,"+pubkeyToIdent(pubk1), "10.1.2.3", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if al.AcceptHost(inside) || al.AcceptPeer(inside, pubk1) {
+		t.Fatal("denied host accepted")
+	}
+	if !al.AcceptHost(outside) || !al.AcceptPeer(outside, pubk2) {
+		t.Fatal("peer refused without whitelist only mode")
+	}
+
+	al, err = NewAccessList("10.0.0.0/8,"+pubkeyToIdent(pubk1), pubkeyToIdent(pubk2), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !al.AcceptPeer(outside, pubk1) {
+		t.Fatal("allowed identity refused")
+	}
+	if !al.AcceptPeer(net.ParseIP("10.9.9.9"), pubk1) {
+		t.Fatal("allowed range refused")
+	}
+	if al.AcceptPeer(net.ParseIP("10.9.9.9"), pubk2) {
+		t.Fatal("denied identity accepted")
+	}
+	if !al.AcceptHost(outside) {
+		t.Fatal("host refused before its identity is known")
+	}
+	privk3, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if al.AcceptPeer(outside, publicKeyFromPrivateKey(privk3)) {
+		t.Fatal("unlisted peer accepted in whitelist only mode")
+	}
+}
+
+// dialAccessList dials a brontide listener on loopback filtered by al with
+// dialerPrivk and returns true if the listener accepted the connection.
+func dialAccessList(t *testing.T, al *AccessList, dialerPrivk *secp256k1.PrivateKey) bool {
+	listenerPrivk, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, err := brontide.NewListener(listenerPrivk, "127.0.0.1", 0, versionOffer(), testCID, 16, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	listener.SetFilter(al)
+
+	accepted := make(chan *brontide.Conn, 1)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err == brontide.ErrBrontideClose {
+				return
+			}
+			if err == nil {
+				accepted <- conn
+				return
+			}
+		}
+	}()
+
+	netAddr := &brontide.NetAddress{
+		IdentityKey: publicKeyFromPrivateKey(listenerPrivk),
+		Address:     listener.Addr(),
+	}
+	conn, err := brontide.Dial(dialerPrivk, 1, versionOffer(), testCID, 0, netAddr, net.Dial)
+	if err == nil {
+		defer conn.Close()
+	}
+	select {
+	case conn := <-accepted:
+		conn.Close()
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
+
+func TestAccessListListener(t *testing.T) {
+	dialerPrivk, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ident := pubkeyToIdent(publicKeyFromPrivateKey(dialerPrivk))
+
+	for _, test := range []struct {
+		whitelist     string
+		blacklist     string
+		whitelistOnly bool
+		accept        bool
+	}{
+		{"", "", false, true},
+		{"", "", true, false},
+		{"", "127.0.0.0/8", false, false},
+		{"", ident, false, false},
+		{ident, "", true, true},
+		{"127.0.0.1", "", true, true},
+		{"10.0.0.0/8", "", true, false},
+		{ident, "127.0.0.1", true, false},
+	} {
+		al, err := NewAccessList(test.whitelist, test.blacklist, test.whitelistOnly)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if accepted := dialAccessList(t, al, dialerPrivk); accepted != test.accept {
+			t.Fatalf("whitelist %q blacklist %q whitelist only %v: accepted %v", test.whitelist, test.blacklist, test.whitelistOnly, accepted)
+		}
+	}
+}
+
+func TestAccessListDial(t *testing.T) {
+	privk, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	remotePrivk, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote := &NodeAddr{
+		host:     "127.0.0.1",
+		port:     1,
+		chainID:  testCID,
+		identity: publicKeyFromPrivateKey(remotePrivk),
+	}
+	al, err := NewAccessList("", remote.Identity(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pt := &P2PTransport{
+		logger:          logrus.New(),
+		localPrivateKey: privk,
+		localNodeAddr: &NodeAddr{
+			host:     "127.0.0.1",
+			port:     2,
+			chainID:  testCID,
+			identity: publicKeyFromPrivateKey(privk),
+		},
+		accessList: al,
+		closeChan:  make(chan struct{}),
+	}
+	if _, err := pt.Dial(remote, 1); err != ErrPeerNotAllowed {
+		t.Fatalf("dialed a denied peer: %v", err)
+	}
+}
@@ -30,9 +30,10 @@ type Conn struct {
 
 	readBuf bytes.Buffer
 
-	P2PPort  int
-	Protocol types.Protocol
-	Version  types.ProtoVersion
+	P2PPort      int
+	Protocol     types.Protocol
+	Version      types.ProtoVersion
+	Capabilities types.Capabilities
 
 	closeFn   func() error
 	closeChan chan struct{}
@@ -64,7 +65,7 @@ func (c *Conn) CloseChan() <-chan struct{} {
 // remote peer located at address which has remotePub as its long-term static
 // public key. In the case of a handshake failure, the connection is closed and
 // a non-nil error is returned.
-func Dial(localPriv *secp256k1.PrivateKey, protocol types.Protocol, protoVersion types.ProtoVersion, chainID types.ChainIdentifier, port int, netAddr *NetAddress, dialer func(string, string) (net.Conn, error)) (*Conn, error) {
+func Dial(localPriv *secp256k1.PrivateKey, protocol types.Protocol, versions VersionOffer, chainID types.ChainIdentifier, port int, netAddr *NetAddress, dialer func(string, string) (net.Conn, error)) (*Conn, error) {
 	ipAddr := netAddr.Address.String()
 	var conn net.Conn
 	var err error
@@ -150,7 +151,7 @@ func Dial(localPriv *secp256k1.PrivateKey, protocol types.Protocol, protoVersion
 		b.conn.Close()
 		return nil, err
 	}
-	remoteVersion, err := selfInitiatedVersionHandshake(b, protoVersion)
+	version, capabilities, err := selfInitiatedVersionHandshake(b, versions)
 	if err != nil {
 		b.conn.Close()
 		return nil, err
@@ -170,7 +171,8 @@ func Dial(localPriv *secp256k1.PrivateKey, protocol types.Protocol, protoVersion
 	}
 
 	b.P2PPort = remoteP2PPort
-	b.Version = types.ProtoVersion(remoteVersion)
+	b.Version = version
+	b.Capabilities = capabilities
 	b.Protocol = protocol
 
 	return b, nil
@@ -0,0 +1,15 @@
+package brontide
+
+import (
+	"net"
+
+	"github.com/MadBase/MadNet/crypto/secp256k1"
+)
+
+// Filter decides which remote peers a Listener accepts. AcceptHost is
+// checked as soon as a connection arrives and AcceptPeer once the handshake
+// has authenticated the identity of the peer.
+type Filter interface {
+	AcceptHost(ip net.IP) bool
+	AcceptPeer(ip net.IP, pubk *secp256k1.PublicKey) bool
+}
@@ -55,15 +55,18 @@ type Listener struct {
 	originLimit            int
 	pubkeyLimit            int
 
+	// access control
+	filter Filter
+
 	// handshaking
-	chainID      types.ChainIdentifier
-	port         int
-	protoVersion types.ProtoVersion
+	chainID  types.ChainIdentifier
+	port     int
+	versions VersionOffer
 }
 
 // NewListener returns a new net.Listener which enforces the Brontide scheme
 // during both initial connection establishment and data transfer.
-func NewListener(localStatic *secp256k1.PrivateKey, host string, port int, protoVersion types.ProtoVersion, chainID types.ChainIdentifier, totalLimit int, pubkeyLimit int, originLimit int) (*Listener, error) {
+func NewListener(localStatic *secp256k1.PrivateKey, host string, port int, versions VersionOffer, chainID types.ChainIdentifier, totalLimit int, pubkeyLimit int, originLimit int) (*Listener, error) {
 	listenAddr := net.JoinHostPort(host, strconv.Itoa(port))
 
 	addr, err := net.ResolveTCPAddr("tcp", listenAddr)
@@ -88,7 +91,7 @@ func NewListener(localStatic *secp256k1.PrivateKey, host string, port int, proto
 		originLimit:            originLimit,
 		pubkeyLimit:            pubkeyLimit,
 		port:                   port,
-		protoVersion:           protoVersion,
+		versions:               versions,
 		chainID:                chainID,
 	}
 
@@ -97,6 +100,14 @@ func NewListener(localStatic *secp256k1.PrivateKey, host string, port int, proto
 	return brontideListener, nil
 }
 
+// SetFilter sets the filter remote peers must pass to be accepted. A nil
+// filter accepts every peer.
+func (l *Listener) SetFilter(filter Filter) {
+	l.Lock()
+	defer l.Unlock()
+	l.filter = filter
+}
+
 // rejectedConnErr is a helper function that prepends the remote address of the
 // failed connection attempt to the original error message.
 func rejectedConnErr(err error, remoteAddr string) error {
@@ -143,6 +154,15 @@ func (l *Listener) preHandshake(conn net.Conn) {
 		return
 	}
 
+	// guard logic for denied hosts
+	if l.filter != nil && !l.filter.AcceptHost(addr.IP) {
+		err := conn.Close()
+		if err != nil {
+			utils.DebugTrace(l.logger, err)
+		}
+		return
+	}
+
 	// guard logic for total connections
 	if len(l.numConnectionsbyIP) >= l.totalLimit {
 		err := conn.Close()
@@ -188,6 +208,21 @@ func (l *Listener) postHandshake(conn *Conn) {
 	l.Lock()
 	defer l.Unlock()
 
+	// guard logic for denied peers
+	if l.filter != nil {
+		var ip net.IP
+		if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+			ip = addr.IP
+		}
+		if !l.filter.AcceptPeer(ip, conn.RemotePub()) {
+			err := conn.Close()
+			if err != nil {
+				utils.DebugTrace(l.logger, err)
+			}
+			return
+		}
+	}
+
 	// get pubkey for limit pubkey tracking
 	pubk := string(conn.RemotePub().SerializeCompressed())
 
@@ -410,7 +445,7 @@ func (l *Listener) doHandshake(conn net.Conn) {
 		l.rejectConn(rejectedConnErr(err, remoteAddr))
 		return
 	}
-	remoteVersion, err := peerInitiatedVersionHandshake(brontideConn, l.protoVersion)
+	version, capabilities, err := peerInitiatedVersionHandshake(brontideConn, l.versions)
 	if err != nil {
 		utils.DebugTrace(l.logger, err)
 		err2 := brontideConn.Close()
@@ -459,7 +494,8 @@ func (l *Listener) doHandshake(conn net.Conn) {
 	}
 
 	brontideConn.P2PPort = remoteP2PPort
-	brontideConn.Version = types.ProtoVersion(remoteVersion)
+	brontideConn.Version = version
+	brontideConn.Capabilities = capabilities
 	brontideConn.Protocol = types.Protocol(protocol)
 
 	go l.postHandshake(brontideConn)
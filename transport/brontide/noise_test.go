@@ -19,9 +19,12 @@ const (
 	testChainID      types.ChainIdentifier = 1
 	testProtocol     types.Protocol        = 2
 	testProtoVer     types.ProtoVersion    = 3
+	testCapabilities types.Capabilities    = 5
 	testPortListener int                   = 9000
 )
 
+var testVersions = VersionOffer{Min: testProtoVer, Max: testProtoVer, Capabilities: testCapabilities}
+
 type maybeNetConn struct {
 	conn net.Conn
 	err  error
@@ -37,7 +40,7 @@ func makeListener() (*Listener, *NetAddress, error) {
 	addr := "localhost"
 
 	// Our listener will be local, and the connection remote.
-	listener, err := NewListener(localPriv, addr, testPortListener, testProtoVer, testChainID, 50, 1, 50)
+	listener, err := NewListener(localPriv, addr, testPortListener, testVersions, testChainID, 50, 1, 50)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -68,7 +71,7 @@ func establishTestConnection(t *testing.T) (net.Conn, net.Conn, func(), error) {
 		if err != nil {
 			t.Error(err)
 		}
-		remoteConn, err := Dial(remotePriv, testProtocol, testProtoVer, testChainID, 9001, netAddr, net.Dial)
+		remoteConn, err := Dial(remotePriv, testProtocol, testVersions, testChainID, 9001, netAddr, net.Dial)
 		remoteConnChan <- maybeNetConn{remoteConn, err}
 	}()
 
@@ -111,6 +114,12 @@ func TestConnectionCorrectness(t *testing.T) {
 		t.Fatal("Got a nil conn")
 	}
 	defer cleanUp()
+	for _, conn := range []net.Conn{localConn, remoteConn} {
+		bconn := conn.(*Conn)
+		if bconn.Version != testProtoVer || bconn.Capabilities != testCapabilities {
+			t.Fatalf("negotiated version %v capabilities %v", bconn.Version, bconn.Capabilities)
+		}
+	}
 
 	// Test out some message full-message reads.
 	for i := 0; i < 10; i++ {
@@ -202,7 +211,7 @@ func TestConcurrentHandshakes(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unable to generate private key: %v", err)
 		}
-		remoteConn, err := Dial(remotePriv, testProtocol, testProtoVer, testChainID, 9001, netAddr, net.Dial)
+		remoteConn, err := Dial(remotePriv, testProtocol, testVersions, testChainID, 9001, netAddr, net.Dial)
 		if err != nil {
 			t.Errorf("Error in concurrent dial: %v", err)
 		}
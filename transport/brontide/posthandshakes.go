@@ -1,6 +1,7 @@
 package brontide
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -13,6 +14,10 @@ import (
 // when remoteID and localID fail to agree.
 var ErrWrongChainID = errors.New("remote peer sent wrong chain identifier")
 
+// ErrNoCommonVersion occurs in (peer|self)InitiatedVersionHandshake
+// when the version ranges of the peers do not overlap.
+var ErrNoCommonVersion = errors.New("no protocol version in common with remote peer")
+
 // Verify that both peers are working on the same chain by
 // having them cross compare their chain identifiers.
 // This step MUST be done after an authenticated encrypted channel
@@ -70,26 +75,121 @@ func peerInitiatedPortHandshake(conn net.Conn, port int) (int, error) {
 	return int(remotePort), nil
 }
 
-func selfInitiatedVersionHandshake(conn net.Conn, version types.ProtoVersion) (uint32, error) {
-	if err := writeUint32(conn, uint32(version)); err != nil {
-		return 0, err
+// VersionOffer is the range of protocol versions and the capabilities a
+// node offers in the post handshake. The connection speaks the highest
+// version in both ranges and has the capabilities both nodes offer, so new
+// versions can be rolled out while nodes still speak the old ones.
+type VersionOffer struct {
+	Min          types.ProtoVersion
+	Max          types.ProtoVersion
+	Capabilities types.Capabilities
+}
+
+// versionOfferLen is the length of a marshalled VersionOffer
+const versionOfferLen = 16
+
+// extendedOfferFlag is set in the legacy version frame by nodes that
+// exchange a VersionOffer after it. Nodes that predate version offers only
+// send their version in that frame and never set the flag, so the offer is
+// skipped with them and the connection speaks their version without any
+// capabilities.
+const extendedOfferFlag uint32 = 1 << 31
+
+func (vo VersionOffer) marshal() []byte {
+	buf := make([]byte, versionOfferLen)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(vo.Min))
+	binary.BigEndian.PutUint32(buf[4:8], uint32(vo.Max))
+	binary.BigEndian.PutUint64(buf[8:16], uint64(vo.Capabilities))
+	return buf
+}
+
+func unmarshalVersionOffer(buf []byte) VersionOffer {
+	return VersionOffer{
+		Min:          types.ProtoVersion(binary.BigEndian.Uint32(buf[0:4])),
+		Max:          types.ProtoVersion(binary.BigEndian.Uint32(buf[4:8])),
+		Capabilities: types.Capabilities(binary.BigEndian.Uint64(buf[8:16])),
 	}
-	remoteversion, err := readUint32(conn)
+}
+
+// negotiate returns the highest version in both offers and the
+// capabilities both offers include.
+func (vo VersionOffer) negotiate(remote VersionOffer) (types.ProtoVersion, types.Capabilities, error) {
+	min := vo.Min
+	if remote.Min > min {
+		min = remote.Min
+	}
+	max := vo.Max
+	if remote.Max < max {
+		max = remote.Max
+	}
+	if max < min {
+		return 0, 0, fmt.Errorf("%s: local %v-%v, remote %v-%v", ErrNoCommonVersion, vo.Min, vo.Max, remote.Min, remote.Max)
+	}
+	return max, vo.Capabilities & remote.Capabilities, nil
+}
+
+// legacyOffer is the offer of a node that only sent its version
+func legacyOffer(frame uint32) VersionOffer {
+	v := types.ProtoVersion(frame)
+	return VersionOffer{Min: v, Max: v}
+}
+
+func selfInitiatedVersionHandshake(conn net.Conn, offer VersionOffer) (types.ProtoVersion, types.Capabilities, error) {
+	if err := writeUint32(conn, uint32(offer.Max)|extendedOfferFlag); err != nil {
+		return 0, 0, err
+	}
+	frame, err := readUint32(conn)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
+	}
+	if frame&extendedOfferFlag == 0 {
+		return offer.negotiate(legacyOffer(frame))
+	}
+	if _, err := conn.Write(offer.marshal()); err != nil {
+		return 0, 0, err
+	}
+	buf := make([]byte, versionOfferLen)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return 0, 0, err
 	}
-	return remoteversion, nil
+	return offer.negotiate(unmarshalVersionOffer(buf))
 }
 
-func peerInitiatedVersionHandshake(conn net.Conn, version types.ProtoVersion) (uint32, error) {
-	remoteversion, err := readUint32(conn)
+func peerInitiatedVersionHandshake(conn net.Conn, offer VersionOffer) (types.ProtoVersion, types.Capabilities, error) {
+	frame, err := readUint32(conn)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
-	if err := writeUint32(conn, uint32(version)); err != nil {
-		return 0, err
+	if frame&extendedOfferFlag == 0 {
+		// the legacy frame of an old node is answered with the version
+		// it speaks
+		remote := legacyOffer(frame)
+		if err := writeUint32(conn, uint32(legacyVersion(offer, remote))); err != nil {
+			return 0, 0, err
+		}
+		return offer.negotiate(remote)
+	}
+	if err := writeUint32(conn, uint32(offer.Max)|extendedOfferFlag); err != nil {
+		return 0, 0, err
+	}
+	buf := make([]byte, versionOfferLen)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return 0, 0, err
 	}
-	return remoteversion, nil
+	if _, err := conn.Write(offer.marshal()); err != nil {
+		return 0, 0, err
+	}
+	return offer.negotiate(unmarshalVersionOffer(buf))
+}
+
+// legacyVersion returns the version sent back to an old node. It is the
+// version of the old node if the local node speaks it and the lowest local
+// version otherwise.
+func legacyVersion(offer, remote VersionOffer) types.ProtoVersion {
+	if remote.Max >= offer.Min && remote.Max <= offer.Max {
+		return remote.Max
+	}
+	return offer.Min
 }
 
 func writeUint32(conn net.Conn, local uint32) error {
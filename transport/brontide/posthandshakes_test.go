@@ -0,0 +1,125 @@
+package brontide
+
+import (
+	"net"
+	"testing"
+
+	"github.com/MadBase/MadNet/types"
+)
+
+func TestVersionNegotiation(t *testing.T) {
+	tests := []struct {
+		name    string
+		local   VersionOffer
+		remote  VersionOffer
+		version types.ProtoVersion
+		caps    types.Capabilities
+		err     bool
+	}{
+		{"same", VersionOffer{1, 1, 3}, VersionOffer{1, 1, 3}, 1, 3, false},
+		{"highest common", VersionOffer{1, 3, 0}, VersionOffer{2, 5, 0}, 3, 0, false},
+		{"old peer", VersionOffer{1, 2, 0}, VersionOffer{1, 1, 0}, 1, 0, false},
+		{"common capabilities", VersionOffer{1, 1, 5}, VersionOffer{1, 1, 6}, 1, 4, false},
+		{"disjoint", VersionOffer{3, 4, 0}, VersionOffer{1, 2, 0}, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			self, peer := net.Pipe()
+			defer self.Close()
+			defer peer.Close()
+			type result struct {
+				version types.ProtoVersion
+				caps    types.Capabilities
+				err     error
+			}
+			peerResult := make(chan result, 1)
+			go func() {
+				v, c, err := peerInitiatedVersionHandshake(peer, tt.remote)
+				peerResult <- result{v, c, err}
+			}()
+			v, c, err := selfInitiatedVersionHandshake(self, tt.local)
+			pr := <-peerResult
+			if tt.err {
+				if err == nil || pr.err == nil {
+					t.Fatal("negotiated without a common version")
+				}
+				return
+			}
+			if err != nil || pr.err != nil {
+				t.Fatal(err, pr.err)
+			}
+			if v != tt.version || c != tt.caps {
+				t.Fatalf("got version %v capabilities %v", v, c)
+			}
+			if pr.version != v || pr.caps != c {
+				t.Fatal("peers disagree on the negotiated version")
+			}
+		})
+	}
+}
+
+// legacyVersionHandshake is the version exchange of nodes that predate
+// version offers
+func legacyVersionHandshake(conn net.Conn, initiator bool, version uint32) (uint32, error) {
+	if initiator {
+		if err := writeUint32(conn, version); err != nil {
+			return 0, err
+		}
+		return readUint32(conn)
+	}
+	remote, err := readUint32(conn)
+	if err != nil {
+		return 0, err
+	}
+	return remote, writeUint32(conn, version)
+}
+
+func TestVersionNegotiationLegacyPeer(t *testing.T) {
+	offer := VersionOffer{1, 2, 3}
+	for _, initiator := range []bool{true, false} {
+		self, peer := net.Pipe()
+		legacyResult := make(chan error, 1)
+		var remote uint32
+		go func() {
+			var err error
+			remote, err = legacyVersionHandshake(peer, !initiator, 1)
+			legacyResult <- err
+		}()
+		var v types.ProtoVersion
+		var c types.Capabilities
+		var err error
+		if initiator {
+			v, c, err = selfInitiatedVersionHandshake(self, offer)
+		} else {
+			v, c, err = peerInitiatedVersionHandshake(self, offer)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := <-legacyResult; err != nil {
+			t.Fatal(err)
+		}
+		if v != 1 || c != 0 {
+			t.Fatalf("initiator %v: got version %v capabilities %v", initiator, v, c)
+		}
+		// a dialed old node only gets the legacy frame and a dialing old
+		// node gets back its own version
+		if initiator && remote != uint32(offer.Max)|extendedOfferFlag {
+			t.Fatalf("dialed old node got %x", remote)
+		}
+		if !initiator && remote != 1 {
+			t.Fatalf("dialing old node got %x", remote)
+		}
+		self.Close()
+		peer.Close()
+	}
+
+	// an old node speaking no common version is refused
+	self, peer := net.Pipe()
+	defer self.Close()
+	defer peer.Close()
+	go legacyVersionHandshake(peer, true, 1)
+	if _, _, err := peerInitiatedVersionHandshake(self, VersionOffer{2, 3, 0}); err == nil {
+		t.Fatal("negotiated without a common version")
+	}
+}
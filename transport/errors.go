@@ -28,4 +28,32 @@ var (
 	// ErrInvalidPrivKey occurs when private key bytes is strictly less than
 	// 16 bytes in length; this is an invalid private key.
 	ErrInvalidPrivKey = errors.New("invalid private key hex string")
+
+	// ErrInvalidAccessListEntry occurs in NewAccessList when an entry is
+	// neither a node identity, an IP address nor a CIDR range.
+	ErrInvalidAccessListEntry = errors.New("invalid access list entry")
+
+	// ErrPeerNotAllowed occurs in Dial when the access list of the
+	// transport refuses the remote peer.
+	ErrPeerNotAllowed = errors.New("peer not allowed by access list")
+
+	// ErrConnClosed occurs in P2PConn Read and Write when the connection
+	// closes while waiting for the bandwidth limits.
+	ErrConnClosed = errors.New("connection closed")
+
+	// ErrInvalidNodeRecord occurs in UnmarshalNodeRecord when a node record
+	// is malformed or its signature was not made by the node it names.
+	ErrInvalidNodeRecord = errors.New("invalid node record")
+
+	// ErrNodeRecordVersion occurs in UnmarshalNodeRecord when a node record
+	// is for a protocol version older than the local node speaks.
+	ErrNodeRecordVersion = errors.New("node record for unsupported protocol version")
+
+	// ErrUnresolvedNodeRecord occurs in NodeRecord.Resolve when a record
+	// without a public host is received from a node other than its owner.
+	ErrUnresolvedNodeRecord = errors.New("node record has no public host")
+
+	// ErrNodeUnreachable occurs in MemoryTransport Dial when the address is
+	// not attached to the network, is partitioned away or the dial is lost.
+	ErrNodeUnreachable = errors.New("node unreachable")
 )
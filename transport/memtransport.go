@@ -0,0 +1,493 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/crypto/secp256k1"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
+	"github.com/sirupsen/logrus"
+)
+
+var _ interfaces.P2PTransport = (*MemoryTransport)(nil)
+var _ net.Conn = (*memConn)(nil)
+
+const (
+	// memHost is the host of every memory transport
+	memHost string = "127.0.0.1"
+	// memChunkBacklog is how many writes may be in flight on a link in each
+	// direction before writes block
+	memChunkBacklog int = 256
+	// memAcceptBacklog is how many dialed connections may wait for Accept
+	memAcceptBacklog int = 64
+)
+
+// LinkConfig describes the links between the transports of a MemoryNetwork.
+// The zero value is an instant, unlimited and lossless link.
+type LinkConfig struct {
+	// Latency delays every dial and every write by this long
+	Latency time.Duration
+	// Bandwidth is the rate in bytes per second of each direction of a
+	// link. A rate that is not positive is unlimited.
+	Bandwidth int
+	// DropRate is the probability that a dial or a write is lost. A
+	// lost dial fails as if the node was unreachable and a lost write
+	// resets the connection.
+	DropRate float64
+}
+
+// MemoryNetwork connects MemoryTransports in process without any sockets.
+// It allows multi node tests to run fast and without depending on the
+// ports of the host. The links between the transports may be slowed down,
+// made lossy and partitioned. The drops are drawn from a random source
+// seeded at creation, so a test with a DropRate of zero or one behaves the
+// same on every run.
+type MemoryNetwork struct {
+	sync.Mutex
+	cid        types.ChainIdentifier
+	link       LinkConfig
+	rand       *rand.Rand
+	nextPort   int
+	transports map[string]*MemoryTransport
+	partitions map[[2]string]bool
+	links      map[*memLink]struct{}
+}
+
+// NewMemoryNetwork returns a network for chain cid whose drops are drawn
+// from a random source seeded with seed.
+func NewMemoryNetwork(cid types.ChainIdentifier, seed int64) *MemoryNetwork {
+	return &MemoryNetwork{
+		cid:        cid,
+		rand:       rand.New(rand.NewSource(seed)),
+		nextPort:   1,
+		transports: make(map[string]*MemoryTransport),
+		partitions: make(map[[2]string]bool),
+		links:      make(map[*memLink]struct{}),
+	}
+}
+
+// SetLink sets the configuration of the links. Connections that are
+// already open keep the configuration they were opened with.
+func (mn *MemoryNetwork) SetLink(link LinkConfig) {
+	mn.Lock()
+	defer mn.Unlock()
+	mn.link = link
+}
+
+// NewTransport returns a transport with a new identity attached to the
+// network.
+func (mn *MemoryNetwork) NewTransport(logger *logrus.Logger) (*MemoryTransport, error) {
+	privk, err := newTransportPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	mn.Lock()
+	defer mn.Unlock()
+	addr := &NodeAddr{
+		host:     memHost,
+		port:     mn.nextPort,
+		identity: publicKeyFromPrivateKey(privk),
+		chainID:  mn.cid,
+	}
+	mn.nextPort++
+	record, err := newLocalNodeRecord(logger, privk, addr)
+	if err != nil {
+		return nil, err
+	}
+	mt := &MemoryTransport{
+		logger:          logger,
+		network:         mn,
+		localNodeAddr:   addr,
+		localPrivateKey: privk,
+		nodeRecord:      record,
+		acceptChan:      make(chan *P2PConn, memAcceptBacklog),
+		closeChan:       make(chan struct{}),
+	}
+	mn.transports[addr.Identity()] = mt
+	return mt, nil
+}
+
+// Partition cuts the link between the nodes at a and b. Open connections
+// between them are closed and dials between them fail until the link is
+// healed.
+func (mn *MemoryNetwork) Partition(a, b interfaces.NodeAddr) {
+	mn.Lock()
+	defer mn.Unlock()
+	key := partitionKey(a.Identity(), b.Identity())
+	mn.partitions[key] = true
+	for l := range mn.links {
+		if partitionKey(l.a, l.b) == key {
+			l.shut()
+			delete(mn.links, l)
+		}
+	}
+}
+
+// Heal restores the link between the nodes at a and b.
+func (mn *MemoryNetwork) Heal(a, b interfaces.NodeAddr) {
+	mn.Lock()
+	defer mn.Unlock()
+	delete(mn.partitions, partitionKey(a.Identity(), b.Identity()))
+}
+
+// HealAll restores every link of the network.
+func (mn *MemoryNetwork) HealAll() {
+	mn.Lock()
+	defer mn.Unlock()
+	mn.partitions = make(map[[2]string]bool)
+}
+
+func partitionKey(a, b string) [2]string {
+	if a > b {
+		return [2]string{b, a}
+	}
+	return [2]string{a, b}
+}
+
+// drop returns true if a dial or write is lost
+func (mn *MemoryNetwork) drop(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	mn.Lock()
+	defer mn.Unlock()
+	return mn.rand.Float64() < rate
+}
+
+// connect opens a link from the transport from to the node at addr
+func (mn *MemoryNetwork) connect(from *MemoryTransport, addr interfaces.NodeAddr) (*MemoryTransport, *memLink, error) {
+	mn.Lock()
+	defer mn.Unlock()
+	to, ok := mn.transports[addr.Identity()]
+	if !ok || addr.ChainID() != mn.cid || addr.Port() != to.localNodeAddr.port {
+		return nil, nil, ErrNodeUnreachable
+	}
+	if mn.partitions[partitionKey(from.localNodeAddr.Identity(), addr.Identity())] {
+		return nil, nil, ErrNodeUnreachable
+	}
+	l := &memLink{
+		network:   mn,
+		config:    mn.link,
+		a:         from.localNodeAddr.Identity(),
+		b:         addr.Identity(),
+		closeChan: make(chan struct{}),
+	}
+	mn.links[l] = struct{}{}
+	return to, l, nil
+}
+
+// MemoryTransport is a P2PTransport whose connections are in memory pipes
+// to the other transports of its MemoryNetwork. Every connection uses the
+// highest protocol version and all local capabilities.
+type MemoryTransport struct {
+	logger          *logrus.Logger
+	network         *MemoryNetwork
+	localNodeAddr   *NodeAddr
+	localPrivateKey *secp256k1.PrivateKey
+	nodeRecord      *localNodeRecord
+	acceptChan      chan *P2PConn
+	closeChan       chan struct{}
+	closeOnce       sync.Once
+}
+
+// NodeAddr returns the address of the transport.
+func (mt *MemoryTransport) NodeAddr() interfaces.NodeAddr {
+	return mt.localNodeAddr
+}
+
+// NodeRecord returns the signed record of the transport.
+func (mt *MemoryTransport) NodeRecord() []byte {
+	return mt.nodeRecord.Marshal()
+}
+
+// WaitInbound does not wait since the bandwidth of the network is limited
+// per link.
+func (mt *MemoryTransport) WaitInbound(ctx context.Context, n int, consensus bool) error {
+	return nil
+}
+
+// PrivateKey returns the hex encoded private key of the transport so tests
+// can sign node records for it.
+func (mt *MemoryTransport) PrivateKey() string {
+	return serializeTransportPrivateKey(mt.localPrivateKey)
+}
+
+// Close detaches the transport from the network and causes Accept to
+// return an error. Open connections stay open.
+func (mt *MemoryTransport) Close() error {
+	mt.closeOnce.Do(func() {
+		mt.network.Lock()
+		delete(mt.network.transports, mt.localNodeAddr.Identity())
+		mt.network.Unlock()
+		close(mt.closeChan)
+	})
+	return nil
+}
+
+// Accept returns the connections other transports dial to this one.
+func (mt *MemoryTransport) Accept() (interfaces.P2PConn, error) {
+	select {
+	case <-mt.closeChan:
+		return nil, ErrListenerClosed
+	case conn := <-mt.acceptChan:
+		return conn, nil
+	}
+}
+
+// Dial connects to the transport at addr with the given protocol. The
+// dial takes one round trip of the link latency as the handshake of the
+// real transport would.
+func (mt *MemoryTransport) Dial(addr interfaces.NodeAddr, protocol types.Protocol) (interfaces.P2PConn, error) {
+	select {
+	case <-mt.closeChan:
+		return nil, ErrListenerClosed
+	default:
+	}
+	remote, l, err := mt.network.connect(mt, addr)
+	if err != nil {
+		return nil, err
+	}
+	if mt.network.drop(l.config.DropRate) {
+		l.close()
+		return nil, ErrNodeUnreachable
+	}
+	select {
+	case <-time.After(2 * l.config.Latency):
+	case <-l.closeChan:
+		return nil, ErrNodeUnreachable
+	}
+	toRemote := make(chan memChunk, memChunkBacklog)
+	toLocal := make(chan memChunk, memChunkBacklog)
+	local := mt.newConn(l, &memConn{link: l, in: toLocal, out: toRemote}, remote.localNodeAddr, protocol, types.SelfInitiatedConnection)
+	accepted := remote.newConn(l, &memConn{link: l, in: toRemote, out: toLocal}, mt.localNodeAddr, protocol, types.PeerInitiatedConnection)
+	select {
+	case remote.acceptChan <- accepted:
+		return local, nil
+	case <-remote.closeChan:
+	case <-l.closeChan:
+	}
+	l.close()
+	return nil, ErrNodeUnreachable
+}
+
+// newConn wraps one end of a link into a P2PConn
+func (mt *MemoryTransport) newConn(l *memLink, mc *memConn, remote *NodeAddr, protocol types.Protocol, initiator types.P2PInitiator) *P2PConn {
+	mc.local = mt.localNodeAddr
+	mc.remote = remote
+	return &P2PConn{
+		Conn:         mc,
+		logger:       mt.logger,
+		protocol:     protocol,
+		protoVersion: maxProtoVersion,
+		capabilities: localCapabilities,
+		initiator:    initiator,
+		nodeAddr: &NodeAddr{
+			host:     remote.host,
+			port:     remote.port,
+			identity: remote.identity,
+			chainID:  remote.chainID,
+		},
+		cleanupfn: func() {},
+		closeChan: l.closeChan,
+	}
+}
+
+// memLink is the state shared by the two ends of a memory connection
+type memLink struct {
+	network   *MemoryNetwork
+	config    LinkConfig
+	a         string
+	b         string
+	closeChan chan struct{}
+	closeOnce sync.Once
+}
+
+// close closes both ends of the link and forgets it
+func (l *memLink) close() {
+	l.shut()
+	l.network.Lock()
+	defer l.network.Unlock()
+	delete(l.network.links, l)
+}
+
+// shut closes both ends of the link
+func (l *memLink) shut() {
+	l.closeOnce.Do(func() {
+		close(l.closeChan)
+	})
+}
+
+// memChunk is a write in flight that may be read from at
+type memChunk struct {
+	data []byte
+	at   time.Time
+}
+
+// memConn is one end of a memory connection. Writes wait for the link
+// bandwidth and are delivered to the reader after the link latency. Data
+// that arrived before the connection closed is still read while data in
+// flight is lost. A deadline applies to the reads and writes that start
+// after it is set.
+type memConn struct {
+	link          *memLink
+	local         net.Addr
+	remote        net.Addr
+	in            <-chan memChunk
+	out           chan<- memChunk
+	pending       memChunk
+	readMu        sync.Mutex
+	writeMu       sync.Mutex
+	busyUntil     time.Time
+	deadlineMu    sync.Mutex
+	readDeadline  time.Time
+	writeDeadline time.Time
+}
+
+// Read reads the data delivered by the link
+func (mc *memConn) Read(b []byte) (int, error) {
+	mc.readMu.Lock()
+	defer mc.readMu.Unlock()
+	readDeadline, _ := mc.getDeadlines()
+	deadline, stop := deadlineTimer(readDeadline)
+	defer stop()
+	if len(mc.pending.data) == 0 {
+		select {
+		case mc.pending = <-mc.in:
+		default:
+			select {
+			case mc.pending = <-mc.in:
+			case <-mc.link.closeChan:
+				select {
+				case mc.pending = <-mc.in:
+				default:
+					return 0, io.EOF
+				}
+			case <-deadline:
+				return 0, os.ErrDeadlineExceeded
+			}
+		}
+	}
+	if wait := time.Until(mc.pending.at); wait > 0 {
+		arrival := time.NewTimer(wait)
+		defer arrival.Stop()
+		select {
+		case <-arrival.C:
+		case <-mc.link.closeChan:
+			mc.pending = memChunk{}
+			return 0, io.EOF
+		case <-deadline:
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+	n := copy(b, mc.pending.data)
+	mc.pending.data = mc.pending.data[n:]
+	return n, nil
+}
+
+// Write sends b over the link. It blocks while b is transmitted at the
+// bandwidth of the link.
+func (mc *memConn) Write(b []byte) (int, error) {
+	mc.writeMu.Lock()
+	defer mc.writeMu.Unlock()
+	select {
+	case <-mc.link.closeChan:
+		return 0, ErrConnClosed
+	default:
+	}
+	if mc.link.network.drop(mc.link.config.DropRate) {
+		mc.link.close()
+		return 0, ErrConnClosed
+	}
+	_, writeDeadline := mc.getDeadlines()
+	deadline, stop := deadlineTimer(writeDeadline)
+	defer stop()
+	now := time.Now()
+	if mc.busyUntil.Before(now) {
+		mc.busyUntil = now
+	}
+	if mc.link.config.Bandwidth > 0 {
+		mc.busyUntil = mc.busyUntil.Add(time.Duration(float64(len(b)) / float64(mc.link.config.Bandwidth) * float64(time.Second)))
+		select {
+		case <-time.After(time.Until(mc.busyUntil)):
+		case <-mc.link.closeChan:
+			return 0, ErrConnClosed
+		case <-deadline:
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+	chunk := memChunk{
+		data: append([]byte(nil), b...),
+		at:   mc.busyUntil.Add(mc.link.config.Latency),
+	}
+	select {
+	case mc.out <- chunk:
+		return len(b), nil
+	case <-mc.link.closeChan:
+		return 0, ErrConnClosed
+	case <-deadline:
+		return 0, os.ErrDeadlineExceeded
+	}
+}
+
+// deadlineTimer returns a channel that fires at deadline and a function to
+// release the timer. A zero deadline never fires.
+func deadlineTimer(deadline time.Time) (<-chan time.Time, func()) {
+	if deadline.IsZero() {
+		return nil, func() {}
+	}
+	t := time.NewTimer(time.Until(deadline))
+	return t.C, func() { t.Stop() }
+}
+
+func (mc *memConn) getDeadlines() (time.Time, time.Time) {
+	mc.deadlineMu.Lock()
+	defer mc.deadlineMu.Unlock()
+	return mc.readDeadline, mc.writeDeadline
+}
+
+// Close closes both ends of the connection
+func (mc *memConn) Close() error {
+	mc.link.close()
+	return nil
+}
+
+// LocalAddr See docs for net.Conn
+func (mc *memConn) LocalAddr() net.Addr {
+	return mc.local
+}
+
+// RemoteAddr See docs for net.Conn
+func (mc *memConn) RemoteAddr() net.Addr {
+	return mc.remote
+}
+
+// SetDeadline See docs for net.Conn
+func (mc *memConn) SetDeadline(t time.Time) error {
+	mc.deadlineMu.Lock()
+	defer mc.deadlineMu.Unlock()
+	mc.readDeadline = t
+	mc.writeDeadline = t
+	return nil
+}
+
+// SetReadDeadline See docs for net.Conn
+func (mc *memConn) SetReadDeadline(t time.Time) error {
+	mc.deadlineMu.Lock()
+	defer mc.deadlineMu.Unlock()
+	mc.readDeadline = t
+	return nil
+}
+
+// SetWriteDeadline See docs for net.Conn
+func (mc *memConn) SetWriteDeadline(t time.Time) error {
+	mc.deadlineMu.Lock()
+	defer mc.deadlineMu.Unlock()
+	mc.writeDeadline = t
+	return nil
+}
@@ -0,0 +1,224 @@
+package transport
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
+	"github.com/sirupsen/logrus"
+)
+
+func newTestMemoryTransports(t *testing.T, mn *MemoryNetwork, n int) []*MemoryTransport {
+	t.Helper()
+	tps := []*MemoryTransport{}
+	for i := 0; i < n; i++ {
+		mt, err := mn.NewTransport(logrus.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		tps = append(tps, mt)
+	}
+	return tps
+}
+
+// dialMemory dials b from a and returns both ends of the connection
+func dialMemory(t *testing.T, a, b *MemoryTransport) (interfaces.P2PConn, interfaces.P2PConn) {
+	t.Helper()
+	conn, err := a.Dial(b.NodeAddr(), types.P2PProtocol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accepted, err := b.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, accepted
+}
+
+func TestMemoryTransportDial(t *testing.T) {
+	mn := NewMemoryNetwork(testCID, 1)
+	tps := newTestMemoryTransports(t, mn, 2)
+	conn, accepted := dialMemory(t, tps[0], tps[1])
+
+	if conn.Initiator() != types.SelfInitiatedConnection || accepted.Initiator() != types.PeerInitiatedConnection {
+		t.Fatal("wrong initiators")
+	}
+	if conn.NodeAddr().P2PAddr() != tps[1].NodeAddr().P2PAddr() {
+		t.Fatalf("dialer sees %s", conn.NodeAddr().P2PAddr())
+	}
+	if accepted.NodeAddr().P2PAddr() != tps[0].NodeAddr().P2PAddr() {
+		t.Fatalf("acceptor sees %s", accepted.NodeAddr().P2PAddr())
+	}
+	if conn.Protocol() != types.P2PProtocol || conn.ProtoVersion() != maxProtoVersion || conn.Capabilities() != localCapabilities {
+		t.Fatal("wrong negotiated parameters")
+	}
+	rec, err := UnmarshalNodeRecord(tps[0].NodeRecord())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.NodeAddr().P2PAddr() != tps[0].NodeAddr().P2PAddr() {
+		t.Fatal("record does not hold the transport address")
+	}
+
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(accepted, buf); err != nil || string(buf) != "hel" {
+		t.Fatalf("read %q: %v", buf, err)
+	}
+	if err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	<-accepted.CloseChan()
+	// data in flight is delivered after the close
+	buf = make([]byte, 8)
+	n, err := accepted.Read(buf)
+	if err != nil || string(buf[:n]) != "lo" {
+		t.Fatalf("read %q: %v", buf[:n], err)
+	}
+	if _, err := accepted.Read(buf); err != io.EOF {
+		t.Fatalf("read after close: %v", err)
+	}
+	if conn.BytesOut() != 5 || accepted.BytesIn() != 5 {
+		t.Fatalf("counted %d out and %d in", conn.BytesOut(), accepted.BytesIn())
+	}
+
+	if _, err := tps[0].Dial(&NodeAddr{host: memHost, port: 99, identity: tps[1].localNodeAddr.identity, chainID: testCID}, types.P2PProtocol); err != ErrNodeUnreachable {
+		t.Fatalf("dialed the wrong port: %v", err)
+	}
+	if err := tps[1].Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tps[1].Accept(); err != ErrListenerClosed {
+		t.Fatalf("accepted on closed transport: %v", err)
+	}
+	if _, err := tps[0].Dial(tps[1].NodeAddr(), types.P2PProtocol); err != ErrNodeUnreachable {
+		t.Fatalf("dialed closed transport: %v", err)
+	}
+}
+
+func TestMemoryTransportLink(t *testing.T) {
+	mn := NewMemoryNetwork(testCID, 1)
+	mn.SetLink(LinkConfig{Latency: 50 * time.Millisecond, Bandwidth: 10000})
+	tps := newTestMemoryTransports(t, mn, 2)
+
+	start := time.Now()
+	conn, accepted := dialMemory(t, tps[0], tps[1])
+	if d := time.Since(start); d < 100*time.Millisecond {
+		t.Fatalf("dial took %v", d)
+	}
+
+	start = time.Now()
+	if _, err := conn.Write(make([]byte, 1000)); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < 100*time.Millisecond {
+		t.Fatalf("write took %v", d)
+	}
+	if _, err := io.ReadFull(accepted, make([]byte, 1000)); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < 150*time.Millisecond {
+		t.Fatalf("delivery took %v", d)
+	}
+
+	if err := accepted.SetReadDeadline(time.Now().Add(10 * time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := accepted.Read(make([]byte, 1)); err == nil {
+		t.Fatal("read past the deadline")
+	}
+
+	// the deadline and the close interrupt a read waiting for data in flight
+	if _, err := conn.Write([]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	start = time.Now()
+	if err := accepted.SetReadDeadline(time.Now().Add(10 * time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := accepted.Read(make([]byte, 1)); err == nil {
+		t.Fatal("read past the deadline")
+	}
+	if err := accepted.SetReadDeadline(time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		conn.Close()
+	}()
+	if _, err := accepted.Read(make([]byte, 1)); err != io.EOF {
+		t.Fatalf("read after close: %v", err)
+	}
+	if d := time.Since(start); d >= 50*time.Millisecond {
+		t.Fatalf("interrupted reads took %v", d)
+	}
+}
+
+func TestMemoryTransportDrop(t *testing.T) {
+	mn := NewMemoryNetwork(testCID, 1)
+	tps := newTestMemoryTransports(t, mn, 2)
+	conn, _ := dialMemory(t, tps[0], tps[1])
+
+	mn.SetLink(LinkConfig{DropRate: 1})
+	if _, err := tps[0].Dial(tps[1].NodeAddr(), types.P2PProtocol); err != ErrNodeUnreachable {
+		t.Fatalf("lossy dial: %v", err)
+	}
+	// open connections keep their link
+	if _, err := conn.Write([]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a lossy connection is reset by a lost write
+	mn.SetLink(LinkConfig{DropRate: 0.5})
+	var lossy interfaces.P2PConn
+	for i := 0; lossy == nil; i++ {
+		if i == 100 {
+			t.Fatal("every dial was lost")
+		}
+		if c, err := tps[0].Dial(tps[1].NodeAddr(), types.P2PProtocol); err == nil {
+			lossy = c
+		}
+	}
+	for i := 0; ; i++ {
+		if i == 100 {
+			t.Fatal("no write was lost")
+		}
+		if _, err := lossy.Write([]byte{1}); err != nil {
+			if err != ErrConnClosed {
+				t.Fatal(err)
+			}
+			break
+		}
+	}
+	<-lossy.CloseChan()
+}
+
+func TestMemoryTransportPartition(t *testing.T) {
+	mn := NewMemoryNetwork(testCID, 1)
+	tps := newTestMemoryTransports(t, mn, 3)
+	conn01, _ := dialMemory(t, tps[0], tps[1])
+	conn02, _ := dialMemory(t, tps[0], tps[2])
+
+	mn.Partition(tps[1].NodeAddr(), tps[0].NodeAddr())
+	select {
+	case <-conn01.CloseChan():
+	default:
+		t.Fatal("partitioned connection is open")
+	}
+	select {
+	case <-conn02.CloseChan():
+		t.Fatal("connection outside the partition closed")
+	default:
+	}
+	if _, err := tps[0].Dial(tps[1].NodeAddr(), types.P2PProtocol); err != ErrNodeUnreachable {
+		t.Fatalf("dialed across partition: %v", err)
+	}
+	dialMemory(t, tps[1], tps[2])
+
+	mn.Heal(tps[0].NodeAddr(), tps[1].NodeAddr())
+	dialMemory(t, tps[1], tps[0])
+}
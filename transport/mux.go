@@ -104,6 +104,7 @@ func (pmx *P2PMux) serverMux(ctx context.Context, conn interfaces.P2PConn) (inte
 			nodeAddr:     conn.NodeAddr(),
 			protocol:     conn.Protocol(),
 			protoVersion: conn.ProtoVersion(),
+			capabilities: conn.Capabilities(),
 			initiator:    conn.Initiator(),
 			session:      session,
 			closeChan:    conn.CloseChan(),
@@ -115,6 +116,7 @@ func (pmx *P2PMux) serverMux(ctx context.Context, conn interfaces.P2PConn) (inte
 			nodeAddr:     conn.NodeAddr(),
 			protocol:     conn.Protocol(),
 			protoVersion: conn.ProtoVersion(),
+			capabilities: conn.Capabilities(),
 			initiator:    conn.Initiator(),
 			session:      session,
 			closeChan:    conn.CloseChan(),
@@ -180,6 +182,7 @@ func (pmx *P2PMux) clientMux(ctx context.Context, conn interfaces.P2PConn) (inte
 			initiator:    conn.Initiator(),
 			logger:       mlog,
 			protoVersion: conn.ProtoVersion(),
+			capabilities: conn.Capabilities(),
 			nodeAddr:     conn.NodeAddr(),
 			session:      session,
 			closeChan:    conn.CloseChan(),
@@ -188,6 +191,7 @@ func (pmx *P2PMux) clientMux(ctx context.Context, conn interfaces.P2PConn) (inte
 		serverp2pconn := &P2PConn{
 			Conn:         serverConn,
 			protoVersion: conn.ProtoVersion(),
+			capabilities: conn.Capabilities(),
 			logger:       mlog,
 			initiator:    conn.Initiator(),
 			nodeAddr:     conn.NodeAddr(),
@@ -0,0 +1,226 @@
+package transport
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/crypto"
+	"github.com/MadBase/MadNet/crypto/secp256k1"
+	"github.com/MadBase/MadNet/interfaces"
+	"github.com/MadBase/MadNet/types"
+	"github.com/MadBase/MadNet/utils"
+	eth "github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+// nodeRecordHeaderLen is the length of the signature, sequence number and
+// protocol version that precede the address in a marshalled node record.
+const nodeRecordHeaderLen = constants.CurveSecp256k1SigLen + 8 + 4
+
+// NodeRecord is the address of a node signed with the transport key of that
+// node. Discovery hands out node records instead of bare addresses so a
+// peer can not forge the address of another node. The sequence number of a
+// record is the time it was signed in nanoseconds since the Unix epoch, so
+// older records of the node can be told apart and dropped and records that
+// have not been signed again for too long expire.
+//
+// The marshalled form is
+// <signature><sequence number><protocol version><p2p address>
+// where the signature covers everything after it.
+type NodeRecord struct {
+	addr         *NodeAddr
+	seq          uint64
+	protoVersion types.ProtoVersion
+	raw          []byte
+}
+
+// newNodeRecord signs a record for addr with privk
+func newNodeRecord(privk *secp256k1.PrivateKey, addr *NodeAddr, seq uint64, version types.ProtoVersion) (*NodeRecord, error) {
+	body := nodeRecordBody(addr, seq, version)
+	signer := &crypto.Secp256k1Signer{}
+	if err := signer.SetPrivk(utils.ForceSliceToLength(privk.Serialize(), 32)); err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(body)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeRecord{
+		addr:         addr,
+		seq:          seq,
+		protoVersion: version,
+		raw:          append(sig, body...),
+	}, nil
+}
+
+func nodeRecordBody(addr *NodeAddr, seq uint64, version types.ProtoVersion) []byte {
+	body := make([]byte, 12, 12+len(addr.P2PAddr()))
+	binary.BigEndian.PutUint64(body[0:8], seq)
+	binary.BigEndian.PutUint32(body[8:12], uint32(version))
+	return append(body, []byte(addr.P2PAddr())...)
+}
+
+// UnmarshalNodeRecord parses a marshalled node record and verifies that it
+// was signed by the node it names. The record holds the highest protocol
+// version of the node and is rejected if that version is older than every
+// version the local node speaks.
+func UnmarshalNodeRecord(data []byte) (*NodeRecord, error) {
+	if len(data) <= nodeRecordHeaderLen {
+		return nil, ErrInvalidNodeRecord
+	}
+	sig := data[:constants.CurveSecp256k1SigLen]
+	body := data[constants.CurveSecp256k1SigLen:]
+	na, err := NewNodeAddr(string(body[12:]))
+	if err != nil {
+		return nil, ErrInvalidNodeRecord
+	}
+	addr := na.(*NodeAddr)
+	validator := &crypto.Secp256k1Validator{}
+	signer, err := validator.Validate(body, sig)
+	if err != nil {
+		return nil, ErrInvalidNodeRecord
+	}
+	if !bytes.Equal(signer, eth.FromECDSAPub((*ecdsa.PublicKey)(addr.identity))) {
+		return nil, ErrInvalidNodeRecord
+	}
+	version := types.ProtoVersion(binary.BigEndian.Uint32(body[8:12]))
+	if version < minProtoVersion {
+		return nil, ErrNodeRecordVersion
+	}
+	return &NodeRecord{
+		addr:         addr,
+		seq:          binary.BigEndian.Uint64(body[0:8]),
+		protoVersion: version,
+		raw:          utils.CopySlice(data),
+	}, nil
+}
+
+// Marshal returns the signed record
+func (nr *NodeRecord) Marshal() []byte {
+	return utils.CopySlice(nr.raw)
+}
+
+// NodeAddr returns the address in the record
+func (nr *NodeRecord) NodeAddr() interfaces.NodeAddr {
+	return nr.addr
+}
+
+// Seq returns the sequence number of the record
+func (nr *NodeRecord) Seq() uint64 {
+	return nr.seq
+}
+
+// SignedAt returns the time the record was signed
+func (nr *NodeRecord) SignedAt() time.Time {
+	return time.Unix(0, int64(nr.seq))
+}
+
+// ProtoVersion returns the highest protocol version of the node
+func (nr *NodeRecord) ProtoVersion() types.ProtoVersion {
+	return nr.protoVersion
+}
+
+// Public returns true if the record holds a host other nodes can dial.
+// A node listening on every interface without an advertised host signs
+// an unspecified host.
+func (nr *NodeRecord) Public() bool {
+	if nr.addr.host == "" {
+		return false
+	}
+	ip := net.ParseIP(nr.addr.host)
+	return ip == nil || !ip.IsUnspecified()
+}
+
+// Resolve returns the address to dial for a record received from the peer
+// at from. A record without a public host is only usable when it comes
+// from its owner, in which case the host the owner was reached at is used.
+func (nr *NodeRecord) Resolve(from interfaces.NodeAddr) (interfaces.NodeAddr, error) {
+	if nr.Public() {
+		return nr.addr, nil
+	}
+	if from == nil || from.Identity() != nr.addr.Identity() {
+		return nil, ErrUnresolvedNodeRecord
+	}
+	return &NodeAddr{
+		host:     from.Host(),
+		port:     nr.addr.port,
+		identity: nr.addr.identity,
+		chainID:  nr.addr.chainID,
+	}, nil
+}
+
+// localNodeRecord is the record of the local node. It is signed again once
+// it is older than constants.NodeRecordRefresh so that peers never have to
+// drop the record of a running node for its age.
+type localNodeRecord struct {
+	sync.Mutex
+	logger *logrus.Logger
+	privk  *secp256k1.PrivateKey
+	addr   *NodeAddr
+	record *NodeRecord
+	clock  utils.Clock
+}
+
+func newLocalNodeRecord(logger *logrus.Logger, privk *secp256k1.PrivateKey, addr *NodeAddr) (*localNodeRecord, error) {
+	lr := &localNodeRecord{
+		logger: logger,
+		privk:  privk,
+		addr:   addr,
+	}
+	if err := lr.sign(); err != nil {
+		return nil, err
+	}
+	return lr, nil
+}
+
+// sign signs a new record. The caller must hold the lock.
+func (lr *localNodeRecord) sign() error {
+	seq := uint64(lr.clock.Now().UnixNano())
+	if lr.record != nil && seq <= lr.record.seq {
+		seq = lr.record.seq + 1
+	}
+	record, err := newNodeRecord(lr.privk, lr.addr, seq, maxProtoVersion)
+	if err != nil {
+		return err
+	}
+	lr.record = record
+	return nil
+}
+
+// Marshal returns the current record, signing a new one if it is due
+func (lr *localNodeRecord) Marshal() []byte {
+	lr.Lock()
+	defer lr.Unlock()
+	if lr.clock.Now().Sub(lr.record.SignedAt()) >= constants.NodeRecordRefresh {
+		if err := lr.sign(); err != nil {
+			utils.DebugTrace(lr.logger, err)
+		}
+	}
+	return lr.record.Marshal()
+}
+
+// SignNodeRecord returns the marshalled record of addr with sequence number
+// seq signed with the hex encoded transport key of the node at addr.
+func SignNodeRecord(privateKeyHex string, addr interfaces.NodeAddr, seq uint64) ([]byte, error) {
+	privk, err := deserializeTransportPrivateKey(privateKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	if pubkeyToIdent(publicKeyFromPrivateKey(privk)) != addr.Identity() {
+		return nil, ErrInvalidNodeRecord
+	}
+	na, err := NewNodeAddr(addr.P2PAddr())
+	if err != nil {
+		return nil, err
+	}
+	rec, err := newNodeRecord(privk, na.(*NodeAddr), seq, maxProtoVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rec.Marshal(), nil
+}
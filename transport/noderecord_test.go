@@ -0,0 +1,146 @@
+package transport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/sirupsen/logrus"
+)
+
+func TestNodeRecord(t *testing.T) {
+	tkey, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := &NodeAddr{
+		host:     "10.0.0.1",
+		port:     4242,
+		identity: publicKeyFromPrivateKey(tkey),
+		chainID:  42,
+	}
+	data, err := SignNodeRecord(serializeTransportPrivateKey(tkey), addr, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, err := UnmarshalNodeRecord(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.NodeAddr().P2PAddr() != addr.P2PAddr() || rec.Seq() != 7 || rec.ProtoVersion() != maxProtoVersion {
+		t.Fatal("record does not round trip")
+	}
+	resolved, err := rec.Resolve(nil)
+	if err != nil || resolved.P2PAddr() != addr.P2PAddr() {
+		t.Fatal("public record not resolved")
+	}
+
+	// any change to the record breaks the signature
+	for _, i := range []int{0, nodeRecordHeaderLen - 1, len(data) - 1} {
+		tampered := append([]byte{}, data...)
+		tampered[i] ^= 1
+		if _, err := UnmarshalNodeRecord(tampered); err == nil {
+			t.Fatalf("tampered byte %d accepted", i)
+		}
+	}
+	if _, err := UnmarshalNodeRecord(data[:nodeRecordHeaderLen]); err != ErrInvalidNodeRecord {
+		t.Fatal("short record accepted")
+	}
+
+	// a node can not sign the record of another node
+	other, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SignNodeRecord(serializeTransportPrivateKey(other), addr, 7); err != ErrInvalidNodeRecord {
+		t.Fatal("signed the record of another node")
+	}
+	forged, err := newNodeRecord(other, addr, 8, maxProtoVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := UnmarshalNodeRecord(forged.Marshal()); err != ErrInvalidNodeRecord {
+		t.Fatal("forged record accepted")
+	}
+
+	stale, err := newNodeRecord(tkey, addr, 8, minProtoVersion-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := UnmarshalNodeRecord(stale.Marshal()); err != ErrNodeRecordVersion {
+		t.Fatal("record for another protocol version accepted")
+	}
+}
+
+func TestLocalNodeRecord(t *testing.T) {
+	tkey, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := &NodeAddr{
+		host:     "10.0.0.1",
+		port:     4242,
+		identity: publicKeyFromPrivateKey(tkey),
+		chainID:  42,
+	}
+	lr, err := newLocalNodeRecord(logrus.New(), tkey, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	lr.clock = func() time.Time { return now }
+	first, err := UnmarshalNodeRecord(lr.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if now.Sub(first.SignedAt()) > time.Minute {
+		t.Fatalf("record signed at %v", first.SignedAt())
+	}
+	// the record is signed again once it is due
+	now = now.Add(constants.NodeRecordRefresh)
+	second, err := UnmarshalNodeRecord(lr.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Seq() <= first.Seq() || !second.SignedAt().Equal(now) {
+		t.Fatalf("record was not signed again: %d after %d", second.Seq(), first.Seq())
+	}
+	if third := lr.Marshal(); string(third) != string(second.Marshal()) {
+		t.Fatal("record signed again before it was due")
+	}
+}
+
+func TestNodeRecordResolve(t *testing.T) {
+	tkey, err := newTransportPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := &NodeAddr{
+		host:     "0.0.0.0",
+		port:     4242,
+		identity: publicKeyFromPrivateKey(tkey),
+		chainID:  42,
+	}
+	rec, err := newNodeRecord(tkey, addr, 1, maxProtoVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Public() {
+		t.Fatal("unspecified host is public")
+	}
+	owner := &NodeAddr{host: "10.0.0.1", port: 5000, identity: addr.identity, chainID: 42}
+	resolved, err := rec.Resolve(owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Host() != "10.0.0.1" || resolved.Port() != 4242 {
+		t.Fatalf("resolved to %s", resolved.String())
+	}
+	other, err := RandomNodeAddr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rec.Resolve(other); err != ErrUnresolvedNodeRecord {
+		t.Fatal("record without a public host resolved for a third party")
+	}
+}
@@ -3,6 +3,7 @@ package transport
 import (
 	"net"
 	"sync"
+	"sync/atomic"
 
 	"github.com/MadBase/MadNet/interfaces"
 	"github.com/MadBase/MadNet/types"
@@ -23,12 +24,54 @@ type P2PConn struct {
 	logger       *logrus.Logger
 	protocol     types.Protocol
 	protoVersion types.ProtoVersion
+	capabilities types.Capabilities
 	initiator    types.P2PInitiator
 	nodeAddr     interfaces.NodeAddr
 	closeOnce    sync.Once
 	closeChan    <-chan struct{}
 	cleanupfn    func()
 	session      *yamux.Session
+	inLimits     []limiter
+	outLimits    []limiter
+	bytesIn      uint64
+	bytesOut     uint64
+}
+
+// Read reads from the connection and then waits until the bytes read fit
+// within the inbound bandwidth limits. Waiting stops reading from the socket
+// which in turn slows down the remote peer.
+func (pc *P2PConn) Read(b []byte) (int, error) {
+	n, err := pc.Conn.Read(b)
+	atomic.AddUint64(&pc.bytesIn, uint64(n))
+	for _, rl := range pc.inLimits {
+		if err := rl.wait(n, pc.closeChan); err != nil {
+			return n, err
+		}
+	}
+	return n, err
+}
+
+// Write waits until b fits within the outbound bandwidth limits and then
+// writes it to the connection.
+func (pc *P2PConn) Write(b []byte) (int, error) {
+	for _, rl := range pc.outLimits {
+		if err := rl.wait(len(b), pc.closeChan); err != nil {
+			return 0, err
+		}
+	}
+	n, err := pc.Conn.Write(b)
+	atomic.AddUint64(&pc.bytesOut, uint64(n))
+	return n, err
+}
+
+// BytesIn returns the number of bytes read from the connection
+func (pc *P2PConn) BytesIn() uint64 {
+	return atomic.LoadUint64(&pc.bytesIn)
+}
+
+// BytesOut returns the number of bytes written to the connection
+func (pc *P2PConn) BytesOut() uint64 {
+	return atomic.LoadUint64(&pc.bytesOut)
 }
 
 // CloseChan closes channel
@@ -87,8 +130,14 @@ func (pc *P2PConn) Protocol() types.Protocol {
 	return pc.protocol
 }
 
-// ProtoVersion returns the protocol version being used.
-// This is not used at this time.
+// ProtoVersion returns the protocol version negotiated with the remote
+// peer in the post handshake.
 func (pc *P2PConn) ProtoVersion() types.ProtoVersion {
 	return pc.protoVersion
 }
+
+// Capabilities returns the optional protocol features both the local node
+// and the remote peer support.
+func (pc *P2PConn) Capabilities() types.Capabilities {
+	return pc.capabilities
+}
@@ -70,3 +70,15 @@ func (pmc *P2PMuxConn) Initiator() types.P2PInitiator {
 func (pmc *P2PMuxConn) NodeAddr() interfaces.NodeAddr {
 	return pmc.nodeAddr
 }
+
+// BytesIn returns the number of bytes read from the underlying connection,
+// including the overhead of the multiplexing protocol
+func (pmc *P2PMuxConn) BytesIn() uint64 {
+	return pmc.baseConn.BytesIn()
+}
+
+// BytesOut returns the number of bytes written to the underlying connection,
+// including the overhead of the multiplexing protocol
+func (pmc *P2PMuxConn) BytesOut() uint64 {
+	return pmc.baseConn.BytesOut()
+}
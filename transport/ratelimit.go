@@ -0,0 +1,240 @@
+package transport
+
+import (
+	"sync"
+	"time"
+
+	"github.com/MadBase/MadNet/types"
+	"github.com/MadBase/MadNet/utils"
+)
+
+// rateLimiter is a token bucket that refills at rate bytes per second and
+// holds up to one second of tokens. A nil rateLimiter does not limit.
+type rateLimiter struct {
+	sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+	clock  utils.Clock
+}
+
+// newRateLimiter returns a rateLimiter for rate bytes per second or nil if
+// rate is not positive.
+func newRateLimiter(rate int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		rate:   float64(rate),
+		tokens: float64(rate),
+		last:   time.Now(),
+	}
+}
+
+// take refills the bucket and takes n tokens from it, going into debt if
+// there are not enough. The caller must hold the lock.
+func (rl *rateLimiter) take(n int) {
+	now := rl.clock.Now()
+	rl.tokens += now.Sub(rl.last).Seconds() * rl.rate
+	if rl.tokens > rl.rate {
+		rl.tokens = rl.rate
+	}
+	rl.last = now
+	rl.tokens -= float64(n)
+}
+
+// reserve takes n tokens from the bucket and returns how long the caller
+// must wait for the debt to be repaid.
+func (rl *rateLimiter) reserve(n int) time.Duration {
+	rl.Lock()
+	defer rl.Unlock()
+	rl.take(n)
+	if rl.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-rl.tokens / rl.rate * float64(time.Second))
+}
+
+// wait blocks until n bytes may pass the limiter or closeChan is closed.
+func (rl *rateLimiter) wait(n int, closeChan <-chan struct{}) error {
+	if rl == nil || n <= 0 {
+		return nil
+	}
+	return waitFor(rl.reserve(n), closeChan)
+}
+
+// sharedLimiter is a rateLimiter shared by every connection of the node. A
+// connection does not pay for the debt of the others: a reservation waits
+// for the debt of the bucket at most as long as its own bytes take at the
+// fair share of the rate of each connection, and the debt is capped at one
+// second of tokens. Priority reservations are charged to the bucket too, so
+// the other reservations make room for them, but they only wait for the
+// debt of earlier priority reservations. A nil sharedLimiter does not limit.
+type sharedLimiter struct {
+	rateLimiter
+	conns    int
+	priority rateLimiter
+}
+
+// newSharedLimiter returns a sharedLimiter for rate bytes per second or nil
+// if rate is not positive.
+func newSharedLimiter(rate int) *sharedLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &sharedLimiter{
+		rateLimiter: rateLimiter{
+			rate:   float64(rate),
+			tokens: float64(rate),
+			last:   time.Now(),
+		},
+		priority: rateLimiter{
+			rate:   float64(rate),
+			tokens: float64(rate),
+			last:   time.Now(),
+		},
+	}
+}
+
+// join counts a connection towards the shares until closeChan is closed.
+func (sl *sharedLimiter) join(closeChan <-chan struct{}) {
+	if sl == nil {
+		return
+	}
+	sl.Lock()
+	sl.conns++
+	sl.Unlock()
+	go func() {
+		<-closeChan
+		sl.Lock()
+		sl.conns--
+		sl.Unlock()
+	}()
+}
+
+// charge takes n tokens from the bucket and caps the debt. The caller must
+// hold the lock.
+func (sl *sharedLimiter) charge(n int) {
+	sl.take(n)
+	if sl.tokens < -sl.rate {
+		sl.tokens = -sl.rate
+	}
+}
+
+// reserve takes n tokens from the bucket and returns how long the caller
+// must wait for its share of the debt.
+func (sl *sharedLimiter) reserve(n int) time.Duration {
+	sl.Lock()
+	defer sl.Unlock()
+	sl.charge(n)
+	if sl.tokens >= 0 {
+		return 0
+	}
+	delay := -sl.tokens / sl.rate
+	conns := sl.conns
+	if conns < 1 {
+		conns = 1
+	}
+	if share := float64(n) * float64(conns) / sl.rate; share < delay {
+		delay = share
+	}
+	return time.Duration(delay * float64(time.Second))
+}
+
+// wait blocks until n bytes may pass the limiter or closeChan is closed.
+func (sl *sharedLimiter) wait(n int, closeChan <-chan struct{}) error {
+	if sl == nil || n <= 0 {
+		return nil
+	}
+	return waitFor(sl.reserve(n), closeChan)
+}
+
+// reservePriority charges n tokens to the bucket and returns how long the
+// caller must wait for the debt of the priority lane.
+func (sl *sharedLimiter) reservePriority(n int) time.Duration {
+	sl.Lock()
+	sl.charge(n)
+	sl.Unlock()
+	return sl.priority.reserve(n)
+}
+
+// waitPriority blocks until n bytes may pass the priority lane of the
+// limiter or closeChan is closed.
+func (sl *sharedLimiter) waitPriority(n int, closeChan <-chan struct{}) error {
+	if sl == nil || n <= 0 {
+		return nil
+	}
+	return waitFor(sl.reservePriority(n), closeChan)
+}
+
+// limiter is a bandwidth limit applied to a connection
+type limiter interface {
+	wait(n int, closeChan <-chan struct{}) error
+}
+
+var _ limiter = (*rateLimiter)(nil)
+var _ limiter = (*sharedLimiter)(nil)
+
+// waitFor blocks for delay or until closeChan is closed.
+func waitFor(delay time.Duration, closeChan <-chan struct{}) error {
+	if delay == 0 {
+		return nil
+	}
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-closeChan:
+		return ErrConnClosed
+	}
+}
+
+// Bandwidth holds the limits on the bytes the node sends and receives. The
+// global limiters are shared by every connection while each connection gets
+// its own limiters for the per peer rates. A rate that is not positive is
+// unlimited. The bytes read from P2P connections can not be told apart, so
+// the global inbound limit is applied to their messages by waitInbound
+// instead, where consensus messages take the priority lane and do not wait
+// behind transactions.
+type Bandwidth struct {
+	peerIn    int
+	peerOut   int
+	globalIn  *sharedLimiter
+	globalOut *sharedLimiter
+}
+
+// NewBandwidth returns the limits for the given rates in bytes per second.
+func NewBandwidth(peerIn, peerOut, globalIn, globalOut int) *Bandwidth {
+	return &Bandwidth{
+		peerIn:    peerIn,
+		peerOut:   peerOut,
+		globalIn:  newSharedLimiter(globalIn),
+		globalOut: newSharedLimiter(globalOut),
+	}
+}
+
+// limit applies the limits to conn.
+func (bw *Bandwidth) limit(conn *P2PConn) {
+	if bw == nil {
+		return
+	}
+	bw.globalIn.join(conn.closeChan)
+	bw.globalOut.join(conn.closeChan)
+	conn.inLimits = []limiter{newRateLimiter(bw.peerIn)}
+	if conn.protocol != types.P2PProtocol {
+		conn.inLimits = append(conn.inLimits, bw.globalIn)
+	}
+	conn.outLimits = []limiter{newRateLimiter(bw.peerOut), bw.globalOut}
+}
+
+// waitInbound blocks until an inbound P2P message of n bytes fits within the
+// global inbound limit or closeChan is closed. Priority messages take the
+// priority lane of the limit.
+func (bw *Bandwidth) waitInbound(n int, priority bool, closeChan <-chan struct{}) error {
+	if bw == nil {
+		return nil
+	}
+	if priority {
+		return bw.globalIn.waitPriority(n, closeChan)
+	}
+	return bw.globalIn.wait(n, closeChan)
+}
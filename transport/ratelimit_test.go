@@ -0,0 +1,224 @@
+package transport
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/MadBase/MadNet/constants"
+	"github.com/MadBase/MadNet/types"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Now()
+	rl := newRateLimiter(1000)
+	rl.clock = func() time.Time { return now }
+	rl.last = now
+
+	if d := rl.reserve(1000); d != 0 {
+		t.Fatalf("full bucket waited %v", d)
+	}
+	if d := rl.reserve(500); d != 500*time.Millisecond {
+		t.Fatalf("empty bucket waited %v", d)
+	}
+	// the debt is repaid before new tokens accumulate
+	now = now.Add(time.Second)
+	if d := rl.reserve(500); d != 0 {
+		t.Fatalf("waited %v after the debt was repaid", d)
+	}
+	// the bucket holds at most one second of tokens
+	now = now.Add(time.Hour)
+	if d := rl.reserve(2000); d != time.Second {
+		t.Fatalf("overfull bucket waited %v", d)
+	}
+
+	if newRateLimiter(0) != nil {
+		t.Fatal("zero rate is limited")
+	}
+	var unlimited *rateLimiter
+	if err := unlimited.wait(1<<30, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSharedLimiterReserve(t *testing.T) {
+	now := time.Now()
+	sl := newSharedLimiter(1000)
+	sl.clock = func() time.Time { return now }
+	sl.last = now
+	chatty := make(chan struct{})
+	quiet := make(chan struct{})
+	defer close(quiet)
+	sl.join(chatty)
+	sl.join(quiet)
+
+	// the debt of a large read is capped at one second
+	if d := sl.reserve(5000); d != time.Second {
+		t.Fatalf("chatty peer waited %v", d)
+	}
+	// another peer waits for its share only
+	if d := sl.reserve(100); d != 200*time.Millisecond {
+		t.Fatalf("quiet peer waited %v", d)
+	}
+	// the share grows when a connection closes
+	close(chatty)
+	for i := 0; ; i++ {
+		if i == 100 {
+			t.Fatal("closed connection was counted")
+		}
+		if d := sl.reserve(100); d == 100*time.Millisecond {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if newSharedLimiter(0) != nil {
+		t.Fatal("zero rate is limited")
+	}
+	var unlimited *sharedLimiter
+	unlimited.join(nil)
+	if err := unlimited.wait(1<<30, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSharedLimiterPriority(t *testing.T) {
+	now := time.Now()
+	sl := newSharedLimiter(10000)
+	sl.clock = func() time.Time { return now }
+	sl.priority.clock = sl.clock
+	sl.last = now
+	sl.priority.last = now
+	for i := 0; i < 20; i++ {
+		closeChan := make(chan struct{})
+		defer close(closeChan)
+		sl.join(closeChan)
+	}
+
+	// every peer floods transactions while the proposal and votes of each
+	// round pass the limiter without waiting
+	for round := 0; round < 10; round++ {
+		for i := 0; i < 20; i++ {
+			sl.reserve(10000)
+		}
+		if d := sl.reserve(1000); d == 0 {
+			t.Fatal("transactions were not limited")
+		}
+		for _, n := range []int{2000, 300, 300, 300, 300} {
+			if d := sl.reservePriority(n); d != 0 {
+				t.Fatalf("consensus message waited %v in round %d", d, round)
+			}
+		}
+		now = now.Add(constants.ProposalStepTO + constants.PreVoteStepTO + constants.PreCommitStepTO)
+	}
+	// the consensus messages are charged to the shared bucket
+	sl.tokens = sl.rate
+	sl.reservePriority(5000)
+	if sl.tokens != 5000 {
+		t.Fatalf("priority bytes not charged: %v tokens", sl.tokens)
+	}
+	// the priority lane is itself limited
+	if d := sl.reservePriority(20000); d != 1500*time.Millisecond {
+		t.Fatalf("flood of consensus messages waited %v", d)
+	}
+
+	var unlimited *sharedLimiter
+	if err := unlimited.waitPriority(1<<30, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBandwidthInbound(t *testing.T) {
+	bw := NewBandwidth(0, 0, 10000, 0)
+	p2p := &P2PConn{Conn: &net.TCPConn{}, protocol: types.P2PProtocol, closeChan: make(chan struct{})}
+	bw.limit(p2p)
+	if len(p2p.inLimits) != 1 {
+		t.Fatalf("global limit applied to the bytes read from a P2P connection")
+	}
+	disc := &P2PConn{Conn: &net.TCPConn{}, protocol: types.DiscProtocol, closeChan: make(chan struct{})}
+	bw.limit(disc)
+	if len(disc.inLimits) != 2 {
+		t.Fatalf("global limit not applied to the bytes read from a discovery connection")
+	}
+
+	// a flood of transactions holds up other transactions but not the
+	// consensus messages
+	stop := make(chan struct{})
+	defer close(stop)
+	for i := 0; i < 10; i++ {
+		go func() {
+			for bw.waitInbound(10000, false, stop) == nil {
+			}
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+	start := time.Now()
+	for _, n := range []int{2000, 300, 300, 300, 300} {
+		if err := bw.waitInbound(n, true, stop); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed >= constants.PreVoteStepTO/10 {
+		t.Fatalf("consensus messages took %v", elapsed)
+	}
+	start = time.Now()
+	if err := bw.waitInbound(2000, false, stop); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Fatalf("transaction was not limited: %v", elapsed)
+	}
+
+	var unlimited *Bandwidth
+	if err := unlimited.waitInbound(1<<30, true, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestP2PConnBandwidth(t *testing.T) {
+	local, remote := net.Pipe()
+	defer remote.Close()
+	closeChan := make(chan struct{})
+	pc := &P2PConn{Conn: local, closeChan: closeChan}
+	NewBandwidth(0, 1000, 0, 0).limit(pc)
+
+	go func() {
+		buf := make([]byte, 1500)
+		io.ReadFull(remote, buf)
+		remote.Write(buf[:300])
+	}()
+	start := time.Now()
+	if _, err := pc.Write(make([]byte, 1000)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pc.Write(make([]byte, 500)); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("write was not limited: %v", elapsed)
+	}
+	if _, err := io.ReadFull(pc, make([]byte, 300)); err != nil {
+		t.Fatal(err)
+	}
+	if pc.BytesOut() != 1500 || pc.BytesIn() != 300 {
+		t.Fatalf("counted %d bytes out and %d in", pc.BytesOut(), pc.BytesIn())
+	}
+
+	// closing the connection stops the wait
+	done := make(chan error)
+	go func() {
+		_, err := pc.Write(make([]byte, 1000000))
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(closeChan)
+	select {
+	case err := <-done:
+		if err != ErrConnClosed {
+			t.Fatalf("got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("write did not stop on close")
+	}
+}
@@ -1,10 +1,12 @@
 package transport
 
 import (
+	"context"
 	"net"
 	"sync"
 
 	"github.com/MadBase/MadNet/config"
+	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/crypto/secp256k1"
 	"github.com/MadBase/MadNet/interfaces"
 	"github.com/MadBase/MadNet/transport/brontide"
@@ -18,10 +20,23 @@ var _ interfaces.P2PTransport = (*P2PTransport)(nil)
 // This is the required value for the network string used in this package due to
 // the design of brontide.
 const (
-	tcpNetwork   string             = "tcp"
-	protoVersion types.ProtoVersion = 1
+	tcpNetwork      string             = "tcp"
+	minProtoVersion types.ProtoVersion = constants.ProtoVersionMin
+	maxProtoVersion types.ProtoVersion = constants.ProtoVersionMax
+	// localCapabilities are the optional protocol features of the local node
+	localCapabilities types.Capabilities = types.CompressionCapability | types.NodeRecordCapability
 )
 
+// versionOffer returns the versions and capabilities the local node offers
+// in the post handshake
+func versionOffer() brontide.VersionOffer {
+	return brontide.VersionOffer{
+		Min:          minProtoVersion,
+		Max:          maxProtoVersion,
+		Capabilities: localCapabilities,
+	}
+}
+
 // P2PTransport wraps the brontide library in native types.
 type P2PTransport struct {
 	// This is the logger for the transport
@@ -33,8 +48,14 @@ type P2PTransport struct {
 	localNodeAddr interfaces.NodeAddr
 	// This is the private key used during encryption and authentication.
 	localPrivateKey *secp256k1.PrivateKey
+	// This is the signed record of the local node handed out by discovery.
+	nodeRecord *localNodeRecord
 	// This is the brontide listener.
 	listener *brontide.Listener
+	// This is the allow and deny list of remote peers.
+	accessList *AccessList
+	// These are the bandwidth limits of connections.
+	bandwidth *Bandwidth
 	// This is the quit notification channel for Accept loops.
 	closeChan chan struct{}
 	// this is the sync once used to protect the close methods
@@ -67,17 +88,38 @@ func (pt *P2PTransport) NodeAddr() interfaces.NodeAddr {
 	return pt.localNodeAddr
 }
 
+// NodeRecord returns the signed record of the local node. The record holds
+// the advertised host of the node if one is configured and the listener
+// address otherwise.
+func (pt *P2PTransport) NodeRecord() []byte {
+	return pt.nodeRecord.Marshal()
+}
+
+// WaitInbound blocks until an inbound P2P message of n bytes fits within the
+// global inbound bandwidth limit or ctx is done. Consensus messages take the
+// priority lane of the limit.
+func (pt *P2PTransport) WaitInbound(ctx context.Context, n int, consensus bool) error {
+	if err := pt.bandwidth.waitInbound(n, consensus, ctx.Done()); err != nil {
+		return ctx.Err()
+	}
+	return nil
+}
+
 // Dial will dial a remote peer at the specified address with the given
 // protocol.
 func (pt *P2PTransport) Dial(addr interfaces.NodeAddr, protocol types.Protocol) (interfaces.P2PConn, error) {
 	// convert to raw type for access to non-interface methods
 	remoteAddr := addr.(*NodeAddr)
+	// refuse denied peers before dialing them
+	if !pt.accessList.AcceptPeer(net.ParseIP(remoteAddr.host), remoteAddr.identity) {
+		return nil, ErrPeerNotAllowed
+	}
 	// convert p2pAddr into the expected format for brontide
 	btcAddr := remoteAddr.toBTCNetAddr()
 	// run the authentication and encryption handshake
 	bconn, err := brontide.Dial(pt.localPrivateKey,
 		protocol,
-		protoVersion,
+		versionOffer(),
 		pt.localNodeAddr.ChainID(),
 		pt.localNodeAddr.Port(),
 		btcAddr,
@@ -87,8 +129,17 @@ func (pt *P2PTransport) Dial(addr interfaces.NodeAddr, protocol types.Protocol)
 	if err != nil {
 		return nil, err
 	}
+	// the host may have been a name, so check the address it resolved to
+	if tcpAddr, ok := bconn.RemoteAddr().(*net.TCPAddr); ok {
+		if !pt.accessList.AcceptPeer(tcpAddr.IP, bconn.RemotePub()) {
+			if err := bconn.Close(); err != nil {
+				utils.DebugTrace(pt.logger, err)
+			}
+			return nil, ErrPeerNotAllowed
+		}
+	}
 	// convert from brontide connection into P2PConn
-	return &P2PConn{
+	conn := &P2PConn{
 		nodeAddr: &NodeAddr{
 			host:     addr.Host(),
 			port:     bconn.P2PPort,
@@ -100,9 +151,12 @@ func (pt *P2PTransport) Dial(addr interfaces.NodeAddr, protocol types.Protocol)
 		initiator:    types.SelfInitiatedConnection,
 		protocol:     bconn.Protocol,
 		protoVersion: bconn.Version,
+		capabilities: bconn.Capabilities,
 		cleanupfn:    func() {},
 		closeChan:    bconn.CloseChan(),
-	}, nil
+	}
+	pt.bandwidth.limit(conn)
+	return conn, nil
 }
 
 // This method handles type conversions.
@@ -117,7 +171,7 @@ func (pt *P2PTransport) handleConnection(bconn *brontide.Conn) interfaces.P2PCon
 		return nil
 	}
 	// turn the brontide conn into a p2PConn
-	return &P2PConn{
+	conn := &P2PConn{
 		nodeAddr: &NodeAddr{
 			host:     host,
 			port:     bconn.P2PPort,
@@ -129,9 +183,12 @@ func (pt *P2PTransport) handleConnection(bconn *brontide.Conn) interfaces.P2PCon
 		initiator:    types.PeerInitiatedConnection,
 		protocol:     bconn.Protocol,
 		protoVersion: bconn.Version,
+		capabilities: bconn.Capabilities,
 		cleanupfn:    func() {},
 		closeChan:    bconn.CloseChan(),
 	}
+	pt.bandwidth.limit(conn)
+	return conn
 }
 
 // Accept method MUST be called after the transport is started.
@@ -181,6 +238,20 @@ func NewP2PTransport(logger *logrus.Logger, cid types.ChainIdentifier, privateKe
 		chainID:  cid,
 	}
 
+	recordAddr := &NodeAddr{
+		host:     host,
+		port:     port,
+		identity: localPublicKey,
+		chainID:  cid,
+	}
+	if config.Configuration.Transport.AdvertisedHost != "" {
+		recordAddr.host = config.Configuration.Transport.AdvertisedHost
+	}
+	nodeRecord, err := newLocalNodeRecord(logger, localPrivateKey, recordAddr)
+	if err != nil {
+		return nil, err
+	}
+
 	var mc int
 	var mp int
 	if config.Configuration.Transport.OriginLimit <= 0 {
@@ -194,17 +265,33 @@ func NewP2PTransport(logger *logrus.Logger, cid types.ChainIdentifier, privateKe
 		mp = config.Configuration.Transport.PeerLimitMax
 	}
 
-	listener, err := brontide.NewListener(localPrivateKey, host, port, protoVersion, cid, mp, 1, mc)
+	accessList, err := NewAccessList(
+		config.Configuration.Transport.Whitelist,
+		config.Configuration.Transport.Blacklist,
+		config.Configuration.Transport.WhitelistOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := brontide.NewListener(localPrivateKey, host, port, versionOffer(), cid, mp, 1, mc)
 	if err != nil {
 		return nil, err
 	}
+	listener.SetFilter(accessList)
 
 	transport := &P2PTransport{
 		logger:          logger,
 		localNodeAddr:   localNodeAddr,
 		localPrivateKey: localPrivateKey,
+		nodeRecord:      nodeRecord,
 		listener:        listener,
-		closeChan:       make(chan struct{}),
+		accessList:      accessList,
+		bandwidth: NewBandwidth(
+			config.Configuration.Transport.PeerRateIn,
+			config.Configuration.Transport.PeerRateOut,
+			config.Configuration.Transport.RateIn,
+			config.Configuration.Transport.RateOut),
+		closeChan: make(chan struct{}),
 	}
 	return transport, nil
 }
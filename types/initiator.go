@@ -16,3 +16,14 @@ const (
 	SelfInitiatedConnection = P2PInitiator(iota + 1)
 	PeerInitiatedConnection
 )
+
+func (i P2PInitiator) String() string {
+	switch i {
+	case SelfInitiatedConnection:
+		return "outbound"
+	case PeerInitiatedConnection:
+		return "inbound"
+	default:
+		return "unknown"
+	}
+}
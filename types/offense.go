@@ -0,0 +1,16 @@
+package types
+
+// PeerOffense is a misbehavior of a remote peer that lowers its reputation.
+type PeerOffense uint8
+
+// These are the offenses a peer may be penalized for.
+// InvalidMessage is a message or response that fails validation.
+// OversizedMessage is a message larger than any valid message.
+// UnresponsivePeer is a request the peer did not answer in time.
+// HandshakeFailure is a connection the peer did not complete.
+const (
+	InvalidMessage = PeerOffense(iota + 1)
+	OversizedMessage
+	UnresponsivePeer
+	HandshakeFailure
+)
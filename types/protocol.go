@@ -3,6 +3,24 @@ package types
 // ProtoVersion is a custom type used to store protocol version
 type ProtoVersion uint32
 
+// Capabilities is a bitmap of the optional protocol features a node
+// supports. The capabilities of a connection are those both peers support.
+type Capabilities uint64
+
+// These are the optional protocol features a node may support.
+// If CompressionCapability is set, large messages may be compressed.
+// If NodeRecordCapability is set, peers are exchanged as signed node records
+// rather than as bare p2p addresses.
+const (
+	CompressionCapability = Capabilities(1 << iota)
+	NodeRecordCapability
+)
+
+// Has returns true if c includes every capability of other
+func (c Capabilities) Has(other Capabilities) bool {
+	return c&other == other
+}
+
 // Protocol specifies if this is a P2P or a discovery connection.
 type Protocol uint32
 
@@ -10,8 +28,10 @@ type Protocol uint32
 // If this value is P2PProtocol, a peer to peer protocol is being initiated.
 // if this value is DiscProtocol, a discovery protocol is being initiated.
 // If this value is Bootnode, a bootnode protocol is being initiated.
+// If this value is DHTProtocol, a DHT lookup is being initiated.
 const (
 	P2PProtocol = Protocol(iota + 1)
 	DiscProtocol
 	Bootnode
+	DHTProtocol
 )
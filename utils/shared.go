@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/MadBase/MadNet/constants"
 	"github.com/MadBase/MadNet/errorz"
@@ -145,3 +146,16 @@ func DebugTrace(logger *logrus.Logger, err error, s ...string) {
 		logger.WithField("f", file).WithField("l", line).Debug("")
 	}
 }
+
+// Clock is a source of time. Components that keep time hold a Clock so that
+// tests and the consensus simulator can drive them on a virtual clock. The
+// zero Clock reads the wall clock.
+type Clock func() time.Time
+
+// Now returns the current time of the clock
+func (c Clock) Now() time.Time {
+	if c == nil {
+		return time.Now()
+	}
+	return c()
+}